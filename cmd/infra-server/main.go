@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"flag"
 	"fmt"
 	"log"
@@ -8,22 +9,30 @@ import (
 	"os/signal"
 	"path/filepath"
 	"syscall"
+	"time"
 
 	"github.com/pkg/errors"
+	"github.com/stackrox/infra/pkg/audit"
 	"github.com/stackrox/infra/pkg/auth"
 	"github.com/stackrox/infra/pkg/bqutil"
 	"github.com/stackrox/infra/pkg/buildinfo"
 	"github.com/stackrox/infra/pkg/config"
 	"github.com/stackrox/infra/pkg/flavor"
+	"github.com/stackrox/infra/pkg/leader"
 	"github.com/stackrox/infra/pkg/logging"
+	"github.com/stackrox/infra/pkg/notifier"
 	"github.com/stackrox/infra/pkg/server"
 	"github.com/stackrox/infra/pkg/service"
 	"github.com/stackrox/infra/pkg/service/cluster"
 	"github.com/stackrox/infra/pkg/service/middleware"
 	"github.com/stackrox/infra/pkg/signer"
 	"github.com/stackrox/infra/pkg/slack"
+	"github.com/stackrox/infra/pkg/tokenstore"
 )
 
+// flavorReloadInterval is how often the flavor config is checked for changes.
+const flavorReloadInterval = time.Minute
+
 // main is the entry point of the infra server.
 func main() {
 	if err := mainCmd(); err != nil {
@@ -55,11 +64,17 @@ func mainCmd() error {
 		return errors.Wrapf(err, "failed to load server config file %q", serverConfigFile)
 	}
 
+	if err := middleware.ValidateRBACConfig(cfg.RBAC); err != nil {
+		return errors.Wrapf(err, "invalid rbac config in %q", serverConfigFile)
+	}
+
 	flavorConfigFile := filepath.Join(*flagConfigDir, "flavors.yaml")
 	registry, err := flavor.NewFromConfig(flavorConfigFile)
 	if err != nil {
 		return errors.Wrapf(err, "failed to load flavor config file %q", flavorConfigFile)
 	}
+	// Pick up changes to the flavor config and workflows without a restart.
+	go registry.Watch(flavorReloadInterval)
 
 	oidcConfigFile := filepath.Join(*flagConfigDir, "oidc.yaml")
 	oidc, err := auth.NewFromConfig(oidcConfigFile)
@@ -67,9 +82,9 @@ func mainCmd() error {
 		return errors.Wrapf(err, "failed to load oidc config file %q", oidcConfigFile)
 	}
 
-	signer, err := signer.NewFromEnv()
+	artifactStore, err := signer.New(cfg.ArtifactStore)
 	if err != nil {
-		return errors.Wrapf(err, "failed to load GCS signing credentials")
+		return errors.Wrapf(err, "failed to create artifact store")
 	}
 
 	slackClient, err := slack.New(cfg.Slack)
@@ -77,18 +92,49 @@ func mainCmd() error {
 		return errors.Wrapf(err, "failed to create Slack client")
 	}
 
+	webhooks, err := notifier.NewWebhooks(cfg.Notifications)
+	if err != nil {
+		return errors.Wrapf(err, "failed to create webhook notifiers")
+	}
+	notifiers := append([]notifier.Notifier{slack.NewNotifier(slackClient)}, webhooks...)
+
 	bqClient, err := bqutil.NewClient(cfg.BigQuery)
 	if err != nil {
 		return errors.Wrapf(err, "failed to create bqClient")
 	}
 
+	// Persist every audit event, in addition to logging it.
+	auditSink, err := audit.New(cfg.Audit)
+	if err != nil {
+		return errors.Wrapf(err, "failed to create audit sink")
+	}
+	logging.SetAuditRecorder(audit.NewRecorder(auditSink))
+
+	elector, err := leader.New(cfg.LeaderElection)
+	if err != nil {
+		return errors.Wrapf(err, "failed to create leader elector")
+	}
+	// Only the leader runs the background reconcilers, while every replica
+	// serves the APIs.
+	go elector.Run(context.Background())
+
+	tokens, err := tokenstore.New(cfg.Tokens)
+	if err != nil {
+		return errors.Wrapf(err, "failed to create token store")
+	}
+
+	clusterService, err := cluster.NewClusterService(registry, artifactStore, slackClient, notifiers, bqClient, cfg.Quota, elector)
+	if err != nil {
+		return err
+	}
+
 	// Construct each individual service.
 	services, err := middleware.Services(
 		func() (middleware.APIService, error) {
 			return service.NewFlavorService(registry)
 		},
 		func() (middleware.APIService, error) {
-			return service.NewUserService(oidc.GenerateServiceAccountToken)
+			return service.NewUserService(oidc.GenerateServiceAccountToken, tokens)
 		},
 		func() (middleware.APIService, error) {
 			return service.NewCliService(cfg.Server.StaticDir)
@@ -96,14 +142,23 @@ func mainCmd() error {
 		service.NewStatusService,
 		service.NewVersionService,
 		func() (middleware.APIService, error) {
-			return cluster.NewClusterService(registry, signer, slackClient, bqClient)
+			return clusterService, nil
+		},
+		func() (middleware.APIService, error) {
+			return cluster.NewScheduleService(clusterService, cfg.Schedules)
+		},
+		func() (middleware.APIService, error) {
+			return cluster.NewQuotaService(clusterService)
+		},
+		func() (middleware.APIService, error) {
+			return cluster.NewAuditService(clusterService, auditSink)
 		},
 	)
 	if err != nil {
 		return err
 	}
 
-	srv := server.New(*cfg, *oidc, services...)
+	srv := server.New(*cfg, *oidc, elector, tokens, services...)
 	errCh, err := srv.RunServer()
 	if err != nil {
 		return err
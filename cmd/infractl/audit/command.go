@@ -0,0 +1,95 @@
+// Package audit implements the infractl audit command.
+package audit
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/stackrox/infra/cmd/infractl/common"
+	v1 "github.com/stackrox/infra/generated/api/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const examples = `# List the recent audit events that are visible to you.
+$ infractl audit
+
+# List who changed the lifespan of a cluster during the last day.
+$ infractl audit --cluster example-cluster --phase cluster-lifespan --since 24h
+
+# List the operations of a user within a time range.
+$ infractl audit --actor jane@example.com --since 2024-05-01T00:00:00Z --until 2024-05-02T00:00:00Z`
+
+// Command defines the handler for infractl audit.
+func Command() *cobra.Command {
+	// $ infractl audit
+	cmd := &cobra.Command{
+		Use:     "audit",
+		Short:   "List audit events",
+		Long:    "Lists the audited operations on your clusters, or on every cluster for admins",
+		Example: examples,
+		Args:    common.ArgsWithHelp(cobra.ExactArgs(0)),
+		RunE:    common.WithGRPCHandler(run),
+	}
+
+	cmd.Flags().String("actor", "", "only list operations requested by this email")
+	cmd.Flags().String("cluster", "", "only list operations on this cluster ID")
+	cmd.Flags().String("phase", "", "only list operations of this kind, e.g. cluster-delete")
+	cmd.Flags().String("since", "", "only list operations after this RFC 3339 time, or this long ago (e.g. 24h)")
+	cmd.Flags().String("until", "", "only list operations before this RFC 3339 time, or this long ago (e.g. 1h)")
+	cmd.Flags().Int32("limit", 0, "maximum number of operations to list (default 100)")
+	return cmd
+}
+
+func run(ctx context.Context, conn *grpc.ClientConn, cmd *cobra.Command, _ []string) (common.PrettyPrinter, error) {
+	since, err := parseTime(cmd, "since")
+	if err != nil {
+		return nil, err
+	}
+	until, err := parseTime(cmd, "until")
+	if err != nil {
+		return nil, err
+	}
+	limit, err := cmd.Flags().GetInt32("limit")
+	if err != nil {
+		return nil, err
+	}
+
+	req := v1.AuditListRequest{
+		Actor:     cmd.Flag("actor").Value.String(),
+		ClusterID: cmd.Flag("cluster").Value.String(),
+		Phase:     cmd.Flag("phase").Value.String(),
+		Since:     since,
+		Until:     until,
+		Limit:     limit,
+	}
+
+	resp, err := v1.NewAuditServiceClient(conn).List(ctx, &req)
+	if err != nil {
+		return nil, err
+	}
+
+	return prettyAuditListResponse{resp}, nil
+}
+
+// parseTime parses the named flag as either an RFC 3339 time, or a duration
+// before now.
+func parseTime(cmd *cobra.Command, name string) (*timestamppb.Timestamp, error) {
+	value := cmd.Flag(name).Value.String()
+	if value == "" {
+		return nil, nil
+	}
+
+	if moment, err := time.Parse(time.RFC3339, value); err == nil {
+		return timestamppb.New(moment), nil
+	}
+
+	ago, err := time.ParseDuration(value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid --%s %q: expected an RFC 3339 time or a duration", name, value)
+	}
+
+	return timestamppb.New(time.Now().Add(-ago)), nil
+}
@@ -0,0 +1,50 @@
+package audit
+
+import (
+	"encoding/json"
+	"sort"
+
+	"github.com/spf13/cobra"
+
+	"github.com/stackrox/infra/cmd/infractl/common"
+	v1 "github.com/stackrox/infra/generated/api/v1"
+)
+
+type prettyAuditListResponse struct {
+	*v1.AuditListResponse
+}
+
+func (p prettyAuditListResponse) PrettyPrint(cmd *cobra.Command) {
+	if len(p.GetEvents()) == 0 {
+		cmd.Println("No audit events found")
+		return
+	}
+
+	for _, event := range p.GetEvents() {
+		cmd.Printf("%s %s\n", common.FormatTime(event.GetTime().AsTime()), event.GetPhase())
+		cmd.Printf("  Actor:   %s\n", event.GetActor())
+		if event.GetClusterID() != "" {
+			cmd.Printf("  Cluster: %s\n", event.GetClusterID())
+		}
+		cmd.Printf("  Message: %s\n", event.GetMessage())
+
+		keys := make([]string, 0, len(event.GetDetails()))
+		for key := range event.GetDetails() {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			cmd.Printf("  %s: %s\n", key, event.GetDetails()[key])
+		}
+	}
+}
+
+func (p prettyAuditListResponse) PrettyJSONPrint(cmd *cobra.Command) error {
+	data, err := json.MarshalIndent(p.AuditListResponse, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	cmd.Printf("%s\n", string(data))
+	return nil
+}
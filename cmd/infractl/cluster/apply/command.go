@@ -0,0 +1,147 @@
+// Package apply implements the infractl apply command.
+package apply
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/spf13/cobra"
+	"github.com/stackrox/infra/cmd/infractl/cluster/manifest"
+	"github.com/stackrox/infra/cmd/infractl/common"
+	v1 "github.com/stackrox/infra/generated/api/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+const examples = `# Create the clusters of env.yaml that do not exist yet, and extend the
+# lifespans of the others where needed.
+$ infractl apply -f env.yaml
+
+# Show what would be done without changing any cluster.
+$ infractl apply -f env.yaml --dry-run
+
+# Apply env.yaml and wait for every cluster to be ready.
+$ infractl apply -f env.yaml --wait
+
+An example manifest:
+
+clusters:
+  - name: ci-gke
+    flavor: gke-default
+    description: integration tests
+    lifespan: 8h
+    labels:
+      team: sre
+    args:
+      nodes: "5"
+  - name: ci-ocp
+    flavor: openshift-4
+    description: integration tests
+    lifespan: 8h
+    noSlack: true`
+
+// Command defines the handler for infractl apply.
+func Command() *cobra.Command {
+	// $ infractl apply
+	cmd := &cobra.Command{
+		Use:     "apply -f FILE",
+		Short:   "Reconcile a set of clusters with a manifest",
+		Long:    "Creates the clusters of a manifest that do not exist, extends the lifespans of the others where needed, and reports differences that cannot be reconciled",
+		Example: examples,
+		Args:    common.ArgsWithHelp(cobra.NoArgs),
+		RunE:    common.WithGRPCHandler(run),
+	}
+
+	cmd.Flags().StringP("file", "f", "", "manifest of the clusters")
+	cmd.Flags().Bool("dry-run", false, "only show what would be done")
+	cmd.Flags().Bool("wait", false, "wait for every cluster to be ready")
+	common.AddMaxWaitErrorsFlag(cmd)
+	_ = cmd.MarkFlagRequired("file")
+	return cmd
+}
+
+func run(ctx context.Context, conn *grpc.ClientConn, cmd *cobra.Command, _ []string) (common.PrettyPrinter, error) {
+	manifestFile, _ := cmd.Flags().GetString("file")
+	dryRun := common.MustBool(cmd.Flags(), "dry-run")
+
+	m, err := manifest.Load(manifestFile)
+	if err != nil {
+		return nil, err
+	}
+
+	client := v1.NewClusterServiceClient(conn)
+
+	var results []result
+	var pending []*v1.ResourceByID
+	for _, desired := range m.Clusters {
+		current, err := client.Info(ctx, &v1.ClusterRequest{Id: desired.Name})
+		if status.Code(err) == codes.NotFound {
+			current, err = nil, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to get cluster %q: %w", desired.Name, err)
+		}
+
+		plan := manifest.PlanCluster(desired, current)
+		results = append(results, result{ID: desired.Name, Action: plan.Action, Drift: plan.Drift})
+		if dryRun {
+			continue
+		}
+
+		switch plan.Action {
+		case manifest.ActionCreate:
+			clusterID, err := client.Create(ctx, desired.CreateRequest())
+			if err != nil {
+				return nil, fmt.Errorf("failed to create cluster %q: %w", desired.Name, err)
+			}
+			pending = append(pending, clusterID)
+
+		case manifest.ActionExtend:
+			_, err := client.Lifespan(ctx, &v1.LifespanRequest{
+				Id:       desired.Name,
+				Lifespan: durationpb.New(desired.Lifespan.Duration()),
+				Method:   v1.LifespanRequest_REPLACE,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to extend the lifespan of cluster %q: %w", desired.Name, err)
+			}
+			fallthrough
+
+		default:
+			if current.GetStatus() == v1.Status_CREATING {
+				pending = append(pending, &v1.ResourceByID{Id: desired.Name})
+			}
+		}
+	}
+
+	if !dryRun && common.MustBool(cmd.Flags(), "wait") {
+		if err := waitForClusters(client, pending, common.GetMaxWaitErrorsFlagValue(cmd)); err != nil {
+			return nil, err
+		}
+	}
+
+	return prettyApplyResult{Clusters: results, DryRun: dryRun}, nil
+}
+
+// waitForClusters waits for all of the given clusters to be ready in
+// parallel.
+func waitForClusters(client v1.ClusterServiceClient, clusterIDs []*v1.ResourceByID, maxWaitErrors int) error {
+	var wg sync.WaitGroup
+	errs := make([]error, len(clusterIDs))
+	for i, clusterID := range clusterIDs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := common.WaitForCluster(client, clusterID, maxWaitErrors); err != nil {
+				errs[i] = fmt.Errorf("cluster %q: %w", clusterID.GetId(), err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	return errors.Join(errs...)
+}
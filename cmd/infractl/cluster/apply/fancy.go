@@ -0,0 +1,44 @@
+package apply
+
+import (
+	"encoding/json"
+
+	"github.com/spf13/cobra"
+	"github.com/stackrox/infra/cmd/infractl/cluster/manifest"
+)
+
+type result struct {
+	ID     string          `json:"ID"`
+	Action manifest.Action `json:"Action"`
+	Drift  []string        `json:"Drift,omitempty"`
+}
+
+type prettyApplyResult struct {
+	Clusters []result `json:"Clusters"`
+	DryRun   bool     `json:"DryRun"`
+}
+
+func (p prettyApplyResult) PrettyPrint(cmd *cobra.Command) {
+	for _, cluster := range p.Clusters {
+		action := string(cluster.Action)
+		if p.DryRun {
+			action += " (dry run)"
+		}
+
+		cmd.Printf("%s \n", cluster.ID)
+		cmd.Printf("  Action: %s\n", action)
+		for _, drift := range cluster.Drift {
+			cmd.Printf("  Drift:  %s\n", drift)
+		}
+	}
+}
+
+func (p prettyApplyResult) PrettyJSONPrint(cmd *cobra.Command) error {
+	data, err := json.MarshalIndent(p, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	cmd.Printf("%s\n", string(data))
+	return nil
+}
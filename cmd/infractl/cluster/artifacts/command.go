@@ -24,7 +24,10 @@ const examples = `# List the artifacts for cluster "example-s3maj".
 $ infractl artifacts example-s3maj
 
 # Download the artifacts for cluster "example-s3maj" into the "artifacts" directory.
-$ infractl artifacts example-s3maj -d artifacts`
+$ infractl artifacts example-s3maj -d artifacts
+
+# List the artifacts for the first generation of cluster "example-s3maj".
+$ infractl artifacts example-s3maj --generation 1`
 
 // Command defines the handler for infractl artifacts.
 func Command() *cobra.Command {
@@ -39,6 +42,7 @@ func Command() *cobra.Command {
 	}
 
 	cmd.Flags().StringP("download-dir", "d", "", "artifact download directory")
+	cmd.Flags().String("generation", "", "generation number or workflow name of an earlier cluster with the same ID")
 	return cmd
 }
 
@@ -51,14 +55,15 @@ func args(_ *cobra.Command, args []string) error {
 
 func run(ctx context.Context, conn *grpc.ClientConn, cmd *cobra.Command, args []string) (common.PrettyPrinter, error) {
 	downloadDir, _ := cmd.Flags().GetString("download-dir")
+	generation, _ := cmd.Flags().GetString("generation")
 	client := v1.NewClusterServiceClient(conn)
 
-	return DownloadArtifacts(ctx, client, args[0], downloadDir)
+	return DownloadArtifacts(ctx, client, &v1.ClusterRequest{Id: args[0], Generation: generation}, downloadDir)
 }
 
 // DownloadArtifacts grabs all artifacts
-func DownloadArtifacts(ctx context.Context, client v1.ClusterServiceClient, id string, downloadDir string) (common.PrettyPrinter, error) {
-	resp, err := client.Artifacts(ctx, &v1.ResourceByID{Id: id})
+func DownloadArtifacts(ctx context.Context, client v1.ClusterServiceClient, req *v1.ClusterRequest, downloadDir string) (common.PrettyPrinter, error) {
+	resp, err := client.Artifacts(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,90 @@
+// Package clone implements the infractl clone command.
+package clone
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/stackrox/infra/cmd/infractl/cluster/utils"
+	"github.com/stackrox/infra/cmd/infractl/common"
+	v1 "github.com/stackrox/infra/generated/api/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+const examples = `# Recreate the failed "example-s3maj" cluster with the same settings.
+$ infractl clone example-s3maj
+ID: example-s3maj
+
+# Create a copy of the cluster with more nodes and an 8 hour lifespan.
+$ infractl clone example-s3maj example-copy --arg nodes=5 --lifespan 8h
+ID: example-copy`
+
+// Command defines the handler for infractl clone.
+func Command() *cobra.Command {
+	// $ infractl clone
+	cmd := &cobra.Command{
+		Use:     "clone SOURCE [NEW_NAME]",
+		Short:   "Create a new cluster from an existing one",
+		Long:    "Creates a new cluster with the flavor, parameters, description and Slack preferences of an existing cluster. The source cluster ID is reused when no new name is given, which is only possible once the source failed or finished.",
+		Example: examples,
+		Args:    common.ArgsWithHelp(cobra.RangeArgs(1, 2), args),
+		RunE:    common.WithGRPCHandler(run),
+	}
+
+	cmd.Flags().StringArray("arg", []string{}, "repeated key=value parameter pairs overriding those of the source cluster")
+	cmd.Flags().String("description", "", "description for this cluster, defaults to the description of the source cluster")
+	cmd.Flags().Duration("lifespan", 0, "initial lifespan of the cluster, defaults to the lifespan of the source cluster")
+	cmd.Flags().String("generation", "", "clone the given generation of the source cluster instead of the most recent one")
+	return cmd
+}
+
+func args(_ *cobra.Command, args []string) error {
+	for _, name := range args {
+		if err := utils.ValidateClusterName(name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func run(ctx context.Context, conn *grpc.ClientConn, cmd *cobra.Command, args []string) (common.PrettyPrinter, error) {
+	params, _ := cmd.Flags().GetStringArray("arg")
+	description, _ := cmd.Flags().GetString("description")
+	generation, _ := cmd.Flags().GetString("generation")
+
+	req := v1.CloneClusterRequest{
+		Id:          args[0],
+		Generation:  generation,
+		Parameters:  make(map[string]string),
+		Description: description,
+	}
+	if len(args) > 1 {
+		req.Name = args[1]
+	}
+
+	if cmd.Flags().Changed("lifespan") {
+		lifespan, _ := cmd.Flags().GetDuration("lifespan")
+		if err := utils.ValidateLifespan(lifespan); err != nil {
+			return nil, err
+		}
+		req.Lifespan = durationpb.New(lifespan)
+	}
+
+	for _, arg := range params {
+		parts := strings.SplitN(arg, "=", 2)
+		if err := utils.ValidateParameterArgument(parts); err != nil {
+			return nil, fmt.Errorf("bad parameter argument %q: %v", arg, err)
+		}
+		req.Parameters[parts[0]] = parts[1]
+	}
+
+	resp, err := v1.NewClusterServiceClient(conn).Clone(ctx, &req)
+	if err != nil {
+		return nil, err
+	}
+
+	return prettyCloneClusterResponse{resp}, nil
+}
@@ -0,0 +1,31 @@
+package clone
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	v1 "github.com/stackrox/infra/generated/api/v1"
+)
+
+type prettyCloneClusterResponse struct {
+	*v1.CloneClusterResponse
+}
+
+func (p prettyCloneClusterResponse) PrettyPrint(cmd *cobra.Command) {
+	if len(p.DroppedParameters) > 0 {
+		cmd.PrintErrf("WARNING: parameters no longer accepted by the flavor were not copied: %s\n", strings.Join(p.DroppedParameters, ", "))
+	}
+	cmd.Printf("ID: %s\n", p.ID)
+}
+
+func (p prettyCloneClusterResponse) PrettyJSONPrint(cmd *cobra.Command) error {
+	data, err := json.MarshalIndent(p.CloneClusterResponse, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	cmd.Printf("%s\n", string(data))
+	return nil
+}
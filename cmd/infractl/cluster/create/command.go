@@ -25,6 +25,10 @@ ID: jb-10-21-1
 $ infractl create gke-default --lifespan 8h
 ID: jb-10-21-2
 
+# Create a "gke-default" cluster labeled with the team that owns it.
+$ infractl create gke-default --label team=sre
+ID: jb-10-21-3
+
 # Create a demo cluster with a name of your own choosing.
 $ infractl create qa-demo my-demo-for-me
 ID: my-demo-for-me`
@@ -61,6 +65,7 @@ func Command() *cobra.Command {
 
 	cmd.Flags().StringArray("arg", []string{}, "repeated key=value parameter pairs")
 	cmd.Flags().String("description", "", "description for this cluster")
+	cmd.Flags().StringArray("label", []string{}, "repeated key=value labels, such as a team, ticket or CI run ID")
 	cmd.Flags().Duration("lifespan", 3*time.Hour, "initial lifespan of the cluster")
 	cmd.Flags().Bool("wait", false, "wait for cluster to be ready")
 	common.AddMaxWaitErrorsFlag(cmd)
@@ -79,6 +84,7 @@ var workingEnvironment struct {
 func run(ctx context.Context, conn *grpc.ClientConn, cmd *cobra.Command, args []string) (common.PrettyPrinter, error) {
 	params, _ := cmd.Flags().GetStringArray("arg")
 	description, _ := cmd.Flags().GetString("description")
+	labelArgs, _ := cmd.Flags().GetStringArray("label")
 	lifespan, _ := cmd.Flags().GetDuration("lifespan")
 	wait, _ := cmd.Flags().GetBool("wait")
 	maxWaitErrors := common.GetMaxWaitErrorsFlagValue(cmd)
@@ -93,6 +99,11 @@ func run(ctx context.Context, conn *grpc.ClientConn, cmd *cobra.Command, args []
 		return nil, err
 	}
 
+	labels, err := utils.ParseLabels(labelArgs)
+	if err != nil {
+		return nil, err
+	}
+
 	client := v1.NewClusterServiceClient(conn)
 
 	req := v1.CreateClusterRequest{
@@ -102,6 +113,7 @@ func run(ctx context.Context, conn *grpc.ClientConn, cmd *cobra.Command, args []
 		Description: description,
 		NoSlack:     noSlack,
 		SlackDM:     slackDM,
+		Labels:      labels,
 	}
 
 	for _, arg := range params {
@@ -133,7 +145,7 @@ func run(ctx context.Context, conn *grpc.ClientConn, cmd *cobra.Command, args []
 			return nil, err
 		}
 		if downloadDir != "" {
-			return artifacts.DownloadArtifacts(context.Background(), client, req.Parameters["name"], downloadDir)
+			return artifacts.DownloadArtifacts(context.Background(), client, &v1.ClusterRequest{Id: req.Parameters["name"]}, downloadDir)
 		}
 	}
 
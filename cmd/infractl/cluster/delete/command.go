@@ -13,36 +13,100 @@ import (
 )
 
 const examples = `# Delete cluster "example-s3maj".
-infractl delete example-s3maj`
+infractl delete example-s3maj
+
+# Delete clusters "example-s3maj" and "example-k2dfw".
+infractl delete example-s3maj example-k2dfw
+
+# Show which of your clusters labeled with ci-run=42 would be deleted.
+infractl delete --selector ci-run=42 --dry-run
+
+# Delete every cluster labeled with ci-run=42, including those of others.
+infractl delete --selector ci-run=42 --all --force --reason "CI outage cleanup"
+
+# Delete cluster "example-s3maj" that is owned by someone else.
+infractl delete example-s3maj --force --reason "blocking the release pipeline"`
 
 // Command defines the handler for infractl delete.
 func Command() *cobra.Command {
 	// $ infractl delete
-	return &cobra.Command{
-		Use:     "delete CLUSTER",
-		Short:   "Delete a specific cluster",
-		Long:    "Deletes a specific cluster",
+	cmd := &cobra.Command{
+		Use:     "delete CLUSTER [CLUSTER...]",
+		Short:   "Delete specific clusters",
+		Long:    "Deletes specific clusters, given by their IDs or selected by their labels",
 		Example: examples,
-		Args:    common.ArgsWithHelp(cobra.ExactArgs(1), args),
+		Args:    common.ArgsWithHelp(args),
 		RunE:    common.WithGRPCHandler(run),
 	}
+
+	cmd.Flags().Bool("force", false, "delete a cluster that you do not own")
+	cmd.Flags().String("reason", "", "why the cluster is deleted by force, sent to the owner")
+	cmd.Flags().StringP("selector", "l", "", "delete the clusters whose labels match this selector instead, e.g. ci-run=42")
+	cmd.Flags().Bool("all", false, "with --selector, include clusters not owned by you")
+	cmd.Flags().Bool("dry-run", false, "only show which clusters would be deleted")
+	return cmd
 }
 
-func args(_ *cobra.Command, args []string) error {
-	if args[0] == "" {
+func args(cmd *cobra.Command, args []string) error {
+	selector := cmd.Flag("selector").Value.String()
+	switch {
+	case selector == "" && len(args) == 0:
 		return errors.New("no cluster ID given")
+	case selector != "" && len(args) > 0:
+		return errors.New("cluster IDs cannot be given with --selector")
+	}
+	if common.MustBool(cmd.Flags(), "force") && cmd.Flag("reason").Value.String() == "" {
+		return errors.New("a reason is required with --force")
+	}
+	for _, clusterID := range args {
+		if clusterID == "" {
+			return errors.New("no cluster ID given")
+		}
+		if err := utils.ValidateClusterName(clusterID); err != nil {
+			return err
+		}
 	}
-	return utils.ValidateClusterName(args[0])
+	return nil
 }
 
-func run(ctx context.Context, conn *grpc.ClientConn, _ *cobra.Command, args []string) (common.PrettyPrinter, error) {
-	req := v1.ResourceByID{
-		Id: args[0],
+func run(ctx context.Context, conn *grpc.ClientConn, cmd *cobra.Command, args []string) (common.PrettyPrinter, error) {
+	client := v1.NewClusterServiceClient(conn)
+	selector := cmd.Flag("selector").Value.String()
+	dryRun := common.MustBool(cmd.Flags(), "dry-run")
+
+	// A single cluster is deleted as before, so that older servers are
+	// supported.
+	if len(args) == 1 && !dryRun {
+		req := v1.DeleteClusterRequest{
+			Id:     args[0],
+			Force:  common.MustBool(cmd.Flags(), "force"),
+			Reason: cmd.Flag("reason").Value.String(),
+		}
+
+		if _, err := client.Delete(ctx, &req); err != nil {
+			return nil, err
+		}
+
+		return id{&v1.ResourceByID{Id: req.GetId()}}, nil
+	}
+
+	req := v1.BulkDeleteRequest{
+		Ids:    args,
+		DryRun: dryRun,
+		Force:  common.MustBool(cmd.Flags(), "force"),
+		Reason: cmd.Flag("reason").Value.String(),
+	}
+	if selector != "" {
+		req.Filter = &v1.ClusterListRequest{
+			All:           common.MustBool(cmd.Flags(), "all"),
+			LabelSelector: selector,
+		}
 	}
 
-	if _, err := v1.NewClusterServiceClient(conn).Delete(ctx, &req); err != nil {
+	resp, err := client.BulkDelete(ctx, &req)
+	if err != nil {
 		return nil, err
 	}
 
-	return id{&req}, nil
+	return prettyBulkResponse{resp}, nil
 }
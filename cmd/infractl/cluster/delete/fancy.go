@@ -5,6 +5,7 @@ import (
 
 	"github.com/spf13/cobra"
 
+	"github.com/stackrox/infra/cmd/infractl/common"
 	v1 "github.com/stackrox/infra/generated/api/v1"
 )
 
@@ -25,3 +26,41 @@ func (p id) PrettyJSONPrint(cmd *cobra.Command) error {
 	cmd.Printf("%s\n", string(data))
 	return nil
 }
+
+type prettyBulkResponse struct {
+	*v1.BulkResponse
+}
+
+var _ common.FailureReporter = prettyBulkResponse{}
+
+func (p prettyBulkResponse) PrettyPrint(cmd *cobra.Command) {
+	if len(p.Results) == 0 {
+		cmd.Println("No clusters selected")
+		return
+	}
+
+	for _, result := range p.Results {
+		switch {
+		case result.Error != "":
+			cmd.Printf("ID: %s (failed: %s)\n", result.Id, result.Error)
+		case p.DryRun:
+			cmd.Printf("ID: %s (dry run)\n", result.Id)
+		default:
+			cmd.Printf("ID: %s\n", result.Id)
+		}
+	}
+}
+
+func (p prettyBulkResponse) PrettyJSONPrint(cmd *cobra.Command) error {
+	data, err := json.MarshalIndent(p.BulkResponse, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	cmd.Printf("%s\n", string(data))
+	return nil
+}
+
+func (p prettyBulkResponse) Failure() error {
+	return common.BulkFailure(p.BulkResponse)
+}
@@ -0,0 +1,78 @@
+// Package destroy implements the infractl destroy command.
+package destroy
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/stackrox/infra/cmd/infractl/cluster/manifest"
+	"github.com/stackrox/infra/cmd/infractl/common"
+	v1 "github.com/stackrox/infra/generated/api/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const examples = `# Delete every cluster of env.yaml.
+$ infractl destroy -f env.yaml
+
+# Show which clusters would be deleted.
+$ infractl destroy -f env.yaml --dry-run`
+
+// Command defines the handler for infractl destroy.
+func Command() *cobra.Command {
+	// $ infractl destroy
+	cmd := &cobra.Command{
+		Use:     "destroy -f FILE",
+		Short:   "Delete a set of clusters described by a manifest",
+		Long:    "Deletes every cluster of a manifest, as used by infractl apply, that is not already destroyed",
+		Example: examples,
+		Args:    common.ArgsWithHelp(cobra.NoArgs),
+		RunE:    common.WithGRPCHandler(run),
+	}
+
+	cmd.Flags().StringP("file", "f", "", "manifest of the clusters")
+	cmd.Flags().Bool("dry-run", false, "only show which clusters would be deleted")
+	_ = cmd.MarkFlagRequired("file")
+	return cmd
+}
+
+func run(ctx context.Context, conn *grpc.ClientConn, cmd *cobra.Command, _ []string) (common.PrettyPrinter, error) {
+	manifestFile, _ := cmd.Flags().GetString("file")
+	dryRun := common.MustBool(cmd.Flags(), "dry-run")
+
+	m, err := manifest.Load(manifestFile)
+	if err != nil {
+		return nil, err
+	}
+
+	client := v1.NewClusterServiceClient(conn)
+
+	result := prettyDestroyResult{DryRun: dryRun}
+	for _, desired := range m.Clusters {
+		current, err := client.Info(ctx, &v1.ClusterRequest{Id: desired.Name})
+		if status.Code(err) == codes.NotFound {
+			result.Skipped = append(result.Skipped, desired.Name)
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to get cluster %q: %w", desired.Name, err)
+		}
+
+		switch current.GetStatus() {
+		case v1.Status_FAILED, v1.Status_DESTROYING, v1.Status_FINISHED:
+			result.Skipped = append(result.Skipped, desired.Name)
+			continue
+		}
+
+		if !dryRun {
+			if _, err := client.Delete(ctx, &v1.DeleteClusterRequest{Id: desired.Name}); err != nil {
+				return nil, fmt.Errorf("failed to delete cluster %q: %w", desired.Name, err)
+			}
+		}
+		result.Deleted = append(result.Deleted, desired.Name)
+	}
+
+	return result, nil
+}
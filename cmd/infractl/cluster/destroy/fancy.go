@@ -0,0 +1,41 @@
+package destroy
+
+import (
+	"encoding/json"
+
+	"github.com/spf13/cobra"
+)
+
+type prettyDestroyResult struct {
+	// Deleted are the IDs of the deleted clusters.
+	Deleted []string `json:"Deleted"`
+
+	// Skipped are the IDs of the clusters that did not exist, or were
+	// already destroyed.
+	Skipped []string `json:"Skipped"`
+
+	DryRun bool `json:"DryRun"`
+}
+
+func (p prettyDestroyResult) PrettyPrint(cmd *cobra.Command) {
+	verb := "Deleted"
+	if p.DryRun {
+		verb = "Would delete"
+	}
+	for _, id := range p.Deleted {
+		cmd.Printf("%s: %s\n", verb, id)
+	}
+	for _, id := range p.Skipped {
+		cmd.Printf("Skipped: %s (not running)\n", id)
+	}
+}
+
+func (p prettyDestroyResult) PrettyJSONPrint(cmd *cobra.Command) error {
+	data, err := json.MarshalIndent(p, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	cmd.Printf("%s\n", string(data))
+	return nil
+}
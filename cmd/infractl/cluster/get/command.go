@@ -13,12 +13,15 @@ import (
 )
 
 const examples = `Lookup info for the "example-s3maj" cluster.
-$ infractl get example-s3maj`
+$ infractl get example-s3maj
+
+Lookup info for the first generation of the "example-s3maj" cluster.
+$ infractl get example-s3maj --generation 1`
 
 // Command defines the handler for infractl get.
 func Command() *cobra.Command {
 	// $ infractl get
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:     "get CLUSTER",
 		Short:   "Get info for a specific cluster",
 		Long:    "Displays info for a single cluster",
@@ -26,6 +29,9 @@ func Command() *cobra.Command {
 		Args:    common.ArgsWithHelp(cobra.ExactArgs(1), args),
 		RunE:    common.WithGRPCHandler(run),
 	}
+
+	cmd.Flags().String("generation", "", "generation number or workflow name of an earlier cluster with the same ID")
+	return cmd
 }
 
 func args(_ *cobra.Command, args []string) error {
@@ -35,8 +41,9 @@ func args(_ *cobra.Command, args []string) error {
 	return utils.ValidateClusterName(args[0])
 }
 
-func run(ctx context.Context, conn *grpc.ClientConn, _ *cobra.Command, args []string) (common.PrettyPrinter, error) {
-	resp, err := v1.NewClusterServiceClient(conn).Info(ctx, &v1.ResourceByID{Id: args[0]})
+func run(ctx context.Context, conn *grpc.ClientConn, cmd *cobra.Command, args []string) (common.PrettyPrinter, error) {
+	generation, _ := cmd.Flags().GetString("generation")
+	resp, err := v1.NewClusterServiceClient(conn).Info(ctx, &v1.ClusterRequest{Id: args[0], Generation: generation})
 	if err != nil {
 		return nil, err
 	}
@@ -29,9 +29,18 @@ func (p prettyCluster) PrettyPrint(cmd *cobra.Command) {
 	cmd.Printf("ID:          %s\n", p.ID)
 	cmd.Printf("Flavor:      %s\n", p.Flavor)
 	cmd.Printf("Owner:       %s\n", p.Owner)
+	if len(p.Collaborators) > 0 {
+		cmd.Printf("Shared with: %s\n", strings.Join(p.Collaborators, ", "))
+	}
+	if len(p.Teams) > 0 {
+		cmd.Printf("Teams:       %s\n", strings.Join(p.Teams, ", "))
+	}
 	if p.Description != "" {
 		cmd.Printf("Description: %s\n", p.Description)
 	}
+	if len(p.Labels) > 0 {
+		cmd.Printf("Labels:      %s\n", common.FormatLabels(p.Labels))
+	}
 	cmd.Printf("Status:      %s\n", p.Status)
 	cmd.Printf("Created:     %v\n", common.FormatTime(createdOn))
 	if p.URL != "" {
@@ -60,7 +69,8 @@ func (p prettyCluster) PrettyJSONPrint(cmd *cobra.Command) error {
 	// because adding will mess with the pretty formatting of the output
 	checkDelete := []string{
 		"Description", "Connect", "URL", "DestroyedOn.seconds", "DestroyedOn.nanos",
-		"DestroyedOn", "CreatedOn.nanos", "Lifespan.nanos",
+		"DestroyedOn", "CreatedOn.nanos", "Lifespan.nanos", "Collaborators", "Labels",
+		"Teams",
 	}
 	var toDelete []string
 	for _, cd := range checkDelete {
@@ -88,6 +98,14 @@ func (p prettyCluster) PrettyJSONPrint(cmd *cobra.Command) error {
 			}
 		case jsonparser.Null:
 			toDelete = append(toDelete, cd)
+		case jsonparser.Array:
+			if strings.Trim(string(val), "[] \n") == "" {
+				toDelete = append(toDelete, cd)
+			}
+		case jsonparser.Object:
+			if strings.Trim(string(val), "{} \n") == "" {
+				toDelete = append(toDelete, cd)
+			}
 		}
 	}
 
@@ -33,20 +33,20 @@ import (
 
 type FakeClusterServiceClient struct {
 	v1.UnimplementedClusterServiceServer
-	infoFn      func(ctx context.Context, clusterID *v1.ResourceByID) (*v1.Cluster, error)
+	infoFn      func(ctx context.Context, req *v1.ClusterRequest) (*v1.Cluster, error)
 	listFn      func(ctx context.Context, req *v1.ClusterListRequest) (*v1.ClusterListResponse, error)
 	lifespanFn  func(ctx context.Context, req *v1.LifespanRequest) (*durationpb.Duration, error)
 	createFn    func(ctx context.Context, req *v1.CreateClusterRequest) (*v1.ResourceByID, error)
-	artifactsFn func(ctx context.Context, clusterID *v1.ResourceByID) (*v1.ClusterArtifacts, error)
-	deleteFn    func(ctx context.Context, clusterID *v1.ResourceByID) (*emptypb.Empty, error)
-	logsFn      func(ctx context.Context, clusterID *v1.ResourceByID) (*v1.LogsResponse, error)
+	artifactsFn func(ctx context.Context, req *v1.ClusterRequest) (*v1.ClusterArtifacts, error)
+	deleteFn    func(ctx context.Context, req *v1.DeleteClusterRequest) (*emptypb.Empty, error)
+	logsFn      func(ctx context.Context, req *v1.ClusterRequest) (*v1.LogsResponse, error)
 }
 
 var _ v1.ClusterServiceServer = (*FakeClusterServiceClient)(nil)
 
-func (csc *FakeClusterServiceClient) Info(ctx context.Context, clusterID *v1.ResourceByID) (*v1.Cluster, error) {
+func (csc *FakeClusterServiceClient) Info(ctx context.Context, req *v1.ClusterRequest) (*v1.Cluster, error) {
 	if csc.infoFn != nil {
-		return csc.infoFn(ctx, clusterID)
+		return csc.infoFn(ctx, req)
 	}
 
 	return nil, errors.New("this method was not set up with a response - must set infoFn")
@@ -75,23 +75,23 @@ func (csc *FakeClusterServiceClient) Create(ctx context.Context, req *v1.CreateC
 	return nil, errors.New("this method was not set up with a response - must set createFn")
 }
 
-func (csc *FakeClusterServiceClient) Artifacts(ctx context.Context, clusterID *v1.ResourceByID) (*v1.ClusterArtifacts, error) {
+func (csc *FakeClusterServiceClient) Artifacts(ctx context.Context, req *v1.ClusterRequest) (*v1.ClusterArtifacts, error) {
 	if csc.artifactsFn != nil {
-		return csc.artifactsFn(ctx, clusterID)
+		return csc.artifactsFn(ctx, req)
 	}
 	return nil, errors.New("this method was not set up with a response - must set artifactsFn")
 }
 
-func (csc *FakeClusterServiceClient) Delete(ctx context.Context, clusterID *v1.ResourceByID) (*emptypb.Empty, error) {
+func (csc *FakeClusterServiceClient) Delete(ctx context.Context, req *v1.DeleteClusterRequest) (*emptypb.Empty, error) {
 	if csc.deleteFn != nil {
-		return csc.deleteFn(ctx, clusterID)
+		return csc.deleteFn(ctx, req)
 	}
 	return nil, errors.New("this method was not set up with a response - must set deleteFn")
 }
 
-func (csc *FakeClusterServiceClient) Logs(ctx context.Context, clusterID *v1.ResourceByID) (*v1.LogsResponse, error) {
+func (csc *FakeClusterServiceClient) Logs(ctx context.Context, req *v1.ClusterRequest) (*v1.LogsResponse, error) {
 	if csc.logsFn != nil {
-		return csc.logsFn(ctx, clusterID)
+		return csc.logsFn(ctx, req)
 	}
 	return nil, errors.New("this method was not set up with a response - must set logsFn")
 }
@@ -143,7 +143,7 @@ func generateTLSCertification() (tls.Certificate, error) {
 func TestGetClusterJSONOutput(t *testing.T) {
 	testTime := timestamppb.New(time.Date(2022, time.April, 1, 1, 0, 0, 0, time.UTC))
 	csc := &FakeClusterServiceClient{
-		infoFn: func(_ context.Context, _ *v1.ResourceByID) (*v1.Cluster, error) {
+		infoFn: func(_ context.Context, _ *v1.ClusterRequest) (*v1.Cluster, error) {
 			return &v1.Cluster{
 				ID:          "test-123",
 				Status:      v1.Status_FAILED,
@@ -222,7 +222,13 @@ func TestGetClusterJSONOutput(t *testing.T) {
       "Internal": false,
       "Order": 0,
       "Help": "",
-      "FromFile": false
+      "FromFile": false,
+      "Type": "STRING",
+      "AllowedValues": [
+      ],
+      "Min": null,
+      "Max": null,
+      "Pattern": ""
     }
   ]
 }
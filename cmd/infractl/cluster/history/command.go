@@ -0,0 +1,48 @@
+// Package history implements the infractl history command.
+package history
+
+import (
+	"context"
+	"errors"
+
+	"github.com/spf13/cobra"
+	"github.com/stackrox/infra/cmd/infractl/cluster/utils"
+	"github.com/stackrox/infra/cmd/infractl/common"
+	v1 "github.com/stackrox/infra/generated/api/v1"
+	"google.golang.org/grpc"
+)
+
+const examples = `Lookup every generation of the "example-s3maj" cluster.
+$ infractl history example-s3maj
+
+Then lookup the logs of its first generation.
+$ infractl logs example-s3maj --generation 1`
+
+// Command defines the handler for infractl history.
+func Command() *cobra.Command {
+	// $ infractl history
+	return &cobra.Command{
+		Use:     "history CLUSTER",
+		Short:   "Get the history of a specific cluster",
+		Long:    "Displays every generation of a cluster, as a cluster ID can be reused once an earlier cluster failed or finished",
+		Example: examples,
+		Args:    common.ArgsWithHelp(cobra.ExactArgs(1), args),
+		RunE:    common.WithGRPCHandler(run),
+	}
+}
+
+func args(_ *cobra.Command, args []string) error {
+	if args[0] == "" {
+		return errors.New("no cluster ID given")
+	}
+	return utils.ValidateClusterName(args[0])
+}
+
+func run(ctx context.Context, conn *grpc.ClientConn, _ *cobra.Command, args []string) (common.PrettyPrinter, error) {
+	resp, err := v1.NewClusterServiceClient(conn).History(ctx, &v1.ResourceByID{Id: args[0]})
+	if err != nil {
+		return nil, err
+	}
+
+	return prettyClusterHistoryResponse{resp}, nil
+}
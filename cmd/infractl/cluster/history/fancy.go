@@ -0,0 +1,47 @@
+package history
+
+import (
+	"encoding/json"
+
+	"github.com/spf13/cobra"
+
+	"github.com/stackrox/infra/cmd/infractl/common"
+	v1 "github.com/stackrox/infra/generated/api/v1"
+)
+
+type prettyClusterHistoryResponse struct {
+	*v1.ClusterHistoryResponse
+}
+
+func (p prettyClusterHistoryResponse) PrettyPrint(cmd *cobra.Command) {
+	for _, generation := range p.GetGenerations() {
+		cluster := generation.GetCluster()
+		cmd.Printf("Generation %d (%s)\n", generation.GetGeneration(), generation.GetWorkflowName())
+		cmd.Printf("  Flavor:     %s\n", cluster.GetFlavor())
+		cmd.Printf("  Owner:      %s\n", cluster.GetOwner())
+		cmd.Printf("  Status:     %s\n", cluster.GetStatus())
+		cmd.Printf("  Created:    %s\n", common.FormatTime(cluster.GetCreatedOn().AsTime()))
+		if cluster.GetDestroyedOn() != nil {
+			cmd.Printf("  Destroyed:  %s\n", common.FormatTime(cluster.GetDestroyedOn().AsTime()))
+		}
+		if generation.GetFailureDetails() != "" {
+			cmd.Printf("  Failure:    %s\n", generation.GetFailureDetails())
+		}
+		if len(cluster.GetParameters()) > 0 {
+			cmd.Printf("  Parameters:\n")
+			for _, parameter := range cluster.GetParameters() {
+				cmd.Printf("    %s: %s\n", parameter.GetName(), parameter.GetValue())
+			}
+		}
+	}
+}
+
+func (p prettyClusterHistoryResponse) PrettyJSONPrint(cmd *cobra.Command) error {
+	data, err := json.MarshalIndent(p.ClusterHistoryResponse, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	cmd.Printf("%s\n", string(data))
+	return nil
+}
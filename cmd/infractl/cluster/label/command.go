@@ -0,0 +1,81 @@
+// Package label implements the infractl label command.
+package label
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/stackrox/infra/cmd/infractl/cluster/utils"
+	"github.com/stackrox/infra/cmd/infractl/common"
+	v1 "github.com/stackrox/infra/generated/api/v1"
+	"google.golang.org/grpc"
+)
+
+const examples = `# Label cluster "example-s3maj" with a team and a ticket.
+$ infractl label example-s3maj team=sre ticket=ROX-1234
+
+# Remove the ticket label of cluster "example-s3maj".
+$ infractl label example-s3maj ticket-`
+
+// Command defines the handler for infractl label.
+func Command() *cobra.Command {
+	// $ infractl label
+	return &cobra.Command{
+		Use:     "label CLUSTER KEY=VALUE|KEY- ...",
+		Short:   "Update the labels of a specific cluster",
+		Long:    "Adds, replaces or removes (with a trailing dash) the labels of a specific cluster",
+		Example: examples,
+		Args:    common.ArgsWithHelp(cobra.MinimumNArgs(2), args),
+		RunE:    common.WithGRPCHandler(run),
+	}
+}
+
+func args(_ *cobra.Command, args []string) error {
+	if args[0] == "" {
+		return errors.New("no cluster ID given")
+	}
+	if err := utils.ValidateClusterName(args[0]); err != nil {
+		return err
+	}
+	_, _, err := parseLabelUpdates(args[1:])
+	return err
+}
+
+func run(ctx context.Context, conn *grpc.ClientConn, _ *cobra.Command, args []string) (common.PrettyPrinter, error) {
+	set, remove, err := parseLabelUpdates(args[1:])
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := v1.NewClusterServiceClient(conn).UpdateLabels(ctx, &v1.UpdateLabelsRequest{
+		Id:     args[0],
+		Set:    set,
+		Remove: remove,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return prettyCluster{resp}, nil
+}
+
+// parseLabelUpdates splits the given arguments into the labels to set, given
+// as key=value, and the names of the labels to remove, given as key-.
+func parseLabelUpdates(updates []string) (map[string]string, []string, error) {
+	var setArgs, remove []string
+	for _, update := range updates {
+		if name, found := strings.CutSuffix(update, "-"); found && !strings.Contains(update, "=") {
+			remove = append(remove, name)
+			continue
+		}
+		setArgs = append(setArgs, update)
+	}
+
+	set, err := utils.ParseLabels(setArgs)
+	if err != nil {
+		return nil, nil, err
+	}
+	return set, remove, nil
+}
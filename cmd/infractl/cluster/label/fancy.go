@@ -0,0 +1,29 @@
+package label
+
+import (
+	"encoding/json"
+
+	"github.com/spf13/cobra"
+
+	"github.com/stackrox/infra/cmd/infractl/common"
+	v1 "github.com/stackrox/infra/generated/api/v1"
+)
+
+type prettyCluster struct {
+	*v1.Cluster
+}
+
+func (p prettyCluster) PrettyPrint(cmd *cobra.Command) {
+	cmd.Printf("ID:     %s\n", p.GetID())
+	cmd.Printf("Labels: %s\n", common.FormatLabels(p.GetLabels()))
+}
+
+func (p prettyCluster) PrettyJSONPrint(cmd *cobra.Command) error {
+	data, err := json.MarshalIndent(p.Cluster, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	cmd.Printf("%s\n", string(data))
+	return nil
+}
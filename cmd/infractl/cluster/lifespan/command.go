@@ -27,55 +27,118 @@ infractl lifespan example-s3maj -- -30m
 infractl lifespan example-s3maj =24h
 
 # Expire cluster example-s3maj.
-infractl lifespan example-s3maj =0`
+infractl lifespan example-s3maj =0
+
+# Add an hour to clusters example-s3maj and example-k2dfw.
+infractl lifespan example-s3maj example-k2dfw +1h
+
+# Add an hour to every one of your clusters labeled with team=sre.
+infractl lifespan --selector team=sre +1h
+
+# Expire cluster example-s3maj that is owned by someone else.
+infractl lifespan example-s3maj =0 --force --reason "left running over the weekend"`
 
 // Command defines the handler for infractl lifespan.
 func Command() *cobra.Command {
 	// $ infractl lifespan
-	return &cobra.Command{
-		Use:     "lifespan CLUSTER DURATION",
+	cmd := &cobra.Command{
+		Use:     "lifespan CLUSTER [CLUSTER...] DURATION",
 		Short:   "Update cluster lifespan",
 		Long:    "Lifespan updates the cluster lifespan",
 		Example: examples,
-		Args:    common.ArgsWithHelp(cobra.ExactArgs(2), args),
+		Args:    common.ArgsWithHelp(cobra.MinimumNArgs(1), args),
 		RunE:    common.WithGRPCHandler(run),
 	}
+
+	cmd.Flags().Bool("force", false, "update the lifespan of a cluster that you do not own")
+	cmd.Flags().String("reason", "", "why the lifespan is updated by force, sent to the owner")
+	cmd.Flags().StringP("selector", "l", "", "update the clusters whose labels match this selector instead, e.g. team=sre")
+	cmd.Flags().Bool("all", false, "with --selector, include clusters not owned by you")
+	cmd.Flags().Bool("dry-run", false, "only show which clusters would be updated")
+	return cmd
 }
 
-func args(_ *cobra.Command, args []string) error {
-	if args[0] == "" {
+func args(cmd *cobra.Command, args []string) error {
+	clusterIDs, spec := args[:len(args)-1], args[len(args)-1]
+	selector := cmd.Flag("selector").Value.String()
+	switch {
+	case selector == "" && len(clusterIDs) == 0:
 		return errors.New("no cluster ID given")
+	case selector != "" && len(clusterIDs) > 0:
+		return errors.New("cluster IDs cannot be given with --selector")
 	}
-	if err := utils.ValidateClusterName(args[0]); err != nil {
-		return err
+	if common.MustBool(cmd.Flags(), "force") && cmd.Flag("reason").Value.String() == "" {
+		return errors.New("a reason is required with --force")
+	}
+	for _, clusterID := range clusterIDs {
+		if clusterID == "" {
+			return errors.New("no cluster ID given")
+		}
+		if err := utils.ValidateClusterName(clusterID); err != nil {
+			return err
+		}
 	}
 
-	if args[1] == "" {
+	if spec == "" {
 		return errors.New("no duration given")
 	}
-	_, lifespan, err := parseDuration(args[1])
+	_, lifespan, err := parseDuration(spec)
 	if err != nil {
 		return err
 	}
 	return utils.ValidateLifespan(lifespan)
 }
 
-func run(ctx context.Context, conn *grpc.ClientConn, _ *cobra.Command, args []string) (common.PrettyPrinter, error) {
-	method, lifespan, err := parseDuration(args[1])
+func run(ctx context.Context, conn *grpc.ClientConn, cmd *cobra.Command, args []string) (common.PrettyPrinter, error) {
+	clusterIDs, spec := args[:len(args)-1], args[len(args)-1]
+	selector := cmd.Flag("selector").Value.String()
+	dryRun := common.MustBool(cmd.Flags(), "dry-run")
+
+	method, lifespan, err := parseDuration(spec)
 	if err != nil {
 		return nil, err
 	}
 
-	resp, err := v1.NewClusterServiceClient(conn).Lifespan(ctx, &v1.LifespanRequest{
-		Id:       args[0],
+	client := v1.NewClusterServiceClient(conn)
+
+	// A single cluster is updated as before, so that older servers are
+	// supported.
+	if len(clusterIDs) == 1 && !dryRun {
+		resp, err := client.Lifespan(ctx, &v1.LifespanRequest{
+			Id:       clusterIDs[0],
+			Lifespan: durationpb.New(lifespan),
+			Method:   method,
+			Force:    common.MustBool(cmd.Flags(), "force"),
+			Reason:   cmd.Flag("reason").Value.String(),
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		return prettyDuration{resp}, nil
+	}
+
+	req := v1.BulkLifespanRequest{
+		Ids:      clusterIDs,
+		DryRun:   dryRun,
 		Lifespan: durationpb.New(lifespan),
 		Method:   method,
-	})
+		Force:    common.MustBool(cmd.Flags(), "force"),
+		Reason:   cmd.Flag("reason").Value.String(),
+	}
+	if selector != "" {
+		req.Filter = &v1.ClusterListRequest{
+			All:           common.MustBool(cmd.Flags(), "all"),
+			LabelSelector: selector,
+		}
+	}
+
+	resp, err := client.BulkLifespan(ctx, &req)
 	if err != nil {
 		return nil, err
 	}
 
-	return prettyDuration{resp}, nil
+	return prettyBulkResponse{resp}, nil
 }
 
 func parseDuration(spec string) (v1.LifespanRequest_Method, time.Duration, error) {
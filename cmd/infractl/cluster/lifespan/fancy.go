@@ -7,6 +7,7 @@ import (
 	"google.golang.org/protobuf/types/known/durationpb"
 
 	"github.com/stackrox/infra/cmd/infractl/common"
+	v1 "github.com/stackrox/infra/generated/api/v1"
 )
 
 type prettyDuration struct {
@@ -27,3 +28,41 @@ func (p prettyDuration) PrettyJSONPrint(cmd *cobra.Command) error {
 	cmd.Printf("%s\n", string(data))
 	return nil
 }
+
+type prettyBulkResponse struct {
+	*v1.BulkResponse
+}
+
+var _ common.FailureReporter = prettyBulkResponse{}
+
+func (p prettyBulkResponse) PrettyPrint(cmd *cobra.Command) {
+	if len(p.Results) == 0 {
+		cmd.Println("No clusters selected")
+		return
+	}
+
+	for _, result := range p.Results {
+		switch {
+		case result.Error != "":
+			cmd.Printf("%s: failed: %s\n", result.Id, result.Error)
+		case p.DryRun:
+			cmd.Printf("%s: (dry run)\n", result.Id)
+		default:
+			cmd.Printf("%s: %s\n", result.Id, common.FormatExpiration(result.Lifespan.AsDuration()))
+		}
+	}
+}
+
+func (p prettyBulkResponse) PrettyJSONPrint(cmd *cobra.Command) error {
+	data, err := json.MarshalIndent(p.BulkResponse, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	cmd.Printf("%s\n", string(data))
+	return nil
+}
+
+func (p prettyBulkResponse) Failure() error {
+	return common.BulkFailure(p.BulkResponse)
+}
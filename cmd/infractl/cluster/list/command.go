@@ -29,6 +29,9 @@ $ infractl list --flavor=<flavor> [--flavor=<another>]
 $ List only clusters with specified status(es).
 $ infractl list --status=<status> [--status=<another>]
 
+# List only clusters whose labels match a selector.
+$ infractl list --all --selector team=sre,ticket
+
 # List only the names of clusters
 $ infractl list --quiet`
 
@@ -50,6 +53,7 @@ func Command() *cobra.Command {
 	cmd.Flags().String("prefix", "", "only include clusters whose names matches this prefix")
 	cmd.Flags().StringSlice("flavor", []string{}, "only include clusters with matching flavor(s)")
 	cmd.Flags().StringSlice("status", []string{}, "only include clusters with matching status(es)")
+	cmd.Flags().StringP("selector", "l", "", "only include clusters whose labels match this selector, e.g. team=sre,ticket")
 	return cmd
 }
 
@@ -60,6 +64,7 @@ func run(ctx context.Context, conn *grpc.ClientConn, cmd *cobra.Command, _ []str
 	prefix, _ := cmd.Flags().GetString("prefix")
 	allowedFlavors, _ := cmd.Flags().GetStringSlice("flavor")
 	allowedStatuses, _ := cmd.Flags().GetStringSlice("status")
+	labelSelector, _ := cmd.Flags().GetString("selector")
 
 	protoAllowedStatuses := make([]v1.Status, len(allowedStatuses))
 	for i, s := range allowedStatuses {
@@ -76,6 +81,7 @@ func run(ctx context.Context, conn *grpc.ClientConn, cmd *cobra.Command, _ []str
 		Prefix:          prefix,
 		AllowedFlavors:  allowedFlavors,
 		AllowedStatuses: protoAllowedStatuses,
+		LabelSelector:   labelSelector,
 	}
 
 	resp, err := v1.NewClusterServiceClient(conn).List(ctx, &req)
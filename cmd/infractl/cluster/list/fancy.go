@@ -29,6 +29,9 @@ func (p prettyClusterListResponse) PrettyPrint(cmd *cobra.Command) {
 			cmd.Printf("  Flavor:      %s\n", cluster.GetFlavor())
 			cmd.Printf("  Owner:       %s\n", cluster.GetOwner())
 			cmd.Printf("  Description: %s\n", cluster.GetDescription())
+			if len(cluster.GetLabels()) > 0 {
+				cmd.Printf("  Labels:      %s\n", common.FormatLabels(cluster.GetLabels()))
+			}
 			cmd.Printf("  Status:      %s\n", cluster.GetStatus())
 			cmd.Printf("  Created:     %v\n", common.FormatTime(createdOn))
 			if destroyedOn.Unix() != 0 {
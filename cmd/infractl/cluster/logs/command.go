@@ -13,12 +13,21 @@ import (
 )
 
 const examples = `Lookup logs for the "example-s3maj" cluster.
-$ infractl logs example-s3maj`
+$ infractl logs example-s3maj
+
+Follow logs for the "example-s3maj" cluster while it is being created.
+$ infractl logs example-s3maj --follow
+
+Follow logs for a single step of the "example-s3maj" cluster.
+$ infractl logs example-s3maj --follow --step create
+
+Lookup logs for the first generation of the "example-s3maj" cluster.
+$ infractl logs example-s3maj --generation 1`
 
 // Command defines the handler for infractl logs.
 func Command() *cobra.Command {
 	// $ infractl logs
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:     "logs CLUSTER",
 		Short:   "Get logs for a specific cluster",
 		Long:    "Displays logs for a single cluster",
@@ -26,22 +35,56 @@ func Command() *cobra.Command {
 		Args:    common.ArgsWithHelp(cobra.ExactArgs(1), args),
 		RunE:    common.WithGRPCHandler(run),
 	}
+
+	cmd.Flags().BoolP("follow", "f", false, "follow the logs as they are produced")
+	cmd.Flags().String("step", "", "only show logs for the workflow step with this name")
+	cmd.Flags().String("generation", "", "generation number or workflow name of an earlier cluster with the same ID")
+	return cmd
 }
 
-func args(_ *cobra.Command, args []string) error {
+func args(cmd *cobra.Command, args []string) error {
 	if args[0] == "" {
 		return errors.New("no cluster ID given")
 	}
+	if generation, _ := cmd.Flags().GetString("generation"); generation != "" && common.MustBool(cmd.Flags(), "follow") {
+		return errors.New("--generation cannot be used with --follow")
+	}
 	return utils.ValidateClusterName(args[0])
 }
 
-func run(ctx context.Context, conn *grpc.ClientConn, _ *cobra.Command, args []string) (common.PrettyPrinter, error) {
-	req := v1.ResourceByID{Id: args[0]}
+func run(ctx context.Context, conn *grpc.ClientConn, cmd *cobra.Command, args []string) (common.PrettyPrinter, error) {
+	follow := common.MustBool(cmd.Flags(), "follow")
+	step, _ := cmd.Flags().GetString("step")
+	generation, _ := cmd.Flags().GetString("generation")
+
+	if follow {
+		// Following logs can take much longer than the --timeout for API
+		// requests, so don't bind the stream to that deadline.
+		req := v1.StreamLogsRequest{Id: args[0], Step: step}
+		stream, err := v1.NewClusterServiceClient(conn).StreamLogs(context.Background(), &req)
+		if err != nil {
+			return nil, err
+		}
+
+		return &prettyLogStream{ClusterService_StreamLogsClient: stream}, nil
+	}
+
+	req := v1.ClusterRequest{Id: args[0], Generation: generation}
 
 	resp, err := v1.NewClusterServiceClient(conn).Logs(ctx, &req)
 	if err != nil {
 		return nil, err
 	}
 
+	if step != "" {
+		logs := make([]*v1.Log, 0, len(resp.Logs))
+		for _, log := range resp.Logs {
+			if log.Name == step {
+				logs = append(logs, log)
+			}
+		}
+		resp.Logs = logs
+	}
+
 	return prettyLogsResponse{resp}, nil
 }
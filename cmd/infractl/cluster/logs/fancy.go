@@ -2,10 +2,13 @@ package logs
 
 import (
 	"encoding/json"
+	"errors"
+	"io"
 	"strings"
 
 	"github.com/spf13/cobra"
 
+	"github.com/stackrox/infra/cmd/infractl/common"
 	v1 "github.com/stackrox/infra/generated/api/v1"
 )
 
@@ -31,3 +34,58 @@ func (p prettyLogsResponse) PrettyJSONPrint(cmd *cobra.Command) error {
 	cmd.Printf("%s\n", string(data))
 	return nil
 }
+
+// prettyLogStream renders log chunks as they are received from the server.
+type prettyLogStream struct {
+	v1.ClusterService_StreamLogsClient
+
+	// err is the error that ended the stream while it was pretty printed.
+	err error
+}
+
+var _ common.FailureReporter = &prettyLogStream{}
+
+func (p *prettyLogStream) PrettyPrint(cmd *cobra.Command) {
+	p.err = p.each(func(chunk *v1.LogChunk) error {
+		cmd.Printf("%s | %s", chunk.Name, string(chunk.Body))
+		if !strings.HasSuffix(string(chunk.Body), "\n") {
+			cmd.Println()
+		}
+		return nil
+	})
+}
+
+// Failure implements common.FailureReporter, so that the command exits with
+// an error if the stream broke off before the logs were complete.
+func (p *prettyLogStream) Failure() error {
+	return p.err
+}
+
+func (p *prettyLogStream) PrettyJSONPrint(cmd *cobra.Command) error {
+	return p.each(func(chunk *v1.LogChunk) error {
+		data, err := json.Marshal(chunk)
+		if err != nil {
+			return err
+		}
+
+		cmd.Printf("%s\n", string(data))
+		return nil
+	})
+}
+
+// each calls fn for every chunk received until the stream ends.
+func (p *prettyLogStream) each(fn func(chunk *v1.LogChunk) error) error {
+	for {
+		chunk, err := p.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		if err := fn(chunk); err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,226 @@
+// Package manifest implements the manifest format of the infractl apply and
+// destroy commands, which describes a set of clusters.
+package manifest
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/ghodss/yaml"
+	"github.com/pkg/errors"
+	"github.com/stackrox/infra/cmd/infractl/cluster/utils"
+	v1 "github.com/stackrox/infra/generated/api/v1"
+	"github.com/stackrox/infra/pkg/config"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// defaultLifespan is the lifespan of clusters that do not define one, and
+// matches the default of infractl create.
+const defaultLifespan = 3 * time.Hour
+
+// Manifest represents a set of clusters.
+//
+// An example manifest:
+//
+//	clusters:
+//	  - name: ci-gke
+//	    flavor: gke-default
+//	    description: integration tests
+//	    lifespan: 8h
+//	    labels:
+//	      team: sre
+//	    args:
+//	      nodes: "5"
+//	  - name: ci-ocp
+//	    flavor: openshift-4
+//	    description: integration tests
+//	    lifespan: 8h
+//	    noSlack: true
+type Manifest struct {
+	Clusters []Cluster `json:"clusters"`
+}
+
+// Cluster represents a single cluster of a manifest.
+type Cluster struct {
+	// Name is the ID of the cluster.
+	Name string `json:"name"`
+
+	// Flavor is the ID of the flavor that the cluster is created from.
+	Flavor string `json:"flavor"`
+
+	// Args are the flavor parameters of the cluster.
+	Args map[string]string `json:"args"`
+
+	// Description is a human readable description for the cluster.
+	Description string `json:"description"`
+
+	// Labels are user-defined labels, such as a team, ticket or CI run ID.
+	Labels map[string]string `json:"labels"`
+
+	// Lifespan is the minimum lifespan of the cluster. Defaults to 3 hours.
+	Lifespan config.JSONDuration `json:"lifespan"`
+
+	// NoSlack skips sending Slack messages for lifecycle events.
+	NoSlack bool `json:"noSlack"`
+
+	// SlackDM sends Slack messages directly to the owner.
+	SlackDM bool `json:"slackDM"`
+}
+
+// Load reads and validates the manifest in the named file.
+func Load(path string) (*Manifest, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read manifest")
+	}
+
+	return Parse(data)
+}
+
+// Parse parses and validates a manifest.
+func Parse(data []byte) (*Manifest, error) {
+	var manifest Manifest
+	if err := yaml.Unmarshal(data, &manifest); err != nil {
+		return nil, errors.Wrap(err, "failed to parse manifest")
+	}
+
+	if len(manifest.Clusters) == 0 {
+		return nil, errors.New("manifest defines no clusters")
+	}
+
+	names := make(map[string]struct{}, len(manifest.Clusters))
+	for i, cluster := range manifest.Clusters {
+		if err := utils.ValidateClusterName(cluster.Name); err != nil {
+			return nil, fmt.Errorf("cluster %d: %v", i+1, err)
+		}
+		if _, found := names[cluster.Name]; found {
+			return nil, fmt.Errorf("cluster %q is defined more than once", cluster.Name)
+		}
+		names[cluster.Name] = struct{}{}
+
+		if cluster.Flavor == "" {
+			return nil, fmt.Errorf("cluster %q: no flavor given", cluster.Name)
+		}
+		if cluster.Lifespan < 0 {
+			return nil, fmt.Errorf("cluster %q: lifespan must not be negative", cluster.Name)
+		}
+		if cluster.Lifespan == 0 {
+			manifest.Clusters[i].Lifespan = config.JSONDuration(defaultLifespan)
+		}
+		if err := utils.ValidateLifespan(manifest.Clusters[i].Lifespan.Duration()); err != nil {
+			return nil, fmt.Errorf("cluster %q: %v", cluster.Name, err)
+		}
+	}
+
+	return &manifest, nil
+}
+
+// CreateRequest returns the request that creates the cluster.
+func (c Cluster) CreateRequest() *v1.CreateClusterRequest {
+	req := &v1.CreateClusterRequest{
+		ID:          c.Flavor,
+		Parameters:  map[string]string{"name": c.Name},
+		Lifespan:    durationpb.New(c.Lifespan.Duration()),
+		Description: c.Description,
+		NoSlack:     c.NoSlack,
+		SlackDM:     c.SlackDM,
+		Labels:      c.Labels,
+	}
+	for name, value := range c.Args {
+		req.Parameters[name] = value
+	}
+
+	return req
+}
+
+// Action is what is done to reconcile a cluster with its manifest.
+type Action string
+
+const (
+	// ActionCreate creates a cluster that does not exist, or whose most
+	// recent generation failed or finished.
+	ActionCreate Action = "create"
+
+	// ActionExtend extends the lifespan of a cluster to the one of its
+	// manifest.
+	ActionExtend Action = "extend"
+
+	// ActionNone leaves a cluster as is.
+	ActionNone Action = "none"
+)
+
+// Plan is how a cluster is reconciled with its manifest.
+type Plan struct {
+	Action Action
+
+	// Drift describes every difference between the cluster and its manifest
+	// that cannot be reconciled without recreating the cluster.
+	Drift []string
+}
+
+// PlanCluster determines how the given current state of a cluster, which is
+// nil for a cluster that does not exist, is reconciled with its manifest.
+func PlanCluster(desired Cluster, current *v1.Cluster) Plan {
+	if current == nil {
+		return Plan{Action: ActionCreate}
+	}
+
+	switch current.GetStatus() {
+	case v1.Status_FAILED, v1.Status_FINISHED:
+		return Plan{Action: ActionCreate}
+	case v1.Status_DESTROYING:
+		return Plan{Action: ActionNone, Drift: []string{"cluster is being destroyed, apply again once it finished"}}
+	}
+
+	plan := Plan{Action: ActionNone}
+	if current.GetLifespan().AsDuration() < desired.Lifespan.Duration() {
+		plan.Action = ActionExtend
+	}
+
+	if current.GetFlavor() != desired.Flavor {
+		plan.Drift = append(plan.Drift, fmt.Sprintf("flavor is %q instead of %q", current.GetFlavor(), desired.Flavor))
+	}
+	if current.GetDescription() != desired.Description {
+		plan.Drift = append(plan.Drift, fmt.Sprintf("description is %q instead of %q", current.GetDescription(), desired.Description))
+	}
+
+	for _, name := range sortedKeys(desired.Labels) {
+		value, found := current.GetLabels()[name]
+		switch {
+		case !found:
+			plan.Drift = append(plan.Drift, fmt.Sprintf("label %q is not set", name))
+		case value != desired.Labels[name]:
+			plan.Drift = append(plan.Drift, fmt.Sprintf("label %q is %q instead of %q", name, value, desired.Labels[name]))
+		}
+	}
+
+	// Only the parameters of the manifest are compared, as clusters also
+	// have parameters that are defaulted or hardcoded by their flavor.
+	parameters := make(map[string]string, len(current.GetParameters()))
+	for _, parameter := range current.GetParameters() {
+		parameters[parameter.GetName()] = parameter.GetValue()
+	}
+	for _, name := range sortedKeys(desired.Args) {
+		value, found := parameters[name]
+		switch {
+		case !found:
+			plan.Drift = append(plan.Drift, fmt.Sprintf("arg %q is not set", name))
+		case value != desired.Args[name]:
+			plan.Drift = append(plan.Drift, fmt.Sprintf("arg %q is %q instead of %q", name, value, desired.Args[name]))
+		}
+	}
+
+	return plan
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
@@ -0,0 +1,194 @@
+package manifest
+
+import (
+	"testing"
+	"time"
+
+	v1 "github.com/stackrox/infra/generated/api/v1"
+	"github.com/stackrox/infra/pkg/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name     string
+		data     string
+		expected []Cluster
+		error    string
+	}{
+		{
+			name: "valid",
+			data: `
+clusters:
+  - name: ci-gke
+    flavor: gke-default
+    description: integration tests
+    lifespan: 8h
+    labels:
+      team: sre
+    args:
+      nodes: "5"
+  - name: ci-ocp
+    flavor: openshift-4
+    noSlack: true
+`,
+			expected: []Cluster{
+				{
+					Name:        "ci-gke",
+					Flavor:      "gke-default",
+					Description: "integration tests",
+					Lifespan:    config.JSONDuration(8 * time.Hour),
+					Labels:      map[string]string{"team": "sre"},
+					Args:        map[string]string{"nodes": "5"},
+				},
+				{
+					Name:     "ci-ocp",
+					Flavor:   "openshift-4",
+					Lifespan: config.JSONDuration(3 * time.Hour),
+					NoSlack:  true,
+				},
+			},
+		},
+		{
+			name:  "no clusters",
+			data:  `clusters: []`,
+			error: "manifest defines no clusters",
+		},
+		{
+			name: "duplicate name",
+			data: `
+clusters:
+  - {name: ci-gke, flavor: gke-default}
+  - {name: ci-gke, flavor: gke-default}
+`,
+			error: `cluster "ci-gke" is defined more than once`,
+		},
+		{
+			name:  "missing flavor",
+			data:  `clusters: [{name: ci-gke}]`,
+			error: `cluster "ci-gke": no flavor given`,
+		},
+		{
+			name:  "invalid name",
+			data:  `clusters: [{name: ci, flavor: gke-default}]`,
+			error: "cluster 1: cluster name too short",
+		},
+		{
+			name:  "invalid lifespan",
+			data:  `clusters: [{name: ci-gke, flavor: gke-default, lifespan: forever}]`,
+			error: "failed to parse manifest",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			manifest, err := Parse([]byte(test.data))
+			if test.error != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), test.error)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, manifest.Clusters)
+		})
+	}
+}
+
+func TestPlanCluster(t *testing.T) {
+	desired := Cluster{
+		Name:        "ci-gke",
+		Flavor:      "gke-default",
+		Description: "integration tests",
+		Lifespan:    config.JSONDuration(8 * time.Hour),
+		Labels:      map[string]string{"team": "sre"},
+		Args:        map[string]string{"nodes": "5"},
+	}
+
+	matching := func(status v1.Status, lifespan time.Duration) *v1.Cluster {
+		return &v1.Cluster{
+			ID:          "ci-gke",
+			Status:      status,
+			Flavor:      "gke-default",
+			Description: "integration tests",
+			Lifespan:    durationpb.New(lifespan),
+			Labels:      map[string]string{"team": "sre", "ci-run": "42"},
+			Parameters: []*v1.Parameter{
+				{Name: "name", Value: "ci-gke"},
+				{Name: "nodes", Value: "5"},
+			},
+		}
+	}
+
+	tests := []struct {
+		name     string
+		current  *v1.Cluster
+		expected Plan
+	}{
+		{
+			name:     "missing",
+			current:  nil,
+			expected: Plan{Action: ActionCreate},
+		},
+		{
+			name:     "failed",
+			current:  matching(v1.Status_FAILED, 8*time.Hour),
+			expected: Plan{Action: ActionCreate},
+		},
+		{
+			name:     "finished",
+			current:  matching(v1.Status_FINISHED, 8*time.Hour),
+			expected: Plan{Action: ActionCreate},
+		},
+		{
+			name:    "destroying",
+			current: matching(v1.Status_DESTROYING, 8*time.Hour),
+			expected: Plan{
+				Action: ActionNone,
+				Drift:  []string{"cluster is being destroyed, apply again once it finished"},
+			},
+		},
+		{
+			name:     "up to date",
+			current:  matching(v1.Status_READY, 8*time.Hour),
+			expected: Plan{Action: ActionNone},
+		},
+		{
+			name:     "longer lifespan",
+			current:  matching(v1.Status_READY, 24*time.Hour),
+			expected: Plan{Action: ActionNone},
+		},
+		{
+			name:     "shorter lifespan",
+			current:  matching(v1.Status_CREATING, 3*time.Hour),
+			expected: Plan{Action: ActionExtend},
+		},
+		{
+			name: "drifted",
+			current: &v1.Cluster{
+				ID:          "ci-gke",
+				Status:      v1.Status_READY,
+				Flavor:      "gke-large",
+				Description: "",
+				Lifespan:    durationpb.New(8 * time.Hour),
+				Parameters:  []*v1.Parameter{{Name: "nodes", Value: "3"}},
+			},
+			expected: Plan{
+				Action: ActionNone,
+				Drift: []string{
+					`flavor is "gke-large" instead of "gke-default"`,
+					`description is "" instead of "integration tests"`,
+					`label "team" is not set`,
+					`arg "nodes" is "3" instead of "5"`,
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, PlanCluster(desired, test.current))
+		})
+	}
+}
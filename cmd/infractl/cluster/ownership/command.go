@@ -0,0 +1,72 @@
+// Package ownership implements the infractl ownership command.
+package ownership
+
+import (
+	"context"
+	"errors"
+
+	"github.com/spf13/cobra"
+	"github.com/stackrox/infra/cmd/infractl/cluster/utils"
+	"github.com/stackrox/infra/cmd/infractl/common"
+	v1 "github.com/stackrox/infra/generated/api/v1"
+	"google.golang.org/grpc"
+)
+
+const examples = `# Hand cluster example-s3maj over to a teammate.
+$ infractl ownership example-s3maj --owner teammate@redhat.com
+
+# Share cluster example-s3maj with a collaborator.
+$ infractl ownership example-s3maj --add collaborator@redhat.com
+
+# Stop sharing cluster example-s3maj with a collaborator.
+$ infractl ownership example-s3maj --remove collaborator@redhat.com`
+
+// Command defines the handler for infractl ownership.
+func Command() *cobra.Command {
+	// $ infractl ownership
+	cmd := &cobra.Command{
+		Use:     "ownership CLUSTER",
+		Short:   "Update cluster owner and collaborators",
+		Long:    "Ownership transfers a cluster to a new owner and manages its collaborators",
+		Example: examples,
+		Args:    common.ArgsWithHelp(cobra.ExactArgs(1), args),
+		RunE:    common.WithGRPCHandler(run),
+	}
+
+	cmd.Flags().String("owner", "", "email address of the new owner")
+	cmd.Flags().StringArray("add", nil, "email address of a collaborator to add")
+	cmd.Flags().StringArray("remove", nil, "email address of a collaborator to remove")
+	return cmd
+}
+
+func args(cmd *cobra.Command, args []string) error {
+	if args[0] == "" {
+		return errors.New("no cluster ID given")
+	}
+	if err := utils.ValidateClusterName(args[0]); err != nil {
+		return err
+	}
+
+	if !cmd.Flags().Changed("owner") && !cmd.Flags().Changed("add") && !cmd.Flags().Changed("remove") {
+		return errors.New("at least one of --owner, --add or --remove must be given")
+	}
+	return nil
+}
+
+func run(ctx context.Context, conn *grpc.ClientConn, cmd *cobra.Command, args []string) (common.PrettyPrinter, error) {
+	owner, _ := cmd.Flags().GetString("owner")
+	add, _ := cmd.Flags().GetStringArray("add")
+	remove, _ := cmd.Flags().GetStringArray("remove")
+
+	resp, err := v1.NewClusterServiceClient(conn).UpdateOwnership(ctx, &v1.UpdateOwnershipRequest{
+		Id:                  args[0],
+		Owner:               owner,
+		AddCollaborators:    add,
+		RemoveCollaborators: remove,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return prettyCluster{resp}, nil
+}
@@ -0,0 +1,32 @@
+package ownership
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	v1 "github.com/stackrox/infra/generated/api/v1"
+)
+
+type prettyCluster struct {
+	*v1.Cluster
+}
+
+func (p prettyCluster) PrettyPrint(cmd *cobra.Command) {
+	cmd.Printf("ID:          %s\n", p.GetID())
+	cmd.Printf("Owner:       %s\n", p.GetOwner())
+	if len(p.GetCollaborators()) > 0 {
+		cmd.Printf("Shared with: %s\n", strings.Join(p.GetCollaborators(), ", "))
+	}
+}
+
+func (p prettyCluster) PrettyJSONPrint(cmd *cobra.Command) error {
+	data, err := json.MarshalIndent(p.Cluster, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	cmd.Printf("%s\n", string(data))
+	return nil
+}
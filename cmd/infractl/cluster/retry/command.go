@@ -0,0 +1,60 @@
+// Package retry implements the infractl retry command.
+package retry
+
+import (
+	"context"
+	"errors"
+
+	"github.com/spf13/cobra"
+	"github.com/stackrox/infra/cmd/infractl/cluster/utils"
+	"github.com/stackrox/infra/cmd/infractl/common"
+	v1 "github.com/stackrox/infra/generated/api/v1"
+	"google.golang.org/grpc"
+)
+
+const examples = `# Retry the failed cluster "example-s3maj" from its failed steps.
+$ infractl retry example-s3maj
+
+# Retry the failed cluster "example-s3maj" and wait for it to be ready.
+$ infractl retry example-s3maj --wait`
+
+// Command defines the handler for infractl retry.
+func Command() *cobra.Command {
+	// $ infractl retry
+	cmd := &cobra.Command{
+		Use:     "retry CLUSTER",
+		Short:   "Retry a failed cluster",
+		Long:    "Retries a failed cluster from its failed steps, instead of provisioning it again from scratch",
+		Example: examples,
+		Args:    common.ArgsWithHelp(cobra.ExactArgs(1), args),
+		RunE:    common.WithGRPCHandler(run),
+	}
+
+	cmd.Flags().Bool("wait", false, "wait for cluster to be ready")
+	common.AddMaxWaitErrorsFlag(cmd)
+	return cmd
+}
+
+func args(_ *cobra.Command, args []string) error {
+	if args[0] == "" {
+		return errors.New("no cluster ID given")
+	}
+	return utils.ValidateClusterName(args[0])
+}
+
+func run(ctx context.Context, conn *grpc.ClientConn, cmd *cobra.Command, args []string) (common.PrettyPrinter, error) {
+	client := v1.NewClusterServiceClient(conn)
+
+	clusterID, err := client.Retry(ctx, &v1.ResourceByID{Id: args[0]})
+	if err != nil {
+		return nil, err
+	}
+
+	if common.MustBool(cmd.Flags(), "wait") {
+		if err := common.WaitForCluster(client, clusterID, common.GetMaxWaitErrorsFlagValue(cmd)); err != nil {
+			return nil, err
+		}
+	}
+
+	return id{clusterID}, nil
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"regexp"
+	"strings"
 	"time"
 
 	"google.golang.org/protobuf/types/known/durationpb"
@@ -78,3 +79,18 @@ func ValidateParameterArgument(parts []string) error {
 
 	return nil
 }
+
+// ParseLabels accepts repeated key=value label arguments and returns them as
+// a map. The label names and values are validated by the server.
+func ParseLabels(args []string) (map[string]string, error) {
+	labels := make(map[string]string, len(args))
+	for _, arg := range args {
+		name, value, found := strings.Cut(arg, "=")
+		if !found || name == "" || value == "" {
+			return nil, fmt.Errorf("bad label argument %q: expected key=value", arg)
+		}
+		labels[name] = value
+	}
+
+	return labels, nil
+}
@@ -16,3 +16,14 @@ func TestValidateParameterArgument(t *testing.T) {
 	err = ValidateParameterArgument([]string{"user-arns", "arn:aws:iam::393282794030:user/joey@stackrox.com"})
 	assert.NoError(t, err, "no error expected")
 }
+
+func TestParseLabels(t *testing.T) {
+	labels, err := ParseLabels([]string{"team=sre", "ticket=ROX-1234"})
+	assert.NoError(t, err, "no error expected")
+	assert.Equal(t, map[string]string{"team": "sre", "ticket": "ROX-1234"}, labels)
+
+	for _, arg := range []string{"team", "=sre", "team="} {
+		_, err := ParseLabels([]string{arg})
+		assert.Error(t, err, "error expected for %q", arg)
+	}
+}
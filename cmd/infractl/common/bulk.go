@@ -0,0 +1,23 @@
+package common
+
+import (
+	"fmt"
+
+	v1 "github.com/stackrox/infra/generated/api/v1"
+)
+
+// BulkFailure returns an error if the operation failed for any cluster of
+// the given bulk response.
+func BulkFailure(resp *v1.BulkResponse) error {
+	var failed int
+	for _, result := range resp.GetResults() {
+		if result.GetError() != "" {
+			failed++
+		}
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("failed for %d of %d clusters", failed, len(resp.GetResults()))
+	}
+	return nil
+}
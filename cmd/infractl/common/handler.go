@@ -16,6 +16,14 @@ type PrettyPrinter interface {
 	PrettyJSONPrint(cmd *cobra.Command) error
 }
 
+// FailureReporter is implemented by pretty-printable results that may report
+// a failure after being printed, such as a partial failure of a bulk
+// operation.
+type FailureReporter interface {
+	// Failure returns an error if the result reports a failure.
+	Failure() error
+}
+
 // GRPCHandler represents a function that consumes a gRPC connection, and
 // produces a pretty-printable type.
 type GRPCHandler func(ctx context.Context, conn *grpc.ClientConn, cmd *cobra.Command, args []string) (PrettyPrinter, error)
@@ -40,13 +48,25 @@ func WithGRPCHandler(handler GRPCHandler) func(cmd *cobra.Command, args []string
 			return err
 		}
 
-		// The --json flag was passed, render result as json.
-		if jsonOutput() {
-			return result.PrettyJSONPrint(cmd)
-		}
+		return render(cmd, result)
+	}
+}
 
+// render prints the given result, and returns any failure that it reports
+// afterwards, so that the command exits with an error.
+func render(cmd *cobra.Command, result PrettyPrinter) error {
+	// The --json flag was passed, render result as json.
+	if jsonOutput() {
+		if err := result.PrettyJSONPrint(cmd); err != nil {
+			return err
+		}
+	} else {
 		// Pretty print result instead.
 		result.PrettyPrint(cmd)
-		return nil
 	}
+
+	if reporter, ok := result.(FailureReporter); ok {
+		return reporter.Failure()
+	}
+	return nil
 }
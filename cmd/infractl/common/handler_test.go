@@ -0,0 +1,69 @@
+package common
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/spf13/cobra"
+	v1 "github.com/stackrox/infra/generated/api/v1"
+	"github.com/stretchr/testify/assert"
+)
+
+type bulkResult struct {
+	*v1.BulkResponse
+}
+
+func (p bulkResult) PrettyPrint(cmd *cobra.Command) {
+	for _, result := range p.GetResults() {
+		cmd.Printf("%s\n", result.GetId())
+	}
+}
+
+func (p bulkResult) PrettyJSONPrint(_ *cobra.Command) error {
+	return nil
+}
+
+func (p bulkResult) Failure() error {
+	return BulkFailure(p.BulkResponse)
+}
+
+func TestRender(t *testing.T) {
+	tests := []struct {
+		name     string
+		results  []*v1.BulkResult
+		expected string
+	}{
+		{
+			name:    "every cluster succeeded",
+			results: []*v1.BulkResult{{Id: "one"}, {Id: "two"}},
+		},
+		{
+			name:     "some clusters failed",
+			results:  []*v1.BulkResult{{Id: "one"}, {Id: "two", Error: "not found"}},
+			expected: "failed for 1 of 2 clusters",
+		},
+		{
+			name: "no clusters selected",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var out bytes.Buffer
+			cmd := &cobra.Command{}
+			cmd.SetOut(&out)
+
+			err := render(cmd, bulkResult{&v1.BulkResponse{Results: tt.results}})
+			if tt.expected != "" {
+				assert.EqualError(t, err, tt.expected)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			// The results are printed either way.
+			for _, result := range tt.results {
+				assert.Contains(t, out.String(), result.GetId())
+			}
+		})
+	}
+}
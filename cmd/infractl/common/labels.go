@@ -0,0 +1,18 @@
+package common
+
+import (
+	"sort"
+	"strings"
+)
+
+// FormatLabels renders cluster labels as a sorted, comma separated list of
+// key=value pairs.
+func FormatLabels(labels map[string]string) string {
+	pairs := make([]string, 0, len(labels))
+	for name, value := range labels {
+		pairs = append(pairs, name+"="+value)
+	}
+	sort.Strings(pairs)
+
+	return strings.Join(pairs, ", ")
+}
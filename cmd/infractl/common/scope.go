@@ -0,0 +1,28 @@
+package common
+
+import (
+	"fmt"
+	"strings"
+
+	v1 "github.com/stackrox/infra/generated/api/v1"
+)
+
+// FormatScope renders a token scope as a comma separated list of the
+// key=value pairs accepted by infractl token --scope.
+func FormatScope(scope *v1.TokenScope) string {
+	var pairs []string
+	for _, method := range scope.GetMethods() {
+		pairs = append(pairs, "method="+method)
+	}
+	for _, flavor := range scope.GetFlavors() {
+		pairs = append(pairs, "flavor="+flavor)
+	}
+	if scope.GetMaxLifespan() != nil {
+		pairs = append(pairs, "max-lifespan="+scope.GetMaxLifespan().AsDuration().String())
+	}
+	if scope.GetMaxClusters() > 0 {
+		pairs = append(pairs, fmt.Sprintf("max-clusters=%d", scope.GetMaxClusters()))
+	}
+
+	return strings.Join(pairs, ", ")
+}
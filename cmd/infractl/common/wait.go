@@ -1,20 +1,36 @@
 package common
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"io"
 	"os"
 	"time"
 
 	"github.com/spf13/cobra"
 	v1 "github.com/stackrox/infra/generated/api/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 const (
 	maxWaitErrorsFlagName           = "wait-max-errors"
 	defaultMaxConsecutiveWaitErrors = 10
+
+	// pollInterval is how often a cluster is polled when the server does not
+	// support watching clusters.
+	pollInterval = 30 * time.Second
+
+	// watchRetryInterval is how long to wait before re-establishing a broken
+	// cluster watch.
+	watchRetryInterval = 5 * time.Second
 )
 
+// errTooManyWaitErrors is returned when waiting is aborted after too many
+// consecutive errors.
+var errTooManyWaitErrors = errors.New("too many errors while waiting")
+
 // AddMaxWaitErrorsFlag adds a flag definition to cmd.
 func AddMaxWaitErrorsFlag(cmd *cobra.Command) {
 	cmd.Flags().Int(maxWaitErrorsFlagName, defaultMaxConsecutiveWaitErrors, "maximum number of consecutive errors before giving up waiting")
@@ -29,38 +45,116 @@ func GetMaxWaitErrorsFlagValue(cmd *cobra.Command) int {
 	return value
 }
 
-// WaitForCluster waits for a created cluster to be in a ready state.
+// WaitForCluster waits for a created cluster to be in a ready state. Cluster
+// updates are streamed from the server, and polled for instead when talking
+// to an older server that does not support ClusterService.Watch.
 func WaitForCluster(client v1.ClusterServiceClient, clusterID *v1.ResourceByID, maxWaitErrors int) error {
-	const timeoutSleep = 30 * time.Second
+	fmt.Fprintf(os.Stderr, "...waiting for %s\n", clusterID.Id)
+
+	err := watchForCluster(client, clusterID, maxWaitErrors)
+	if status.Code(err) != codes.Unimplemented {
+		return err
+	}
 
+	return pollForCluster(client, clusterID, maxWaitErrors)
+}
+
+// watchForCluster waits for a cluster using ClusterService.Watch. A
+// codes.Unimplemented error is returned as is so that the caller can fall
+// back to polling.
+func watchForCluster(client v1.ClusterServiceClient, clusterID *v1.ResourceByID, maxWaitErrors int) error {
+	nErrors := 0
+
+	for {
+		done, err := watchOnce(client, clusterID, func() { nErrors = 0 })
+		if done {
+			return err
+		}
+
+		if status.Code(err) == codes.Unimplemented {
+			return err
+		}
+
+		// The server closing the stream is not an error, it just needs to be
+		// re-established.
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "...error %s\n", err)
+			nErrors++
+			if nErrors >= maxWaitErrors {
+				return errTooManyWaitErrors
+			}
+		}
+
+		time.Sleep(watchRetryInterval)
+	}
+}
+
+// watchOnce consumes a single ClusterService.Watch stream until the cluster
+// reaches a final state, or the stream ends.
+func watchOnce(client v1.ClusterServiceClient, clusterID *v1.ResourceByID, onUpdate func()) (bool, error) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	stream, err := client.Watch(ctx, clusterID)
+	if err != nil {
+		return false, err
+	}
+
+	for {
+		cluster, err := stream.Recv()
+		if err == io.EOF {
+			return false, nil
+		}
+		if err != nil {
+			return false, err
+		}
+
+		onUpdate()
+		if done, err := checkClusterStatus(cluster); done {
+			return true, err
+		}
+	}
+}
+
+// pollForCluster waits for a cluster by periodically calling
+// ClusterService.Info.
+func pollForCluster(client v1.ClusterServiceClient, clusterID *v1.ResourceByID, maxWaitErrors int) error {
 	nErrors := 0
 
-	fmt.Fprintf(os.Stderr, "...waiting for %s\n", clusterID.Id)
 	for {
 		ctx, cancel := ContextWithTimeout()
-		cluster, err := client.Info(ctx, clusterID)
+		cluster, err := client.Info(ctx, &v1.ClusterRequest{Id: clusterID.GetId()})
 		cancel()
 
 		if err != nil {
 			fmt.Fprintf(os.Stderr, "...error %s\n", err)
 			nErrors++
 			if nErrors >= maxWaitErrors {
-				return errors.New("too many errors while waiting")
+				return errTooManyWaitErrors
 			}
 		} else {
 			nErrors = 0
-			switch cluster.Status {
-			case v1.Status_CREATING:
-				fmt.Fprintln(os.Stderr, "...creating")
-			case v1.Status_READY:
-				fmt.Fprintln(os.Stderr, "...ready")
-				return nil
-			default:
-				fmt.Fprintln(os.Stderr, "...failed")
-				return errors.New("cluster failed provisioning")
+			if done, err := checkClusterStatus(cluster); done {
+				return err
 			}
 		}
 
-		time.Sleep(timeoutSleep)
+		time.Sleep(pollInterval)
+	}
+}
+
+// checkClusterStatus reports the status of the given cluster, and determines
+// if waiting is over.
+func checkClusterStatus(cluster *v1.Cluster) (bool, error) {
+	switch cluster.Status {
+	case v1.Status_CREATING:
+		fmt.Fprintln(os.Stderr, "...creating")
+		return false, nil
+	case v1.Status_READY:
+		fmt.Fprintln(os.Stderr, "...ready")
+		return true, nil
+	default:
+		fmt.Fprintln(os.Stderr, "...failed")
+		return true, errors.New("cluster failed provisioning")
 	}
 }
@@ -5,6 +5,8 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/stackrox/infra/cmd/infractl/flavor/get"
 	"github.com/stackrox/infra/cmd/infractl/flavor/list"
+	"github.com/stackrox/infra/cmd/infractl/flavor/reload"
+	"github.com/stackrox/infra/cmd/infractl/flavor/status"
 )
 
 // Command defines the handler for infractl flavor.
@@ -22,6 +24,12 @@ func Command() *cobra.Command {
 
 		// $ infractl flavor list
 		list.Command(),
+
+		// $ infractl flavor reload
+		reload.Command(),
+
+		// $ infractl flavor status
+		status.Command(),
 	)
 
 	return cmd
@@ -2,6 +2,7 @@ package get
 
 import (
 	"bytes"
+	"strings"
 
 	"github.com/spf13/cobra"
 
@@ -29,6 +30,21 @@ func (p prettyFlavor) PrettyPrint(cmd *cobra.Command) {
 	for name, parameter := range p.Parameters {
 		cmd.Printf("  %s:\n", name)
 		cmd.Printf("    Description: %s\n", parameter.GetDescription())
+		if parameter.GetType() != v1.ParameterType_STRING {
+			cmd.Printf("    Type:        %s\n", strings.ToLower(parameter.GetType().String()))
+		}
+		if len(parameter.GetAllowedValues()) > 0 {
+			cmd.Printf("    Allowed:     %s\n", strings.Join(parameter.GetAllowedValues(), ", "))
+		}
+		if parameter.GetMin() != nil {
+			cmd.Printf("    Min:         %d\n", parameter.GetMin().GetValue())
+		}
+		if parameter.GetMax() != nil {
+			cmd.Printf("    Max:         %d\n", parameter.GetMax().GetValue())
+		}
+		if parameter.GetPattern() != "" {
+			cmd.Printf("    Pattern:     %s\n", parameter.GetPattern())
+		}
 		if parameter.GetOptional() {
 			cmd.Printf("    Default:     %q\n", parameter.GetValue())
 		} else if parameter.GetValue() != "" {
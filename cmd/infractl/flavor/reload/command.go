@@ -0,0 +1,38 @@
+// Package reload implements the infractl flavor reload command.
+package reload
+
+import (
+	"context"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/spf13/cobra"
+	"github.com/stackrox/infra/cmd/infractl/common"
+	"github.com/stackrox/infra/cmd/infractl/flavor/status"
+	v1 "github.com/stackrox/infra/generated/api/v1"
+	"google.golang.org/grpc"
+)
+
+const examples = `# Reload the flavor config without restarting the server.
+$ infractl flavor reload`
+
+// Command defines the handler for infractl flavor reload.
+func Command() *cobra.Command {
+	// $ infractl flavor reload
+	return &cobra.Command{
+		Use:     "reload",
+		Short:   "Reload flavors",
+		Long:    "Reloads the flavor config and workflows on the server, the previous config is kept if the new one is invalid",
+		Example: examples,
+		Args:    common.ArgsWithHelp(cobra.ExactArgs(0)),
+		RunE:    common.WithGRPCHandler(run),
+	}
+}
+
+func run(ctx context.Context, conn *grpc.ClientConn, _ *cobra.Command, _ []string) (common.PrettyPrinter, error) {
+	resp, err := v1.NewFlavorServiceClient(conn).Reload(ctx, &empty.Empty{})
+	if err != nil {
+		return nil, err
+	}
+
+	return status.PrettyRegistryStatus{FlavorRegistryStatus: resp}, nil
+}
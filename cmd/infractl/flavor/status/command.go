@@ -0,0 +1,37 @@
+// Package status implements the infractl flavor status command.
+package status
+
+import (
+	"context"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/spf13/cobra"
+	"github.com/stackrox/infra/cmd/infractl/common"
+	v1 "github.com/stackrox/infra/generated/api/v1"
+	"google.golang.org/grpc"
+)
+
+const examples = `# Show which flavor config is loaded.
+$ infractl flavor status`
+
+// Command defines the handler for infractl flavor status.
+func Command() *cobra.Command {
+	// $ infractl flavor status
+	return &cobra.Command{
+		Use:     "status",
+		Short:   "Flavor config status",
+		Long:    "Displays the hash of the loaded flavor config and the outcome of the last reload",
+		Example: examples,
+		Args:    common.ArgsWithHelp(cobra.ExactArgs(0)),
+		RunE:    common.WithGRPCHandler(run),
+	}
+}
+
+func run(ctx context.Context, conn *grpc.ClientConn, _ *cobra.Command, _ []string) (common.PrettyPrinter, error) {
+	resp, err := v1.NewFlavorServiceClient(conn).RegistryStatus(ctx, &empty.Empty{})
+	if err != nil {
+		return nil, err
+	}
+
+	return PrettyRegistryStatus{FlavorRegistryStatus: resp}, nil
+}
@@ -0,0 +1,36 @@
+package status
+
+import (
+	"encoding/json"
+
+	"github.com/spf13/cobra"
+
+	"github.com/stackrox/infra/cmd/infractl/common"
+	v1 "github.com/stackrox/infra/generated/api/v1"
+)
+
+// PrettyRegistryStatus renders the outcome of loading the flavor config.
+type PrettyRegistryStatus struct {
+	*v1.FlavorRegistryStatus
+}
+
+// PrettyPrint implements common.PrettyPrinter.
+func (p PrettyRegistryStatus) PrettyPrint(cmd *cobra.Command) {
+	cmd.Printf("Config hash: %s\n", p.GetConfigHash())
+	cmd.Printf("Loaded:      %s\n", common.FormatTime(p.GetLoadedOn().AsTime()))
+	cmd.Printf("Last reload: %s\n", common.FormatTime(p.GetLastReload().AsTime()))
+	if p.GetLastReloadError() != "" {
+		cmd.Printf("Error:       %s\n", p.GetLastReloadError())
+	}
+}
+
+// PrettyJSONPrint implements common.PrettyPrinter.
+func (p PrettyRegistryStatus) PrettyJSONPrint(cmd *cobra.Command) error {
+	data, err := json.MarshalIndent(p.FlavorRegistryStatus, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	cmd.Printf("%s\n", string(data))
+	return nil
+}
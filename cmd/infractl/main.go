@@ -4,22 +4,34 @@ import (
 	"os"
 
 	"github.com/spf13/cobra"
+	"github.com/stackrox/infra/cmd/infractl/audit"
 	"github.com/stackrox/infra/cmd/infractl/cli"
+	"github.com/stackrox/infra/cmd/infractl/cluster/apply"
 	"github.com/stackrox/infra/cmd/infractl/cluster/artifacts"
+	"github.com/stackrox/infra/cmd/infractl/cluster/clone"
 	"github.com/stackrox/infra/cmd/infractl/cluster/create"
 	"github.com/stackrox/infra/cmd/infractl/cluster/delete"
+	"github.com/stackrox/infra/cmd/infractl/cluster/destroy"
 	"github.com/stackrox/infra/cmd/infractl/cluster/get"
+	"github.com/stackrox/infra/cmd/infractl/cluster/history"
+	"github.com/stackrox/infra/cmd/infractl/cluster/label"
 	"github.com/stackrox/infra/cmd/infractl/cluster/lifespan"
 	"github.com/stackrox/infra/cmd/infractl/cluster/list"
 	"github.com/stackrox/infra/cmd/infractl/cluster/logs"
+	"github.com/stackrox/infra/cmd/infractl/cluster/ownership"
+	"github.com/stackrox/infra/cmd/infractl/cluster/retry"
 	"github.com/stackrox/infra/cmd/infractl/cluster/wait"
 	"github.com/stackrox/infra/cmd/infractl/common"
 	"github.com/stackrox/infra/cmd/infractl/flavor"
 	janitorFind "github.com/stackrox/infra/cmd/infractl/janitor/find"
+	"github.com/stackrox/infra/cmd/infractl/quota"
+	"github.com/stackrox/infra/cmd/infractl/schedule"
 	statusGet "github.com/stackrox/infra/cmd/infractl/status/get"
 	statusReset "github.com/stackrox/infra/cmd/infractl/status/reset"
 	statusSet "github.com/stackrox/infra/cmd/infractl/status/set"
 	"github.com/stackrox/infra/cmd/infractl/token"
+	tokenList "github.com/stackrox/infra/cmd/infractl/token/list"
+	tokenRevoke "github.com/stackrox/infra/cmd/infractl/token/revoke"
 	"github.com/stackrox/infra/cmd/infractl/version"
 	"github.com/stackrox/infra/cmd/infractl/whoami"
 	"github.com/stackrox/infra/pkg/buildinfo"
@@ -53,6 +65,12 @@ func main() {
 		janitorFind.Command(),
 	)
 
+	tokenCommand := token.Command()
+	tokenCommand.AddCommand(
+		tokenList.Command(),
+		tokenRevoke.Command(),
+	)
+
 	// For our version of Cobra, `cmd.Printf(...)` defaults to Stderr.
 	// > Printf is a convenience method to Printf to the defined output, fallback to Stderr if not set.
 	cmd.SetOut(os.Stdout)
@@ -60,27 +78,45 @@ func main() {
 	common.AddCommonFlags(cmd)
 
 	cmd.AddCommand(
+		// $ infractl apply
+		apply.Command(),
+
 		// $ infractl artifacts
 		artifacts.Command(),
 
+		// $ infractl audit
+		audit.Command(),
+
 		// $ infractl cli
 		cli.Command(),
 
+		// $ infractl clone
+		clone.Command(),
+
 		// $ infractl create
 		create.Command(),
 
 		// $ infractl delete
 		delete.Command(),
 
+		// $ infractl destroy
+		destroy.Command(),
+
 		// $ infractl flavor
 		flavor.Command(),
 
 		// $ infractl get
 		get.Command(),
 
+		// $ infractl history
+		history.Command(),
+
 		// $ infractl janitor
 		janitorCommand,
 
+		// $ infractl label
+		label.Command(),
+
 		// $ infractl lifespan
 		lifespan.Command(),
 
@@ -90,11 +126,23 @@ func main() {
 		// $ infractl logs
 		logs.Command(),
 
+		// $ infractl ownership
+		ownership.Command(),
+
+		// $ infractl quota
+		quota.Command(),
+
+		// $ infractl retry
+		retry.Command(),
+
+		// $ infractl schedule
+		schedule.Command(),
+
 		// $ infractl status
 		statusCommand,
 
 		// $ infractl token
-		token.Command(),
+		tokenCommand,
 
 		// $ infractl version
 		version.Command(),
@@ -0,0 +1,37 @@
+// Package quota implements the infractl quota command.
+package quota
+
+import (
+	"context"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/spf13/cobra"
+	"github.com/stackrox/infra/cmd/infractl/common"
+	v1 "github.com/stackrox/infra/generated/api/v1"
+	"google.golang.org/grpc"
+)
+
+const examples = `# Show your cluster quota usage.
+$ infractl quota`
+
+// Command defines the handler for infractl quota.
+func Command() *cobra.Command {
+	// $ infractl quota
+	return &cobra.Command{
+		Use:     "quota",
+		Short:   "Cluster quota usage",
+		Long:    "Displays the current usage against the cluster quotas that apply to you",
+		Example: examples,
+		Args:    common.ArgsWithHelp(cobra.ExactArgs(0)),
+		RunE:    common.WithGRPCHandler(run),
+	}
+}
+
+func run(ctx context.Context, conn *grpc.ClientConn, _ *cobra.Command, _ []string) (common.PrettyPrinter, error) {
+	resp, err := v1.NewQuotaServiceClient(conn).Get(ctx, &empty.Empty{})
+	if err != nil {
+		return nil, err
+	}
+
+	return prettyQuotaResponse{resp}, nil
+}
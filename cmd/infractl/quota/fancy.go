@@ -0,0 +1,44 @@
+package quota
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	v1 "github.com/stackrox/infra/generated/api/v1"
+)
+
+type prettyQuotaResponse struct {
+	*v1.QuotaResponse
+}
+
+func (p prettyQuotaResponse) PrettyPrint(cmd *cobra.Command) {
+	if p.GetExempt() {
+		cmd.Println("You are exempt from cluster quotas")
+		return
+	}
+
+	if len(p.GetUsage()) == 0 {
+		cmd.Println("No cluster quotas apply to you")
+		return
+	}
+
+	for _, usage := range p.GetUsage() {
+		cmd.Printf("%s\n", usage.GetDescription())
+		cmd.Printf("  Used:     %d/%d\n", usage.GetUsed(), usage.GetLimit())
+		if len(usage.GetClusters()) > 0 {
+			cmd.Printf("  Clusters: %s\n", strings.Join(usage.GetClusters(), ", "))
+		}
+	}
+}
+
+func (p prettyQuotaResponse) PrettyJSONPrint(cmd *cobra.Command) error {
+	data, err := json.MarshalIndent(p.QuotaResponse, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	cmd.Printf("%s\n", string(data))
+	return nil
+}
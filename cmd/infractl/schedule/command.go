@@ -0,0 +1,32 @@
+// Package schedule implements the infractl schedule ... command.
+package schedule
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/stackrox/infra/cmd/infractl/schedule/create"
+	"github.com/stackrox/infra/cmd/infractl/schedule/delete"
+	"github.com/stackrox/infra/cmd/infractl/schedule/list"
+)
+
+// Command defines the handler for infractl schedule.
+func Command() *cobra.Command {
+	// $ infractl schedule
+	cmd := &cobra.Command{
+		Use:   "schedule",
+		Short: "Interact with scheduled clusters",
+		Long:  "Create clusters at a later time, either once or on a recurring basis",
+	}
+
+	cmd.AddCommand(
+		// $ infractl schedule create
+		create.Command(),
+
+		// $ infractl schedule delete
+		delete.Command(),
+
+		// $ infractl schedule list
+		list.Command(),
+	)
+
+	return cmd
+}
@@ -0,0 +1,134 @@
+// Package create implements the infractl schedule create command.
+package create
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/stackrox/infra/cmd/infractl/cluster/utils"
+	"github.com/stackrox/infra/cmd/infractl/common"
+	v1 "github.com/stackrox/infra/generated/api/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const examples = `# Create a "qa-demo" cluster at 07:30 every weekday, Berlin time.
+$ infractl schedule create qa-demo standup-demo --cron "CRON_TZ=Europe/Berlin 30 7 * * 1-5" --arg main-image=quay.io/stackrox-io/main:4.5.0
+ID: qa-demo-x7k2p
+
+# Create a "gke-default" cluster once, tomorrow morning.
+$ infractl schedule create gke-default --at 2024-06-01T08:00:00+02:00 --lifespan 8h
+ID: gke-default-q9v4d`
+
+// timeLayouts are the accepted formats for the --at flag. Times without a
+// zone are interpreted in the local time zone.
+var timeLayouts = []string{ //nolint:gochecknoglobals
+	time.RFC3339,
+	"2006-01-02 15:04",
+	"2006-01-02T15:04",
+}
+
+// Command defines the handler for infractl schedule create.
+func Command() *cobra.Command {
+	// $ infractl schedule create
+	cmd := &cobra.Command{
+		Use:   "create FLAVOR [NAME]",
+		Short: "Schedule the creation of a cluster",
+		Long: `Schedules the creation of a cluster, either once at the given time, or on a
+recurring basis following the given cron expression. Schedules fire at the
+given time, so allow for the flavor's provisioning time when a cluster has to
+be ready at a certain time. When NAME is omitted, the schedule ID is used as
+the cluster name.`,
+		Example: examples,
+		Args:    common.ArgsWithHelp(cobra.RangeArgs(1, 2)),
+		RunE:    common.WithGRPCHandler(run),
+	}
+
+	cmd.Flags().String("id", "", "ID for the schedule, generated when omitted")
+	cmd.Flags().String("at", "", "time at which to create the cluster once, e.g. 2024-06-01T08:00:00Z or \"2024-06-01 08:00\"")
+	cmd.Flags().String("cron", "", "cron expression at which to create the cluster repeatedly, e.g. \"0 8 * * 1-5\"")
+	cmd.Flags().StringArray("arg", []string{}, "repeated key=value parameter pairs")
+	cmd.Flags().String("description", "", "description for the cluster")
+	cmd.Flags().Duration("lifespan", 3*time.Hour, "initial lifespan of the cluster")
+	cmd.Flags().Bool("no-slack", false, "skip sending Slack messages for lifecycle events")
+	cmd.Flags().Bool("slack-me", false, "send slack messages directly and not to the #infra_notifications channel")
+	return cmd
+}
+
+func run(ctx context.Context, conn *grpc.ClientConn, cmd *cobra.Command, args []string) (common.PrettyPrinter, error) {
+	id, _ := cmd.Flags().GetString("id")
+	at, _ := cmd.Flags().GetString("at")
+	cronExpr, _ := cmd.Flags().GetString("cron")
+	params, _ := cmd.Flags().GetStringArray("arg")
+	description, _ := cmd.Flags().GetString("description")
+	lifespan, _ := cmd.Flags().GetDuration("lifespan")
+	noSlack, _ := cmd.Flags().GetBool("no-slack")
+	slackDM, _ := cmd.Flags().GetBool("slack-me")
+
+	if (at == "") == (cronExpr == "") {
+		return nil, errors.New("exactly one of --at or --cron must be given")
+	}
+
+	if err := utils.ValidateLifespan(lifespan); err != nil {
+		return nil, err
+	}
+
+	req := &v1.CreateClusterRequest{
+		ID:          args[0],
+		Parameters:  make(map[string]string),
+		Lifespan:    durationpb.New(lifespan),
+		Description: description,
+		NoSlack:     noSlack,
+		SlackDM:     slackDM,
+	}
+
+	for _, arg := range params {
+		parts := strings.SplitN(arg, "=", 2)
+		if err := utils.ValidateParameterArgument(parts); err != nil {
+			return nil, fmt.Errorf("bad parameter argument %q: %v", arg, err)
+		}
+		req.Parameters[parts[0]] = parts[1]
+	}
+
+	if len(args) == 2 {
+		if err := utils.ValidateClusterName(args[1]); err != nil {
+			return nil, err
+		}
+		req.Parameters["name"] = args[1]
+	}
+
+	schedule := &v1.Schedule{
+		ID:      id,
+		Request: req,
+		Cron:    cronExpr,
+	}
+
+	if at != "" {
+		when, err := parseTime(at)
+		if err != nil {
+			return nil, err
+		}
+		schedule.At = timestamppb.New(when)
+	}
+
+	resp, err := v1.NewScheduleServiceClient(conn).Create(ctx, schedule)
+	if err != nil {
+		return nil, err
+	}
+
+	return prettySchedule{resp}, nil
+}
+
+func parseTime(value string) (time.Time, error) {
+	for _, layout := range timeLayouts {
+		if when, err := time.ParseInLocation(layout, value, time.Local); err == nil {
+			return when, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("bad time argument %q, expected a format like %q", value, time.RFC3339)
+}
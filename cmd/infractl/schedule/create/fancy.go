@@ -0,0 +1,29 @@
+package create
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	v1 "github.com/stackrox/infra/generated/api/v1"
+)
+
+type prettySchedule struct {
+	*v1.Schedule
+}
+
+func (p prettySchedule) PrettyPrint(cmd *cobra.Command) {
+	cmd.Printf("ID: %s\n", p.GetID())
+	cmd.Printf("Next run: %s\n", p.GetNextRun().AsTime().Local().Format(time.RFC1123))
+}
+
+func (p prettySchedule) PrettyJSONPrint(cmd *cobra.Command) error {
+	data, err := json.MarshalIndent(p.Schedule, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	cmd.Printf("%s\n", string(data))
+	return nil
+}
@@ -0,0 +1,47 @@
+// Package delete implements the infractl schedule delete command.
+package delete
+
+import (
+	"context"
+	"errors"
+
+	"github.com/spf13/cobra"
+	"github.com/stackrox/infra/cmd/infractl/common"
+	v1 "github.com/stackrox/infra/generated/api/v1"
+	"google.golang.org/grpc"
+)
+
+const examples = `# Delete schedule "qa-demo-x7k2p".
+$ infractl schedule delete qa-demo-x7k2p`
+
+// Command defines the handler for infractl schedule delete.
+func Command() *cobra.Command {
+	// $ infractl schedule delete
+	return &cobra.Command{
+		Use:     "delete SCHEDULE",
+		Short:   "Delete a specific schedule",
+		Long:    "Deletes a specific schedule. Clusters it already created are not affected.",
+		Example: examples,
+		Args:    common.ArgsWithHelp(cobra.ExactArgs(1), args),
+		RunE:    common.WithGRPCHandler(run),
+	}
+}
+
+func args(_ *cobra.Command, args []string) error {
+	if args[0] == "" {
+		return errors.New("no schedule ID given")
+	}
+	return nil
+}
+
+func run(ctx context.Context, conn *grpc.ClientConn, _ *cobra.Command, args []string) (common.PrettyPrinter, error) {
+	req := v1.ResourceByID{
+		Id: args[0],
+	}
+
+	if _, err := v1.NewScheduleServiceClient(conn).Delete(ctx, &req); err != nil {
+		return nil, err
+	}
+
+	return id{&req}, nil
+}
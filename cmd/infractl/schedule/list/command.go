@@ -0,0 +1,46 @@
+// Package list implements the infractl schedule list command.
+package list
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+	"github.com/stackrox/infra/cmd/infractl/common"
+	v1 "github.com/stackrox/infra/generated/api/v1"
+	"google.golang.org/grpc"
+)
+
+const examples = `# List your schedules.
+$ infractl schedule list
+
+# List everyone's schedules.
+$ infractl schedule list --all`
+
+// Command defines the handler for infractl schedule list.
+func Command() *cobra.Command {
+	// $ infractl schedule list
+	cmd := &cobra.Command{
+		Use:     "list",
+		Short:   "List schedules",
+		Long:    "List the scheduled cluster creations",
+		Example: examples,
+		Args:    common.ArgsWithHelp(cobra.ExactArgs(0)),
+		RunE:    common.WithGRPCHandler(run),
+	}
+
+	cmd.Flags().Bool("all", false, "include schedules not owned by you")
+	return cmd
+}
+
+func run(ctx context.Context, conn *grpc.ClientConn, cmd *cobra.Command, _ []string) (common.PrettyPrinter, error) {
+	req := v1.ScheduleListRequest{
+		All: common.MustBool(cmd.Flags(), "all"),
+	}
+
+	resp, err := v1.NewScheduleServiceClient(conn).List(ctx, &req)
+	if err != nil {
+		return nil, err
+	}
+
+	return prettyScheduleListResponse{resp}, nil
+}
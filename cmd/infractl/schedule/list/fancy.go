@@ -0,0 +1,53 @@
+package list
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/stackrox/infra/cmd/infractl/common"
+	v1 "github.com/stackrox/infra/generated/api/v1"
+)
+
+type prettyScheduleListResponse struct {
+	*v1.ScheduleListResponse
+}
+
+func (p prettyScheduleListResponse) PrettyPrint(cmd *cobra.Command) {
+	for _, schedule := range p.GetSchedules() {
+		cmd.Printf("%s \n", schedule.GetID())
+		cmd.Printf("  Flavor:      %s\n", schedule.GetRequest().GetID())
+		cmd.Printf("  Cluster:     %s\n", schedule.GetRequest().GetParameters()["name"])
+		cmd.Printf("  Owner:       %s\n", schedule.GetOwner())
+		cmd.Printf("  Description: %s\n", schedule.GetRequest().GetDescription())
+		if schedule.GetScope() != nil {
+			cmd.Printf("  Scope:       %s\n", common.FormatScope(schedule.GetScope()))
+		}
+		if schedule.GetCron() != "" {
+			cmd.Printf("  Cron:        %s\n", schedule.GetCron())
+		}
+		if schedule.GetNextRun() != nil {
+			cmd.Printf("  Next run:    %s\n", schedule.GetNextRun().AsTime().Local().Format(time.RFC1123))
+		}
+		if schedule.GetLastRun() != nil {
+			cmd.Printf("  Last run:    %s\n", common.FormatTime(schedule.GetLastRun().AsTime()))
+		}
+		if schedule.GetLastClusterID() != "" {
+			cmd.Printf("  Last ID:     %s\n", schedule.GetLastClusterID())
+		}
+		if schedule.GetLastError() != "" {
+			cmd.Printf("  Last error:  %s\n", schedule.GetLastError())
+		}
+	}
+}
+
+func (p prettyScheduleListResponse) PrettyJSONPrint(cmd *cobra.Command) error {
+	data, err := json.MarshalIndent(p.ScheduleListResponse, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	cmd.Printf("%s\n", string(data))
+	return nil
+}
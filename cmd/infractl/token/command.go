@@ -4,30 +4,47 @@ package token
 import (
 	"context"
 	"regexp"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 	"github.com/stackrox/infra/cmd/infractl/common"
 	v1 "github.com/stackrox/infra/generated/api/v1"
 	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/durationpb"
 )
 
 const examples = `# Generate a service account token.
-$ infractl token ci-robot 'CI service account' roxbot@redhat.com`
+$ infractl token ci-robot 'CI service account' roxbot@redhat.com
+
+# Generate a service account token that may only create and delete clusters
+# of the gke-default flavor, with a lifespan of at most 8 hours.
+$ infractl token ci-robot 'CI service account' roxbot@redhat.com \
+    --scope method=/v1.ClusterService/Create --scope method=/v1.ClusterService/Delete \
+    --scope flavor=gke-default --scope max-lifespan=8h --scope max-clusters=5
+
+# List your service account tokens.
+$ infractl token list
+
+# Revoke a service account token.
+$ infractl token revoke 0e9c3cb4-5a55-4d4c-9b5e-2f8a3d1c7e61`
 
 // Command defines the handler for infractl token.
 func Command() *cobra.Command {
 	// $ infractl token
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:     "token NAME DESCRIPTION EMAIL",
 		Short:   "Generate tokens",
-		Long:    "Generates a service account token",
+		Long:    "Generates a service account token, or lists and revokes issued ones",
 		Example: examples,
 		Args:    common.ArgsWithHelp(cobra.ExactArgs(3), args),
 		RunE:    common.WithGRPCHandler(token),
-		Hidden:  true,
 	}
+
+	cmd.Flags().StringArray("scope", []string{}, "repeated key=value pairs restricting the token, with keys method, flavor, max-lifespan and max-clusters")
+	return cmd
 }
 
 func validateName(name string) error {
@@ -69,7 +86,46 @@ func validateEmail(email string) error {
 	return nil
 }
 
-func args(_ *cobra.Command, args []string) error {
+// parseScope parses the key=value pairs of the --scope flag. No pairs
+// result in an unrestricted token.
+func parseScope(pairs []string) (*v1.TokenScope, error) {
+	if len(pairs) == 0 {
+		return nil, nil
+	}
+
+	scope := &v1.TokenScope{}
+	for _, pair := range pairs {
+		key, value, found := strings.Cut(pair, "=")
+		if !found || value == "" {
+			return nil, errors.Errorf("scope %q is not a key=value pair", pair)
+		}
+
+		switch key {
+		case "method":
+			scope.Methods = append(scope.Methods, value)
+		case "flavor":
+			scope.Flavors = append(scope.Flavors, value)
+		case "max-lifespan":
+			lifespan, err := time.ParseDuration(value)
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid max-lifespan %q", value)
+			}
+			scope.MaxLifespan = durationpb.New(lifespan)
+		case "max-clusters":
+			maxClusters, err := strconv.ParseInt(value, 10, 32)
+			if err != nil || maxClusters <= 0 {
+				return nil, errors.Errorf("max-clusters %q is not a positive number", value)
+			}
+			scope.MaxClusters = int32(maxClusters)
+		default:
+			return nil, errors.Errorf("unknown scope key %q, expected method, flavor, max-lifespan or max-clusters", key)
+		}
+	}
+
+	return scope, nil
+}
+
+func args(cmd *cobra.Command, args []string) error {
 	name, description, email := args[0], args[1], args[2]
 	if err := validateName(name); err != nil {
 		return err
@@ -77,14 +133,26 @@ func args(_ *cobra.Command, args []string) error {
 	if err := validateDescription(description); err != nil {
 		return err
 	}
-	return validateEmail(email)
+	if err := validateEmail(email); err != nil {
+		return err
+	}
+	pairs, _ := cmd.Flags().GetStringArray("scope")
+	_, err := parseScope(pairs)
+	return err
 }
 
-func token(ctx context.Context, conn *grpc.ClientConn, _ *cobra.Command, args []string) (common.PrettyPrinter, error) {
+func token(ctx context.Context, conn *grpc.ClientConn, cmd *cobra.Command, args []string) (common.PrettyPrinter, error) {
+	pairs, _ := cmd.Flags().GetStringArray("scope")
+	scope, err := parseScope(pairs)
+	if err != nil {
+		return nil, err
+	}
+
 	resp, err := v1.NewUserServiceClient(conn).CreateToken(ctx, &v1.ServiceAccount{
 		Name:        args[0],
 		Description: args[1],
 		Email:       args[2],
+		Scope:       scope,
 	})
 	if err != nil {
 		return nil, err
@@ -0,0 +1,74 @@
+package token
+
+import (
+	"testing"
+	"time"
+
+	v1 "github.com/stackrox/infra/generated/api/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func TestParseScope(t *testing.T) {
+	tests := []struct {
+		name     string
+		pairs    []string
+		expected *v1.TokenScope
+		error    string
+	}{
+		{
+			name: "unscoped",
+		},
+		{
+			name: "full scope",
+			pairs: []string{
+				"method=/v1.ClusterService/Create",
+				"method=/v1.ClusterService/Delete",
+				"flavor=gke-default",
+				"max-lifespan=8h",
+				"max-clusters=5",
+			},
+			expected: &v1.TokenScope{
+				Methods:     []string{"/v1.ClusterService/Create", "/v1.ClusterService/Delete"},
+				Flavors:     []string{"gke-default"},
+				MaxLifespan: durationpb.New(8 * time.Hour),
+				MaxClusters: 5,
+			},
+		},
+		{
+			name:  "missing value",
+			pairs: []string{"flavor="},
+			error: `scope "flavor=" is not a key=value pair`,
+		},
+		{
+			name:  "unknown key",
+			pairs: []string{"team=sre"},
+			error: `unknown scope key "team"`,
+		},
+		{
+			name:  "invalid lifespan",
+			pairs: []string{"max-lifespan=forever"},
+			error: `invalid max-lifespan "forever"`,
+		},
+		{
+			name:  "invalid clusters",
+			pairs: []string{"max-clusters=0"},
+			error: `max-clusters "0" is not a positive number`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			scope, err := parseScope(test.pairs)
+			if test.error != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), test.error)
+				return
+			}
+			require.NoError(t, err)
+			assert.True(t, proto.Equal(test.expected, scope), "expected %v, got %v", test.expected, scope)
+		})
+	}
+}
@@ -2,6 +2,7 @@ package token
 
 import (
 	"encoding/json"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -27,3 +28,23 @@ func (p prettyTokenResponse) PrettyJSONPrint(cmd *cobra.Command) error {
 	cmd.Printf("%s\n", string(data))
 	return nil
 }
+
+// PrintIssuedToken prints the details of an issued service account token.
+func PrintIssuedToken(cmd *cobra.Command, token *v1.IssuedToken) {
+	account := token.GetAccount()
+	cmd.Printf("%s\n", account.GetJti())
+	cmd.Printf("  Name:        %s\n", account.GetName())
+	cmd.Printf("  Description: %s\n", account.GetDescription())
+	cmd.Printf("  Email:       %s\n", account.GetEmail())
+	if account.GetScope() != nil {
+		cmd.Printf("  Scope:       %s\n", common.FormatScope(account.GetScope()))
+	}
+	if token.GetCreatedBy() != "" {
+		cmd.Printf("  Created by:  %s\n", token.GetCreatedBy())
+	}
+	cmd.Printf("  Issued:      %v\n", common.FormatTime(time.Unix(account.GetIssuedAt(), 0)))
+	cmd.Printf("  Expires:     %v\n", common.FormatTime(time.Unix(account.GetExpiresAt(), 0)))
+	if token.GetRevokedAt() != nil {
+		cmd.Printf("  Revoked:     %v by %s\n", common.FormatTime(token.GetRevokedAt().AsTime()), token.GetRevokedBy())
+	}
+}
@@ -0,0 +1,37 @@
+// Package list implements the infractl token list command.
+package list
+
+import (
+	"context"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/spf13/cobra"
+	"github.com/stackrox/infra/cmd/infractl/common"
+	v1 "github.com/stackrox/infra/generated/api/v1"
+	"google.golang.org/grpc"
+)
+
+const examples = `# List your unexpired service account tokens, or everyone's as an admin.
+$ infractl token list`
+
+// Command defines the handler for infractl token list.
+func Command() *cobra.Command {
+	// $ infractl token list
+	return &cobra.Command{
+		Use:     "list",
+		Short:   "List service account tokens",
+		Long:    "Lists the unexpired service account tokens of the current user, or of everyone for admins",
+		Example: examples,
+		Args:    common.ArgsWithHelp(cobra.ExactArgs(0)),
+		RunE:    common.WithGRPCHandler(run),
+	}
+}
+
+func run(ctx context.Context, conn *grpc.ClientConn, _ *cobra.Command, _ []string) (common.PrettyPrinter, error) {
+	resp, err := v1.NewUserServiceClient(conn).ListTokens(ctx, &empty.Empty{})
+	if err != nil {
+		return nil, err
+	}
+
+	return prettyTokenListResponse{resp}, nil
+}
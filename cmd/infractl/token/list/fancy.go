@@ -0,0 +1,30 @@
+package list
+
+import (
+	"encoding/json"
+
+	"github.com/spf13/cobra"
+
+	"github.com/stackrox/infra/cmd/infractl/token"
+	v1 "github.com/stackrox/infra/generated/api/v1"
+)
+
+type prettyTokenListResponse struct {
+	*v1.TokenListResponse
+}
+
+func (p prettyTokenListResponse) PrettyPrint(cmd *cobra.Command) {
+	for _, issued := range p.GetTokens() {
+		token.PrintIssuedToken(cmd, issued)
+	}
+}
+
+func (p prettyTokenListResponse) PrettyJSONPrint(cmd *cobra.Command) error {
+	data, err := json.MarshalIndent(p.TokenListResponse, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	cmd.Printf("%s\n", string(data))
+	return nil
+}
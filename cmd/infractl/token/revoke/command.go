@@ -0,0 +1,44 @@
+// Package revoke implements the infractl token revoke command.
+package revoke
+
+import (
+	"context"
+	"errors"
+
+	"github.com/spf13/cobra"
+	"github.com/stackrox/infra/cmd/infractl/common"
+	v1 "github.com/stackrox/infra/generated/api/v1"
+	"google.golang.org/grpc"
+)
+
+const examples = `# Revoke a service account token.
+$ infractl token revoke 0e9c3cb4-5a55-4d4c-9b5e-2f8a3d1c7e61`
+
+// Command defines the handler for infractl token revoke.
+func Command() *cobra.Command {
+	// $ infractl token revoke
+	return &cobra.Command{
+		Use:     "revoke TOKEN-ID",
+		Short:   "Revoke a service account token",
+		Long:    "Revokes a service account token before it expires. Users may revoke their own tokens, admins any token",
+		Example: examples,
+		Args:    common.ArgsWithHelp(cobra.ExactArgs(1), args),
+		RunE:    common.WithGRPCHandler(run),
+	}
+}
+
+func args(_ *cobra.Command, args []string) error {
+	if args[0] == "" {
+		return errors.New("no token ID given")
+	}
+	return nil
+}
+
+func run(ctx context.Context, conn *grpc.ClientConn, _ *cobra.Command, args []string) (common.PrettyPrinter, error) {
+	resp, err := v1.NewUserServiceClient(conn).RevokeToken(ctx, &v1.ResourceByID{Id: args[0]})
+	if err != nil {
+		return nil, err
+	}
+
+	return prettyIssuedToken{resp}, nil
+}
@@ -0,0 +1,28 @@
+package revoke
+
+import (
+	"encoding/json"
+
+	"github.com/spf13/cobra"
+
+	"github.com/stackrox/infra/cmd/infractl/token"
+	v1 "github.com/stackrox/infra/generated/api/v1"
+)
+
+type prettyIssuedToken struct {
+	*v1.IssuedToken
+}
+
+func (p prettyIssuedToken) PrettyPrint(cmd *cobra.Command) {
+	token.PrintIssuedToken(cmd, p.IssuedToken)
+}
+
+func (p prettyIssuedToken) PrettyJSONPrint(cmd *cobra.Command) error {
+	data, err := json.MarshalIndent(p.IssuedToken, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	cmd.Printf("%s\n", string(data))
+	return nil
+}
@@ -2,9 +2,11 @@ package whoami
 
 import (
 	"encoding/json"
+	"strings"
 
 	"github.com/spf13/cobra"
 
+	"github.com/stackrox/infra/cmd/infractl/common"
 	v1 "github.com/stackrox/infra/generated/api/v1"
 )
 
@@ -21,9 +23,19 @@ func (p prettyWhoamiResp) PrettyPrint(cmd *cobra.Command) {
 		cmd.Printf("  Name:        %s\n", p.ServiceAccount.GetName())
 		cmd.Printf("  Description: %s\n", p.ServiceAccount.GetDescription())
 		cmd.Printf("  Email:       %s\n", p.ServiceAccount.GetEmail())
+		if p.ServiceAccount.GetScope() != nil {
+			cmd.Printf("  Scope:       %s\n", common.FormatScope(p.ServiceAccount.GetScope()))
+		}
 	case nil:
 		cmd.Println("Anonymous")
 	}
+
+	if len(p.GetRoles()) > 0 {
+		cmd.Printf("  Roles:       %s\n", strings.Join(p.GetRoles(), ", "))
+	}
+	if len(p.GetTeams()) > 0 {
+		cmd.Printf("  Teams:       %s\n", strings.Join(p.GetTeams(), ", "))
+	}
 }
 
 func (p prettyWhoamiResp) PrettyJSONPrint(cmd *cobra.Command) error {
@@ -13,6 +13,7 @@ import (
 	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -25,6 +26,71 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// ParameterType represents the types of value that a parameter can accept.
+type ParameterType int32
+
+const (
+	// STRING accepts any string, optionally restricted by a pattern.
+	ParameterType_STRING ParameterType = 0
+	// ENUM accepts one of a list of allowed values.
+	ParameterType_ENUM ParameterType = 1
+	// INT accepts an integer, optionally restricted by a minimum and maximum.
+	ParameterType_INT ParameterType = 2
+	// BOOL accepts "true" or "false".
+	ParameterType_BOOL ParameterType = 3
+	// DURATION accepts a duration, such as "3h".
+	ParameterType_DURATION ParameterType = 4
+	// IMAGE accepts a container image reference.
+	ParameterType_IMAGE ParameterType = 5
+)
+
+// Enum value maps for ParameterType.
+var (
+	ParameterType_name = map[int32]string{
+		0: "STRING",
+		1: "ENUM",
+		2: "INT",
+		3: "BOOL",
+		4: "DURATION",
+		5: "IMAGE",
+	}
+	ParameterType_value = map[string]int32{
+		"STRING":   0,
+		"ENUM":     1,
+		"INT":      2,
+		"BOOL":     3,
+		"DURATION": 4,
+		"IMAGE":    5,
+	}
+)
+
+func (x ParameterType) Enum() *ParameterType {
+	p := new(ParameterType)
+	*p = x
+	return p
+}
+
+func (x ParameterType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ParameterType) Descriptor() protoreflect.EnumDescriptor {
+	return file_service_proto_enumTypes[0].Descriptor()
+}
+
+func (ParameterType) Type() protoreflect.EnumType {
+	return &file_service_proto_enumTypes[0]
+}
+
+func (x ParameterType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ParameterType.Descriptor instead.
+func (ParameterType) EnumDescriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{0}
+}
+
 // Status represents the various cluster states.
 type Status int32
 
@@ -70,11 +136,11 @@ func (x Status) String() string {
 }
 
 func (Status) Descriptor() protoreflect.EnumDescriptor {
-	return file_service_proto_enumTypes[0].Descriptor()
+	return file_service_proto_enumTypes[1].Descriptor()
 }
 
 func (Status) Type() protoreflect.EnumType {
-	return &file_service_proto_enumTypes[0]
+	return &file_service_proto_enumTypes[1]
 }
 
 func (x Status) Number() protoreflect.EnumNumber {
@@ -83,7 +149,7 @@ func (x Status) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Status.Descriptor instead.
 func (Status) EnumDescriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{0}
+	return file_service_proto_rawDescGZIP(), []int{1}
 }
 
 // availability represents the availability classification levels.
@@ -140,11 +206,11 @@ func (x FlavorAvailability) String() string {
 }
 
 func (FlavorAvailability) Descriptor() protoreflect.EnumDescriptor {
-	return file_service_proto_enumTypes[1].Descriptor()
+	return file_service_proto_enumTypes[2].Descriptor()
 }
 
 func (FlavorAvailability) Type() protoreflect.EnumType {
-	return &file_service_proto_enumTypes[1]
+	return &file_service_proto_enumTypes[2]
 }
 
 func (x FlavorAvailability) Number() protoreflect.EnumNumber {
@@ -153,7 +219,7 @@ func (x FlavorAvailability) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use FlavorAvailability.Descriptor instead.
 func (FlavorAvailability) EnumDescriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{8, 0}
+	return file_service_proto_rawDescGZIP(), []int{11, 0}
 }
 
 // method represents the various lifespan operations.
@@ -196,11 +262,11 @@ func (x LifespanRequest_Method) String() string {
 }
 
 func (LifespanRequest_Method) Descriptor() protoreflect.EnumDescriptor {
-	return file_service_proto_enumTypes[2].Descriptor()
+	return file_service_proto_enumTypes[3].Descriptor()
 }
 
 func (LifespanRequest_Method) Type() protoreflect.EnumType {
-	return &file_service_proto_enumTypes[2]
+	return &file_service_proto_enumTypes[3]
 }
 
 func (x LifespanRequest_Method) Number() protoreflect.EnumNumber {
@@ -209,7 +275,7 @@ func (x LifespanRequest_Method) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use LifespanRequest_Method.Descriptor instead.
 func (LifespanRequest_Method) EnumDescriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{14, 0}
+	return file_service_proto_rawDescGZIP(), []int{21, 0}
 }
 
 // ResourceByID represents a generic reference to a named/unique resource.
@@ -350,7 +416,11 @@ type WhoamiResponse struct {
 	//
 	//	*WhoamiResponse_User
 	//	*WhoamiResponse_ServiceAccount
-	Principal     isWhoamiResponse_Principal `protobuf_oneof:"principal"`
+	Principal isWhoamiResponse_Principal `protobuf_oneof:"principal"`
+	// Roles are the effective roles granted to the principal.
+	Roles []string `protobuf:"bytes,3,rep,name=Roles,proto3" json:"Roles,omitempty"`
+	// Teams are the teams that the principal is a member of.
+	Teams         []string `protobuf:"bytes,4,rep,name=Teams,proto3" json:"Teams,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -410,6 +480,20 @@ func (x *WhoamiResponse) GetServiceAccount() *ServiceAccount {
 	return nil
 }
 
+func (x *WhoamiResponse) GetRoles() []string {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+func (x *WhoamiResponse) GetTeams() []string {
+	if x != nil {
+		return x.Teams
+	}
+	return nil
+}
+
 type isWhoamiResponse_Principal interface {
 	isWhoamiResponse_Principal()
 }
@@ -439,7 +523,10 @@ type User struct {
 	// Email is the email address of the user.
 	Email string `protobuf:"bytes,3,opt,name=Email,proto3" json:"Email,omitempty"`
 	// Picture is a URL linking to this user's profile picture, if available.
-	Picture       string `protobuf:"bytes,4,opt,name=Picture,proto3" json:"Picture,omitempty"`
+	Picture string `protobuf:"bytes,4,opt,name=Picture,proto3" json:"Picture,omitempty"`
+	// Groups are the OIDC groups that the user is a member of, as extracted
+	// from the configured group claims.
+	Groups        []string `protobuf:"bytes,5,rep,name=Groups,proto3" json:"Groups,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -502,6 +589,13 @@ func (x *User) GetPicture() string {
 	return ""
 }
 
+func (x *User) GetGroups() []string {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
 // ServiceAccount represents an authenticated service account (robot) principal.
 type ServiceAccount struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -516,7 +610,16 @@ type ServiceAccount struct {
 	// NotBefore is the beginning of service account token valid time period.
 	NotBefore int64 `protobuf:"varint,5,opt,name=NotBefore,proto3" json:"NotBefore,omitempty"`
 	// ExpiresAt is the end of service account token valid time period.
-	ExpiresAt     int64 `protobuf:"varint,6,opt,name=ExpiresAt,proto3" json:"ExpiresAt,omitempty"`
+	ExpiresAt int64 `protobuf:"varint,6,opt,name=ExpiresAt,proto3" json:"ExpiresAt,omitempty"`
+	// jti is the unique ID of the service account token.
+	Jti string `protobuf:"bytes,7,opt,name=jti,proto3" json:"jti,omitempty"`
+	// Scope restricts what the service account token may be used for. The
+	// token is unrestricted without a scope.
+	Scope *TokenScope `protobuf:"bytes,8,opt,name=Scope,proto3" json:"Scope,omitempty"`
+	// Groups are the OIDC groups of the user that a personal token is issued
+	// for, at the time it is issued. The role and team bindings of these
+	// groups apply to the token.
+	Groups        []string `protobuf:"bytes,9,rep,name=Groups,proto3" json:"Groups,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -593,6 +696,106 @@ func (x *ServiceAccount) GetExpiresAt() int64 {
 	return 0
 }
 
+func (x *ServiceAccount) GetJti() string {
+	if x != nil {
+		return x.Jti
+	}
+	return ""
+}
+
+func (x *ServiceAccount) GetScope() *TokenScope {
+	if x != nil {
+		return x.Scope
+	}
+	return nil
+}
+
+func (x *ServiceAccount) GetGroups() []string {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+// TokenScope restricts what a service account token may be used for. Unset
+// fields impose no restriction.
+type TokenScope struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Methods are the full names of the RPC methods that the token may call,
+	// such as "/v1.ClusterService/Create", or "/v1.ClusterService/*" for every
+	// method of a service. Methods that allow anonymous access may always be
+	// called.
+	Methods []string `protobuf:"bytes,1,rep,name=Methods,proto3" json:"Methods,omitempty"`
+	// Flavors are the IDs of the flavors that the token may create clusters
+	// of.
+	Flavors []string `protobuf:"bytes,2,rep,name=Flavors,proto3" json:"Flavors,omitempty"`
+	// MaxLifespan is the longest lifespan that the token may give clusters.
+	MaxLifespan *durationpb.Duration `protobuf:"bytes,3,opt,name=MaxLifespan,proto3" json:"MaxLifespan,omitempty"`
+	// MaxClusters is the number of creating or ready clusters that the
+	// service account may own at once when creating clusters with the token.
+	MaxClusters   int32 `protobuf:"varint,4,opt,name=MaxClusters,proto3" json:"MaxClusters,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TokenScope) Reset() {
+	*x = TokenScope{}
+	mi := &file_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TokenScope) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TokenScope) ProtoMessage() {}
+
+func (x *TokenScope) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TokenScope.ProtoReflect.Descriptor instead.
+func (*TokenScope) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *TokenScope) GetMethods() []string {
+	if x != nil {
+		return x.Methods
+	}
+	return nil
+}
+
+func (x *TokenScope) GetFlavors() []string {
+	if x != nil {
+		return x.Flavors
+	}
+	return nil
+}
+
+func (x *TokenScope) GetMaxLifespan() *durationpb.Duration {
+	if x != nil {
+		return x.MaxLifespan
+	}
+	return nil
+}
+
+func (x *TokenScope) GetMaxClusters() int32 {
+	if x != nil {
+		return x.MaxClusters
+	}
+	return 0
+}
+
 type TokenResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Account is the service account the token was generated for.
@@ -605,7 +808,7 @@ type TokenResponse struct {
 
 func (x *TokenResponse) Reset() {
 	*x = TokenResponse{}
-	mi := &file_service_proto_msgTypes[5]
+	mi := &file_service_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -617,7 +820,7 @@ func (x *TokenResponse) String() string {
 func (*TokenResponse) ProtoMessage() {}
 
 func (x *TokenResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[5]
+	mi := &file_service_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -630,7 +833,7 @@ func (x *TokenResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TokenResponse.ProtoReflect.Descriptor instead.
 func (*TokenResponse) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{5}
+	return file_service_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *TokenResponse) GetAccount() *ServiceAccount {
@@ -647,6 +850,124 @@ func (x *TokenResponse) GetToken() string {
 	return ""
 }
 
+// IssuedToken is a record of an issued service account token.
+type IssuedToken struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Account is the service account the token was generated for.
+	Account *ServiceAccount `protobuf:"bytes,1,opt,name=Account,proto3" json:"Account,omitempty"`
+	// CreatedBy is the principal that requested the token.
+	CreatedBy string `protobuf:"bytes,2,opt,name=CreatedBy,proto3" json:"CreatedBy,omitempty"`
+	// RevokedAt is the time the token was revoked at, if it was.
+	RevokedAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=RevokedAt,proto3" json:"RevokedAt,omitempty"`
+	// RevokedBy is the principal that revoked the token, if any.
+	RevokedBy     string `protobuf:"bytes,4,opt,name=RevokedBy,proto3" json:"RevokedBy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IssuedToken) Reset() {
+	*x = IssuedToken{}
+	mi := &file_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IssuedToken) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IssuedToken) ProtoMessage() {}
+
+func (x *IssuedToken) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IssuedToken.ProtoReflect.Descriptor instead.
+func (*IssuedToken) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *IssuedToken) GetAccount() *ServiceAccount {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+func (x *IssuedToken) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *IssuedToken) GetRevokedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RevokedAt
+	}
+	return nil
+}
+
+func (x *IssuedToken) GetRevokedBy() string {
+	if x != nil {
+		return x.RevokedBy
+	}
+	return ""
+}
+
+type TokenListResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Tokens are the issued tokens that have not expired yet.
+	Tokens        []*IssuedToken `protobuf:"bytes,1,rep,name=Tokens,proto3" json:"Tokens,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TokenListResponse) Reset() {
+	*x = TokenListResponse{}
+	mi := &file_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TokenListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TokenListResponse) ProtoMessage() {}
+
+func (x *TokenListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TokenListResponse.ProtoReflect.Descriptor instead.
+func (*TokenListResponse) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *TokenListResponse) GetTokens() []*IssuedToken {
+	if x != nil {
+		return x.Tokens
+	}
+	return nil
+}
+
 // Parameter represents a single parameter that is needed to launch a flavor.
 type Parameter struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -672,14 +993,24 @@ type Parameter struct {
 	Help  string `protobuf:"bytes,7,opt,name=Help,proto3" json:"Help,omitempty"`
 	// Indicates that the value for this parameter can be provided from the
 	// contents of a file.
-	FromFile      bool `protobuf:"varint,8,opt,name=FromFile,proto3" json:"FromFile,omitempty"`
+	FromFile bool `protobuf:"varint,8,opt,name=FromFile,proto3" json:"FromFile,omitempty"`
+	// Type is the type of value that this parameter accepts.
+	Type ParameterType `protobuf:"varint,9,opt,name=Type,proto3,enum=v1.ParameterType" json:"Type,omitempty"`
+	// AllowedValues is the list of values accepted by an ENUM parameter.
+	AllowedValues []string `protobuf:"bytes,10,rep,name=AllowedValues,proto3" json:"AllowedValues,omitempty"`
+	// Min is the smallest value accepted by an INT parameter, if limited.
+	Min *wrapperspb.Int64Value `protobuf:"bytes,11,opt,name=Min,proto3" json:"Min,omitempty"`
+	// Max is the largest value accepted by an INT parameter, if limited.
+	Max *wrapperspb.Int64Value `protobuf:"bytes,12,opt,name=Max,proto3" json:"Max,omitempty"`
+	// Pattern is a regular expression that STRING values must match, if set.
+	Pattern       string `protobuf:"bytes,13,opt,name=Pattern,proto3" json:"Pattern,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Parameter) Reset() {
 	*x = Parameter{}
-	mi := &file_service_proto_msgTypes[6]
+	mi := &file_service_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -691,7 +1022,7 @@ func (x *Parameter) String() string {
 func (*Parameter) ProtoMessage() {}
 
 func (x *Parameter) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[6]
+	mi := &file_service_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -704,7 +1035,7 @@ func (x *Parameter) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Parameter.ProtoReflect.Descriptor instead.
 func (*Parameter) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{6}
+	return file_service_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *Parameter) GetName() string {
@@ -763,6 +1094,41 @@ func (x *Parameter) GetFromFile() bool {
 	return false
 }
 
+func (x *Parameter) GetType() ParameterType {
+	if x != nil {
+		return x.Type
+	}
+	return ParameterType_STRING
+}
+
+func (x *Parameter) GetAllowedValues() []string {
+	if x != nil {
+		return x.AllowedValues
+	}
+	return nil
+}
+
+func (x *Parameter) GetMin() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Min
+	}
+	return nil
+}
+
+func (x *Parameter) GetMax() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Max
+	}
+	return nil
+}
+
+func (x *Parameter) GetPattern() string {
+	if x != nil {
+		return x.Pattern
+	}
+	return ""
+}
+
 // FlavorArtifact represents a single artifact that is produced by a flavor.
 type FlavorArtifact struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -778,7 +1144,7 @@ type FlavorArtifact struct {
 
 func (x *FlavorArtifact) Reset() {
 	*x = FlavorArtifact{}
-	mi := &file_service_proto_msgTypes[7]
+	mi := &file_service_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -790,7 +1156,7 @@ func (x *FlavorArtifact) String() string {
 func (*FlavorArtifact) ProtoMessage() {}
 
 func (x *FlavorArtifact) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[7]
+	mi := &file_service_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -803,7 +1169,7 @@ func (x *FlavorArtifact) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FlavorArtifact.ProtoReflect.Descriptor instead.
 func (*FlavorArtifact) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{7}
+	return file_service_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *FlavorArtifact) GetName() string {
@@ -843,14 +1209,17 @@ type Flavor struct {
 	// Artifacts is a map of artifacts produced by this flavor.
 	Artifacts map[string]*FlavorArtifact `protobuf:"bytes,6,rep,name=Artifacts,proto3" json:"Artifacts,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	// Aliases are alternative IDs of the flavor.
-	Aliases       []string `protobuf:"bytes,7,rep,name=Aliases,proto3" json:"Aliases,omitempty"`
+	Aliases []string `protobuf:"bytes,7,rep,name=Aliases,proto3" json:"Aliases,omitempty"`
+	// Teams are the teams that the flavor is restricted to. Flavors that are
+	// not restricted are available to everyone.
+	Teams         []string `protobuf:"bytes,8,rep,name=Teams,proto3" json:"Teams,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Flavor) Reset() {
 	*x = Flavor{}
-	mi := &file_service_proto_msgTypes[8]
+	mi := &file_service_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -862,7 +1231,7 @@ func (x *Flavor) String() string {
 func (*Flavor) ProtoMessage() {}
 
 func (x *Flavor) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[8]
+	mi := &file_service_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -875,7 +1244,7 @@ func (x *Flavor) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Flavor.ProtoReflect.Descriptor instead.
 func (*Flavor) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{8}
+	return file_service_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *Flavor) GetID() string {
@@ -927,6 +1296,13 @@ func (x *Flavor) GetAliases() []string {
 	return nil
 }
 
+func (x *Flavor) GetTeams() []string {
+	if x != nil {
+		return x.Teams
+	}
+	return nil
+}
+
 // FlavorListRequest represents a request to FlavorService.List.
 type FlavorListRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -939,7 +1315,7 @@ type FlavorListRequest struct {
 
 func (x *FlavorListRequest) Reset() {
 	*x = FlavorListRequest{}
-	mi := &file_service_proto_msgTypes[9]
+	mi := &file_service_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -951,7 +1327,7 @@ func (x *FlavorListRequest) String() string {
 func (*FlavorListRequest) ProtoMessage() {}
 
 func (x *FlavorListRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[9]
+	mi := &file_service_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -964,7 +1340,7 @@ func (x *FlavorListRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FlavorListRequest.ProtoReflect.Descriptor instead.
 func (*FlavorListRequest) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{9}
+	return file_service_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *FlavorListRequest) GetAll() bool {
@@ -987,7 +1363,7 @@ type FlavorListResponse struct {
 
 func (x *FlavorListResponse) Reset() {
 	*x = FlavorListResponse{}
-	mi := &file_service_proto_msgTypes[10]
+	mi := &file_service_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -999,7 +1375,7 @@ func (x *FlavorListResponse) String() string {
 func (*FlavorListResponse) ProtoMessage() {}
 
 func (x *FlavorListResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[10]
+	mi := &file_service_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1012,7 +1388,7 @@ func (x *FlavorListResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FlavorListResponse.ProtoReflect.Descriptor instead.
 func (*FlavorListResponse) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{10}
+	return file_service_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *FlavorListResponse) GetDefault() string {
@@ -1029,6 +1405,80 @@ func (x *FlavorListResponse) GetFlavors() []*Flavor {
 	return nil
 }
 
+// FlavorRegistryStatus represents the outcome of loading the flavor config.
+type FlavorRegistryStatus struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ConfigHash is the hash of the flavor config and workflows in use.
+	ConfigHash string `protobuf:"bytes,1,opt,name=ConfigHash,proto3" json:"ConfigHash,omitempty"`
+	// LoadedOn is the time at which the flavor config in use was loaded.
+	LoadedOn *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=LoadedOn,proto3" json:"LoadedOn,omitempty"`
+	// LastReload is the time at which the flavor config was last reloaded.
+	LastReload *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=LastReload,proto3" json:"LastReload,omitempty"`
+	// LastReloadError is the error from the last reload, if it failed. The
+	// previously loaded flavor config remains in use.
+	LastReloadError string `protobuf:"bytes,4,opt,name=LastReloadError,proto3" json:"LastReloadError,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *FlavorRegistryStatus) Reset() {
+	*x = FlavorRegistryStatus{}
+	mi := &file_service_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FlavorRegistryStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlavorRegistryStatus) ProtoMessage() {}
+
+func (x *FlavorRegistryStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlavorRegistryStatus.ProtoReflect.Descriptor instead.
+func (*FlavorRegistryStatus) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *FlavorRegistryStatus) GetConfigHash() string {
+	if x != nil {
+		return x.ConfigHash
+	}
+	return ""
+}
+
+func (x *FlavorRegistryStatus) GetLoadedOn() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LoadedOn
+	}
+	return nil
+}
+
+func (x *FlavorRegistryStatus) GetLastReload() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastReload
+	}
+	return nil
+}
+
+func (x *FlavorRegistryStatus) GetLastReloadError() string {
+	if x != nil {
+		return x.LastReloadError
+	}
+	return ""
+}
+
 // Cluster represents a single cluster.
 type Cluster struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -1054,14 +1504,23 @@ type Cluster struct {
 	// Connect is a command to add kube connection information to kubeconfig.
 	Connect string `protobuf:"bytes,10,opt,name=Connect,proto3" json:"Connect,omitempty"`
 	// Parameters is a list of options to configure the cluster creation.
-	Parameters    []*Parameter `protobuf:"bytes,11,rep,name=Parameters,proto3" json:"Parameters,omitempty"`
+	Parameters []*Parameter `protobuf:"bytes,11,rep,name=Parameters,proto3" json:"Parameters,omitempty"`
+	// Collaborators is a list of email addresses for people who share the
+	// cluster with its owner.
+	Collaborators []string `protobuf:"bytes,12,rep,name=Collaborators,proto3" json:"Collaborators,omitempty"`
+	// Labels are user-defined labels, such as a team, ticket or CI run ID.
+	Labels map[string]string `protobuf:"bytes,13,rep,name=Labels,proto3" json:"Labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Teams are the teams that the creator of the cluster was a member of.
+	// Clusters of teams are only visible to the members of those teams, as
+	// well as to their owner, collaborators and infra admins.
+	Teams         []string `protobuf:"bytes,14,rep,name=Teams,proto3" json:"Teams,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Cluster) Reset() {
 	*x = Cluster{}
-	mi := &file_service_proto_msgTypes[11]
+	mi := &file_service_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1073,7 +1532,7 @@ func (x *Cluster) String() string {
 func (*Cluster) ProtoMessage() {}
 
 func (x *Cluster) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[11]
+	mi := &file_service_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1086,7 +1545,7 @@ func (x *Cluster) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Cluster.ProtoReflect.Descriptor instead.
 func (*Cluster) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{11}
+	return file_service_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *Cluster) GetID() string {
@@ -1166,6 +1625,205 @@ func (x *Cluster) GetParameters() []*Parameter {
 	return nil
 }
 
+func (x *Cluster) GetCollaborators() []string {
+	if x != nil {
+		return x.Collaborators
+	}
+	return nil
+}
+
+func (x *Cluster) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *Cluster) GetTeams() []string {
+	if x != nil {
+		return x.Teams
+	}
+	return nil
+}
+
+// ClusterRequest represents a request for a specific cluster.
+type ClusterRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID is the unique ID for the cluster.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Generation selects an earlier generation of a cluster whose ID was
+	// reused. It is either the generation number, where 1 is the first, or
+	// the name of its workflow. The most recent generation is selected when
+	// empty.
+	Generation    string `protobuf:"bytes,2,opt,name=generation,proto3" json:"generation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClusterRequest) Reset() {
+	*x = ClusterRequest{}
+	mi := &file_service_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClusterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClusterRequest) ProtoMessage() {}
+
+func (x *ClusterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClusterRequest.ProtoReflect.Descriptor instead.
+func (*ClusterRequest) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ClusterRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ClusterRequest) GetGeneration() string {
+	if x != nil {
+		return x.Generation
+	}
+	return ""
+}
+
+// ClusterGeneration represents a single generation of a cluster.
+type ClusterGeneration struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Generation is the number of the generation, where 1 is the first.
+	Generation int32 `protobuf:"varint,1,opt,name=Generation,proto3" json:"Generation,omitempty"`
+	// WorkflowName is the name of the workflow of the generation.
+	WorkflowName string `protobuf:"bytes,2,opt,name=WorkflowName,proto3" json:"WorkflowName,omitempty"`
+	// Cluster is the cluster of the generation, including its status,
+	// timestamps, flavor, owner, and parameters.
+	Cluster *Cluster `protobuf:"bytes,3,opt,name=Cluster,proto3" json:"Cluster,omitempty"`
+	// FailureDetails describes why the generation failed, if known.
+	FailureDetails string `protobuf:"bytes,4,opt,name=FailureDetails,proto3" json:"FailureDetails,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ClusterGeneration) Reset() {
+	*x = ClusterGeneration{}
+	mi := &file_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClusterGeneration) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClusterGeneration) ProtoMessage() {}
+
+func (x *ClusterGeneration) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClusterGeneration.ProtoReflect.Descriptor instead.
+func (*ClusterGeneration) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ClusterGeneration) GetGeneration() int32 {
+	if x != nil {
+		return x.Generation
+	}
+	return 0
+}
+
+func (x *ClusterGeneration) GetWorkflowName() string {
+	if x != nil {
+		return x.WorkflowName
+	}
+	return ""
+}
+
+func (x *ClusterGeneration) GetCluster() *Cluster {
+	if x != nil {
+		return x.Cluster
+	}
+	return nil
+}
+
+func (x *ClusterGeneration) GetFailureDetails() string {
+	if x != nil {
+		return x.FailureDetails
+	}
+	return ""
+}
+
+// ClusterHistoryResponse represents every generation of a cluster.
+type ClusterHistoryResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Generations are the generations of the cluster, most recent first.
+	Generations   []*ClusterGeneration `protobuf:"bytes,1,rep,name=Generations,proto3" json:"Generations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClusterHistoryResponse) Reset() {
+	*x = ClusterHistoryResponse{}
+	mi := &file_service_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClusterHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClusterHistoryResponse) ProtoMessage() {}
+
+func (x *ClusterHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClusterHistoryResponse.ProtoReflect.Descriptor instead.
+func (*ClusterHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ClusterHistoryResponse) GetGenerations() []*ClusterGeneration {
+	if x != nil {
+		return x.Generations
+	}
+	return nil
+}
+
 // ClusterListRequest represents a request to ClusterService.List.
 type ClusterListRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -1181,13 +1839,16 @@ type ClusterListRequest struct {
 	AllowedStatuses []Status `protobuf:"varint,4,rep,packed,name=allowedStatuses,proto3,enum=v1.Status" json:"allowedStatuses,omitempty"`
 	// filter clusters whose flavor ID is in the list
 	AllowedFlavors []string `protobuf:"bytes,5,rep,name=allowedFlavors,proto3" json:"allowedFlavors,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	// filter clusters whose user-defined labels match this Kubernetes label
+	// selector, e.g. "team=sre,ticket".
+	LabelSelector string `protobuf:"bytes,6,opt,name=labelSelector,proto3" json:"labelSelector,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ClusterListRequest) Reset() {
 	*x = ClusterListRequest{}
-	mi := &file_service_proto_msgTypes[12]
+	mi := &file_service_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1199,7 +1860,7 @@ func (x *ClusterListRequest) String() string {
 func (*ClusterListRequest) ProtoMessage() {}
 
 func (x *ClusterListRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[12]
+	mi := &file_service_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1212,7 +1873,7 @@ func (x *ClusterListRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ClusterListRequest.ProtoReflect.Descriptor instead.
 func (*ClusterListRequest) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{12}
+	return file_service_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *ClusterListRequest) GetAll() bool {
@@ -1250,6 +1911,13 @@ func (x *ClusterListRequest) GetAllowedFlavors() []string {
 	return nil
 }
 
+func (x *ClusterListRequest) GetLabelSelector() string {
+	if x != nil {
+		return x.LabelSelector
+	}
+	return ""
+}
+
 // ClusterListResponse represents details about all clusters.
 type ClusterListResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -1261,7 +1929,7 @@ type ClusterListResponse struct {
 
 func (x *ClusterListResponse) Reset() {
 	*x = ClusterListResponse{}
-	mi := &file_service_proto_msgTypes[13]
+	mi := &file_service_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1273,7 +1941,7 @@ func (x *ClusterListResponse) String() string {
 func (*ClusterListResponse) ProtoMessage() {}
 
 func (x *ClusterListResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[13]
+	mi := &file_service_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1286,7 +1954,7 @@ func (x *ClusterListResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ClusterListResponse.ProtoReflect.Descriptor instead.
 func (*ClusterListResponse) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{13}
+	return file_service_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *ClusterListResponse) GetClusters() []*Cluster {
@@ -1296,32 +1964,1418 @@ func (x *ClusterListResponse) GetClusters() []*Cluster {
 	return nil
 }
 
-type LifespanRequest struct {
+type LifespanRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID is the unique ID for the cluster.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Lifespan is the new lifespan.
+	Lifespan *durationpb.Duration   `protobuf:"bytes,2,opt,name=Lifespan,proto3" json:"Lifespan,omitempty"`
+	Method   LifespanRequest_Method `protobuf:"varint,3,opt,name=method,proto3,enum=v1.LifespanRequest_Method" json:"method,omitempty"`
+	// Force allows a caller that is neither the owner nor an admin to change
+	// the lifespan. A reason is required, which is sent to the owner.
+	Force bool `protobuf:"varint,4,opt,name=force,proto3" json:"force,omitempty"`
+	// Reason explains why the lifespan is changed by force.
+	Reason        string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LifespanRequest) Reset() {
+	*x = LifespanRequest{}
+	mi := &file_service_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LifespanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LifespanRequest) ProtoMessage() {}
+
+func (x *LifespanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LifespanRequest.ProtoReflect.Descriptor instead.
+func (*LifespanRequest) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *LifespanRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *LifespanRequest) GetLifespan() *durationpb.Duration {
+	if x != nil {
+		return x.Lifespan
+	}
+	return nil
+}
+
+func (x *LifespanRequest) GetMethod() LifespanRequest_Method {
+	if x != nil {
+		return x.Method
+	}
+	return LifespanRequest_REPLACE
+}
+
+func (x *LifespanRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+func (x *LifespanRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// DeleteClusterRequest represents a request to ClusterService.Delete.
+type DeleteClusterRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID is the unique ID for the cluster.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Force allows a caller that is neither the owner nor an admin to delete
+	// the cluster. A reason is required, which is sent to the owner.
+	Force bool `protobuf:"varint,2,opt,name=force,proto3" json:"force,omitempty"`
+	// Reason explains why the cluster is deleted by force.
+	Reason        string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteClusterRequest) Reset() {
+	*x = DeleteClusterRequest{}
+	mi := &file_service_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteClusterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteClusterRequest) ProtoMessage() {}
+
+func (x *DeleteClusterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteClusterRequest.ProtoReflect.Descriptor instead.
+func (*DeleteClusterRequest) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *DeleteClusterRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DeleteClusterRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+func (x *DeleteClusterRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// BulkDeleteRequest represents a request to ClusterService.BulkDelete. The
+// clusters are selected either by their IDs, or by a filter.
+type BulkDeleteRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// IDs are the unique IDs of the clusters.
+	Ids []string `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	// Filter selects the clusters as ClusterService.List does.
+	Filter *ClusterListRequest `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
+	// DryRun reports which clusters would be deleted without deleting them.
+	DryRun bool `protobuf:"varint,3,opt,name=dryRun,proto3" json:"dryRun,omitempty"`
+	// Force and Reason apply to every cluster, as in DeleteClusterRequest.
+	Force         bool   `protobuf:"varint,4,opt,name=force,proto3" json:"force,omitempty"`
+	Reason        string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkDeleteRequest) Reset() {
+	*x = BulkDeleteRequest{}
+	mi := &file_service_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkDeleteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkDeleteRequest) ProtoMessage() {}
+
+func (x *BulkDeleteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkDeleteRequest.ProtoReflect.Descriptor instead.
+func (*BulkDeleteRequest) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *BulkDeleteRequest) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *BulkDeleteRequest) GetFilter() *ClusterListRequest {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *BulkDeleteRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *BulkDeleteRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+func (x *BulkDeleteRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// BulkLifespanRequest represents a request to ClusterService.BulkLifespan.
+// The clusters are selected either by their IDs, or by a filter.
+type BulkLifespanRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// IDs are the unique IDs of the clusters.
+	Ids []string `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	// Filter selects the clusters as ClusterService.List does.
+	Filter *ClusterListRequest `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
+	// DryRun reports which clusters would be updated without updating them.
+	DryRun bool `protobuf:"varint,3,opt,name=dryRun,proto3" json:"dryRun,omitempty"`
+	// Lifespan and Method apply to every cluster, as in LifespanRequest.
+	Lifespan *durationpb.Duration   `protobuf:"bytes,4,opt,name=Lifespan,proto3" json:"Lifespan,omitempty"`
+	Method   LifespanRequest_Method `protobuf:"varint,5,opt,name=method,proto3,enum=v1.LifespanRequest_Method" json:"method,omitempty"`
+	// Force and Reason apply to every cluster, as in LifespanRequest.
+	Force         bool   `protobuf:"varint,6,opt,name=force,proto3" json:"force,omitempty"`
+	Reason        string `protobuf:"bytes,7,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkLifespanRequest) Reset() {
+	*x = BulkLifespanRequest{}
+	mi := &file_service_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkLifespanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkLifespanRequest) ProtoMessage() {}
+
+func (x *BulkLifespanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkLifespanRequest.ProtoReflect.Descriptor instead.
+func (*BulkLifespanRequest) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *BulkLifespanRequest) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *BulkLifespanRequest) GetFilter() *ClusterListRequest {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *BulkLifespanRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *BulkLifespanRequest) GetLifespan() *durationpb.Duration {
+	if x != nil {
+		return x.Lifespan
+	}
+	return nil
+}
+
+func (x *BulkLifespanRequest) GetMethod() LifespanRequest_Method {
+	if x != nil {
+		return x.Method
+	}
+	return LifespanRequest_REPLACE
+}
+
+func (x *BulkLifespanRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+func (x *BulkLifespanRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// BulkResult represents the result of a bulk operation for a single cluster.
+type BulkResult struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID is the unique ID for the cluster.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Error describes why the operation failed for the cluster. It is empty
+	// when the operation succeeded, or would succeed in a dry run.
+	Error string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	// Lifespan is the updated lifespan of the cluster after a lifespan
+	// update.
+	Lifespan      *durationpb.Duration `protobuf:"bytes,3,opt,name=Lifespan,proto3" json:"Lifespan,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkResult) Reset() {
+	*x = BulkResult{}
+	mi := &file_service_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkResult) ProtoMessage() {}
+
+func (x *BulkResult) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkResult.ProtoReflect.Descriptor instead.
+func (*BulkResult) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *BulkResult) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *BulkResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *BulkResult) GetLifespan() *durationpb.Duration {
+	if x != nil {
+		return x.Lifespan
+	}
+	return nil
+}
+
+// BulkResponse represents the results of a bulk operation.
+type BulkResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Results are the result for every selected cluster.
+	Results []*BulkResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	// DryRun indicates that no cluster was changed.
+	DryRun        bool `protobuf:"varint,2,opt,name=dryRun,proto3" json:"dryRun,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkResponse) Reset() {
+	*x = BulkResponse{}
+	mi := &file_service_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkResponse) ProtoMessage() {}
+
+func (x *BulkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkResponse.ProtoReflect.Descriptor instead.
+func (*BulkResponse) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *BulkResponse) GetResults() []*BulkResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *BulkResponse) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+// UpdateOwnershipRequest represents a request to ClusterService.UpdateOwnership.
+type UpdateOwnershipRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID is the unique ID for the cluster.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Owner is the email address for the new cluster owner. The owner is
+	// left unchanged when empty.
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// AddCollaborators is a list of email addresses to add as collaborators.
+	AddCollaborators []string `protobuf:"bytes,3,rep,name=addCollaborators,proto3" json:"addCollaborators,omitempty"`
+	// RemoveCollaborators is a list of email addresses to remove from the
+	// collaborators.
+	RemoveCollaborators []string `protobuf:"bytes,4,rep,name=removeCollaborators,proto3" json:"removeCollaborators,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *UpdateOwnershipRequest) Reset() {
+	*x = UpdateOwnershipRequest{}
+	mi := &file_service_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateOwnershipRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateOwnershipRequest) ProtoMessage() {}
+
+func (x *UpdateOwnershipRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateOwnershipRequest.ProtoReflect.Descriptor instead.
+func (*UpdateOwnershipRequest) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *UpdateOwnershipRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateOwnershipRequest) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *UpdateOwnershipRequest) GetAddCollaborators() []string {
+	if x != nil {
+		return x.AddCollaborators
+	}
+	return nil
+}
+
+func (x *UpdateOwnershipRequest) GetRemoveCollaborators() []string {
+	if x != nil {
+		return x.RemoveCollaborators
+	}
+	return nil
+}
+
+// UpdateLabelsRequest represents a request to ClusterService.UpdateLabels.
+type UpdateLabelsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID is the unique ID for the cluster.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Set is a map of label names to values to add or replace.
+	Set map[string]string `protobuf:"bytes,2,rep,name=set,proto3" json:"set,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Remove is a list of label names to remove.
+	Remove        []string `protobuf:"bytes,3,rep,name=remove,proto3" json:"remove,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateLabelsRequest) Reset() {
+	*x = UpdateLabelsRequest{}
+	mi := &file_service_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateLabelsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateLabelsRequest) ProtoMessage() {}
+
+func (x *UpdateLabelsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateLabelsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateLabelsRequest) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *UpdateLabelsRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateLabelsRequest) GetSet() map[string]string {
+	if x != nil {
+		return x.Set
+	}
+	return nil
+}
+
+func (x *UpdateLabelsRequest) GetRemove() []string {
+	if x != nil {
+		return x.Remove
+	}
+	return nil
+}
+
+// CreateClusterRequest represents details for launching a new cluster.
+type CreateClusterRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID is the flavor ID to launch.
+	ID string `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	// Lifespan is the initial cluster lifespan.
+	Lifespan *durationpb.Duration `protobuf:"bytes,2,opt,name=Lifespan,proto3" json:"Lifespan,omitempty"`
+	// Parameters is a map of launch parameter names to values.
+	Parameters map[string]string `protobuf:"bytes,3,rep,name=Parameters,proto3" json:"Parameters,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Description is a human readable description for the cluster.
+	Description string `protobuf:"bytes,4,opt,name=Description,proto3" json:"Description,omitempty"`
+	// NoSlack is used to skip sending Slack messages for cluster lifecycle
+	// events.
+	NoSlack bool `protobuf:"varint,5,opt,name=NoSlack,proto3" json:"NoSlack,omitempty"`
+	// SlackDM is used to choose direct messages for cluster lifecycle
+	// events.
+	SlackDM bool `protobuf:"varint,6,opt,name=SlackDM,proto3" json:"SlackDM,omitempty"`
+	// Labels are user-defined labels, such as a team, ticket or CI run ID.
+	Labels        map[string]string `protobuf:"bytes,7,rep,name=Labels,proto3" json:"Labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateClusterRequest) Reset() {
+	*x = CreateClusterRequest{}
+	mi := &file_service_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateClusterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateClusterRequest) ProtoMessage() {}
+
+func (x *CreateClusterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateClusterRequest.ProtoReflect.Descriptor instead.
+func (*CreateClusterRequest) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *CreateClusterRequest) GetID() string {
+	if x != nil {
+		return x.ID
+	}
+	return ""
+}
+
+func (x *CreateClusterRequest) GetLifespan() *durationpb.Duration {
+	if x != nil {
+		return x.Lifespan
+	}
+	return nil
+}
+
+func (x *CreateClusterRequest) GetParameters() map[string]string {
+	if x != nil {
+		return x.Parameters
+	}
+	return nil
+}
+
+func (x *CreateClusterRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateClusterRequest) GetNoSlack() bool {
+	if x != nil {
+		return x.NoSlack
+	}
+	return false
+}
+
+func (x *CreateClusterRequest) GetSlackDM() bool {
+	if x != nil {
+		return x.SlackDM
+	}
+	return false
+}
+
+func (x *CreateClusterRequest) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+// CloneClusterRequest represents a request to ClusterService.Clone.
+type CloneClusterRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID is the unique ID for the source cluster.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Generation selects an earlier generation of the source cluster, as in
+	// ClusterRequest.
+	Generation string `protobuf:"bytes,2,opt,name=generation,proto3" json:"generation,omitempty"`
+	// Name is the ID for the new cluster. The source cluster ID is reused
+	// when empty, which is allowed once the source cluster failed or
+	// finished.
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// Parameters is a map of launch parameter names to values that override
+	// those of the source cluster.
+	Parameters map[string]string `protobuf:"bytes,4,rep,name=Parameters,proto3" json:"Parameters,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Lifespan is the initial cluster lifespan. The lifespan of the source
+	// cluster is used when missing.
+	Lifespan *durationpb.Duration `protobuf:"bytes,5,opt,name=Lifespan,proto3" json:"Lifespan,omitempty"`
+	// Description overrides the description of the source cluster when set.
+	Description   string `protobuf:"bytes,6,opt,name=Description,proto3" json:"Description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CloneClusterRequest) Reset() {
+	*x = CloneClusterRequest{}
+	mi := &file_service_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CloneClusterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloneClusterRequest) ProtoMessage() {}
+
+func (x *CloneClusterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloneClusterRequest.ProtoReflect.Descriptor instead.
+func (*CloneClusterRequest) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *CloneClusterRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CloneClusterRequest) GetGeneration() string {
+	if x != nil {
+		return x.Generation
+	}
+	return ""
+}
+
+func (x *CloneClusterRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CloneClusterRequest) GetParameters() map[string]string {
+	if x != nil {
+		return x.Parameters
+	}
+	return nil
+}
+
+func (x *CloneClusterRequest) GetLifespan() *durationpb.Duration {
+	if x != nil {
+		return x.Lifespan
+	}
+	return nil
+}
+
+func (x *CloneClusterRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+// CloneClusterResponse represents the cluster launched by
+// ClusterService.Clone.
+type CloneClusterResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID is the unique ID for the new cluster.
+	ID string `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	// DroppedParameters are the parameters of the source cluster that are no
+	// longer accepted by its flavor, and were not passed on.
+	DroppedParameters []string `protobuf:"bytes,2,rep,name=DroppedParameters,proto3" json:"DroppedParameters,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *CloneClusterResponse) Reset() {
+	*x = CloneClusterResponse{}
+	mi := &file_service_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CloneClusterResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloneClusterResponse) ProtoMessage() {}
+
+func (x *CloneClusterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloneClusterResponse.ProtoReflect.Descriptor instead.
+func (*CloneClusterResponse) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *CloneClusterResponse) GetID() string {
+	if x != nil {
+		return x.ID
+	}
+	return ""
+}
+
+func (x *CloneClusterResponse) GetDroppedParameters() []string {
+	if x != nil {
+		return x.DroppedParameters
+	}
+	return nil
+}
+
+type Artifact struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=Description,proto3" json:"Description,omitempty"`
+	URL           string                 `protobuf:"bytes,3,opt,name=URL,proto3" json:"URL,omitempty"`
+	Mode          int32                  `protobuf:"varint,4,opt,name=Mode,proto3" json:"Mode,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Artifact) Reset() {
+	*x = Artifact{}
+	mi := &file_service_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Artifact) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Artifact) ProtoMessage() {}
+
+func (x *Artifact) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Artifact.ProtoReflect.Descriptor instead.
+func (*Artifact) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *Artifact) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Artifact) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Artifact) GetURL() string {
+	if x != nil {
+		return x.URL
+	}
+	return ""
+}
+
+func (x *Artifact) GetMode() int32 {
+	if x != nil {
+		return x.Mode
+	}
+	return 0
+}
+
+type ClusterArtifacts struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Artifacts     []*Artifact            `protobuf:"bytes,1,rep,name=Artifacts,proto3" json:"Artifacts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClusterArtifacts) Reset() {
+	*x = ClusterArtifacts{}
+	mi := &file_service_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClusterArtifacts) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClusterArtifacts) ProtoMessage() {}
+
+func (x *ClusterArtifacts) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClusterArtifacts.ProtoReflect.Descriptor instead.
+func (*ClusterArtifacts) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ClusterArtifacts) GetArtifacts() []*Artifact {
+	if x != nil {
+		return x.Artifacts
+	}
+	return nil
+}
+
+// Log represents the logs from a specific pod.
+type Log struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Name is the name given to this pod in the workflow.
+	Name string `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	// Started is the time at which this pod was started. Used for ordering
+	// between pods.
+	Started *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=Started,proto3" json:"Started,omitempty"`
+	// Body is the raw pod logs.
+	Body []byte `protobuf:"bytes,3,opt,name=Body,proto3" json:"Body,omitempty"`
+	// Message surfaces step state from Argo.
+	Message       string `protobuf:"bytes,4,opt,name=Message,proto3" json:"Message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Log) Reset() {
+	*x = Log{}
+	mi := &file_service_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Log) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Log) ProtoMessage() {}
+
+func (x *Log) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Log.ProtoReflect.Descriptor instead.
+func (*Log) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *Log) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Log) GetStarted() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Started
+	}
+	return nil
+}
+
+func (x *Log) GetBody() []byte {
+	if x != nil {
+		return x.Body
+	}
+	return nil
+}
+
+func (x *Log) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// LogsResponse represents a collection of logs.
+type LogsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Logs are all of the logs from a cluster.
+	Logs          []*Log `protobuf:"bytes,1,rep,name=Logs,proto3" json:"Logs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LogsResponse) Reset() {
+	*x = LogsResponse{}
+	mi := &file_service_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LogsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogsResponse) ProtoMessage() {}
+
+func (x *LogsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogsResponse.ProtoReflect.Descriptor instead.
+func (*LogsResponse) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *LogsResponse) GetLogs() []*Log {
+	if x != nil {
+		return x.Logs
+	}
+	return nil
+}
+
+// StreamLogsRequest represents a request to ClusterService.StreamLogs.
+type StreamLogsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID is the unique ID for the cluster.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Step limits the logs to the workflow step with this name, all steps are
+	// followed when empty.
+	Step          string `protobuf:"bytes,2,opt,name=step,proto3" json:"step,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamLogsRequest) Reset() {
+	*x = StreamLogsRequest{}
+	mi := &file_service_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamLogsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamLogsRequest) ProtoMessage() {}
+
+func (x *StreamLogsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamLogsRequest.ProtoReflect.Descriptor instead.
+func (*StreamLogsRequest) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *StreamLogsRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *StreamLogsRequest) GetStep() string {
+	if x != nil {
+		return x.Step
+	}
+	return ""
+}
+
+// LogChunk represents a piece of the logs from a specific pod.
+type LogChunk struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Name is the name given to this pod in the workflow.
+	Name string `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	// Body is the raw pod logs, usually a single line.
+	Body          []byte `protobuf:"bytes,2,opt,name=Body,proto3" json:"Body,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LogChunk) Reset() {
+	*x = LogChunk{}
+	mi := &file_service_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LogChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogChunk) ProtoMessage() {}
+
+func (x *LogChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogChunk.ProtoReflect.Descriptor instead.
+func (*LogChunk) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *LogChunk) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *LogChunk) GetBody() []byte {
+	if x != nil {
+		return x.Body
+	}
+	return nil
+}
+
+// Schedule represents a request to create a cluster at a later time, either
+// once or on a recurring basis.
+type Schedule struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID is the unique ID for the schedule. It is generated when not provided.
+	ID string `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	// Request is the cluster to create every time the schedule fires. When no
+	// name parameter is given the schedule ID is used as the cluster name.
+	Request *CreateClusterRequest `protobuf:"bytes,2,opt,name=Request,proto3" json:"Request,omitempty"`
+	// Owner is the email address for the schedule owner, who will also own
+	// the created clusters.
+	Owner string `protobuf:"bytes,3,opt,name=Owner,proto3" json:"Owner,omitempty"`
+	// At is the time at which a one-off schedule fires. Mutually exclusive
+	// with Cron.
+	At *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=At,proto3" json:"At,omitempty"`
+	// Cron is a standard 5 field cron expression for a recurring schedule,
+	// e.g. "0 8 * * 1-5". It is evaluated in UTC unless prefixed with a
+	// CRON_TZ=<zone> specification. Mutually exclusive with At.
+	Cron string `protobuf:"bytes,5,opt,name=Cron,proto3" json:"Cron,omitempty"`
+	// NextRun is the next time at which the schedule will fire.
+	NextRun *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=NextRun,proto3" json:"NextRun,omitempty"`
+	// LastRun is the last time at which the schedule fired.
+	LastRun *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=LastRun,proto3" json:"LastRun,omitempty"`
+	// LastClusterID is the ID of the cluster created the last time the
+	// schedule fired.
+	LastClusterID string `protobuf:"bytes,8,opt,name=LastClusterID,proto3" json:"LastClusterID,omitempty"`
+	// LastError is the error encountered the last time the schedule fired, if
+	// any.
+	LastError string `protobuf:"bytes,9,opt,name=LastError,proto3" json:"LastError,omitempty"`
+	// CreatedOn is the timestamp on which the schedule was created.
+	CreatedOn *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=CreatedOn,proto3" json:"CreatedOn,omitempty"`
+	// Scope is the scope of the service account token that the schedule was
+	// created with, if any. It is checked again every time the schedule
+	// fires.
+	Scope *TokenScope `protobuf:"bytes,11,opt,name=Scope,proto3" json:"Scope,omitempty"`
+	// Teams are the teams that the owner was a member of when the schedule
+	// was created. The clusters created by the schedule belong to them.
+	Teams         []string `protobuf:"bytes,12,rep,name=Teams,proto3" json:"Teams,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Schedule) Reset() {
+	*x = Schedule{}
+	mi := &file_service_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Schedule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Schedule) ProtoMessage() {}
+
+func (x *Schedule) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Schedule.ProtoReflect.Descriptor instead.
+func (*Schedule) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *Schedule) GetID() string {
+	if x != nil {
+		return x.ID
+	}
+	return ""
+}
+
+func (x *Schedule) GetRequest() *CreateClusterRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+func (x *Schedule) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *Schedule) GetAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.At
+	}
+	return nil
+}
+
+func (x *Schedule) GetCron() string {
+	if x != nil {
+		return x.Cron
+	}
+	return ""
+}
+
+func (x *Schedule) GetNextRun() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextRun
+	}
+	return nil
+}
+
+func (x *Schedule) GetLastRun() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastRun
+	}
+	return nil
+}
+
+func (x *Schedule) GetLastClusterID() string {
+	if x != nil {
+		return x.LastClusterID
+	}
+	return ""
+}
+
+func (x *Schedule) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *Schedule) GetCreatedOn() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedOn
+	}
+	return nil
+}
+
+func (x *Schedule) GetScope() *TokenScope {
+	if x != nil {
+		return x.Scope
+	}
+	return nil
+}
+
+func (x *Schedule) GetTeams() []string {
+	if x != nil {
+		return x.Teams
+	}
+	return nil
+}
+
+// ScheduleListRequest represents a request to ScheduleService.List.
+type ScheduleListRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// all indicates that all schedules should be returned, not just the ones
+	// owned by the user.
+	All           bool `protobuf:"varint,1,opt,name=all,proto3" json:"all,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScheduleListRequest) Reset() {
+	*x = ScheduleListRequest{}
+	mi := &file_service_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduleListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleListRequest) ProtoMessage() {}
+
+func (x *ScheduleListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleListRequest.ProtoReflect.Descriptor instead.
+func (*ScheduleListRequest) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ScheduleListRequest) GetAll() bool {
+	if x != nil {
+		return x.All
+	}
+	return false
+}
+
+// ScheduleListResponse represents details about all schedules.
+type ScheduleListResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// ID is the unique ID for the cluster.
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// Lifespan is the new lifespan.
-	Lifespan      *durationpb.Duration   `protobuf:"bytes,2,opt,name=Lifespan,proto3" json:"Lifespan,omitempty"`
-	Method        LifespanRequest_Method `protobuf:"varint,3,opt,name=method,proto3,enum=v1.LifespanRequest_Method" json:"method,omitempty"`
+	// Schedules is a list of all schedules.
+	Schedules     []*Schedule `protobuf:"bytes,1,rep,name=Schedules,proto3" json:"Schedules,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LifespanRequest) Reset() {
-	*x = LifespanRequest{}
-	mi := &file_service_proto_msgTypes[14]
+func (x *ScheduleListResponse) Reset() {
+	*x = ScheduleListResponse{}
+	mi := &file_service_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LifespanRequest) String() string {
+func (x *ScheduleListResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LifespanRequest) ProtoMessage() {}
+func (*ScheduleListResponse) ProtoMessage() {}
 
-func (x *LifespanRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[14]
+func (x *ScheduleListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1332,68 +3386,57 @@ func (x *LifespanRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LifespanRequest.ProtoReflect.Descriptor instead.
-func (*LifespanRequest) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{14}
-}
-
-func (x *LifespanRequest) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
+// Deprecated: Use ScheduleListResponse.ProtoReflect.Descriptor instead.
+func (*ScheduleListResponse) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *LifespanRequest) GetLifespan() *durationpb.Duration {
+func (x *ScheduleListResponse) GetSchedules() []*Schedule {
 	if x != nil {
-		return x.Lifespan
+		return x.Schedules
 	}
 	return nil
 }
 
-func (x *LifespanRequest) GetMethod() LifespanRequest_Method {
-	if x != nil {
-		return x.Method
-	}
-	return LifespanRequest_REPLACE
-}
-
-// CreateClusterRequest represents details for launching a new cluster.
-type CreateClusterRequest struct {
+// QuotaUsage represents the current usage of a single quota limit.
+type QuotaUsage struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// ID is the flavor ID to launch.
-	ID string `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
-	// Lifespan is the initial cluster lifespan.
-	Lifespan *durationpb.Duration `protobuf:"bytes,2,opt,name=Lifespan,proto3" json:"Lifespan,omitempty"`
-	// Parameters is a map of launch parameter names to values.
-	Parameters map[string]string `protobuf:"bytes,3,rep,name=Parameters,proto3" json:"Parameters,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	// Description is a human readable description for the cluster.
-	Description string `protobuf:"bytes,4,opt,name=Description,proto3" json:"Description,omitempty"`
-	// NoSlack is used to skip sending Slack messages for cluster lifecycle
-	// events.
-	NoSlack bool `protobuf:"varint,5,opt,name=NoSlack,proto3" json:"NoSlack,omitempty"`
-	// SlackDM is used to choose direct messages for cluster lifecycle
-	// events.
-	SlackDM       bool `protobuf:"varint,6,opt,name=SlackDM,proto3" json:"SlackDM,omitempty"`
+	// Description is a human readable description of the limit.
+	Description string `protobuf:"bytes,1,opt,name=Description,proto3" json:"Description,omitempty"`
+	// Owner is the owner email the limit applies to, empty when it applies
+	// to all owners.
+	Owner string `protobuf:"bytes,2,opt,name=Owner,proto3" json:"Owner,omitempty"`
+	// Flavor is the flavor ID the limit applies to, empty when it applies to
+	// all flavors.
+	Flavor string `protobuf:"bytes,3,opt,name=Flavor,proto3" json:"Flavor,omitempty"`
+	// Limit is the maximum number of concurrent clusters.
+	Limit int32 `protobuf:"varint,4,opt,name=Limit,proto3" json:"Limit,omitempty"`
+	// Used is the current number of concurrent clusters.
+	Used int32 `protobuf:"varint,5,opt,name=Used,proto3" json:"Used,omitempty"`
+	// Clusters are the IDs of the clusters that count against the limit.
+	Clusters []string `protobuf:"bytes,6,rep,name=Clusters,proto3" json:"Clusters,omitempty"`
+	// Team is the team the limit applies to, empty when it does not apply to
+	// a single team.
+	Team          string `protobuf:"bytes,7,opt,name=Team,proto3" json:"Team,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateClusterRequest) Reset() {
-	*x = CreateClusterRequest{}
-	mi := &file_service_proto_msgTypes[15]
+func (x *QuotaUsage) Reset() {
+	*x = QuotaUsage{}
+	mi := &file_service_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateClusterRequest) String() string {
+func (x *QuotaUsage) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateClusterRequest) ProtoMessage() {}
+func (*QuotaUsage) ProtoMessage() {}
 
-func (x *CreateClusterRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[15]
+func (x *QuotaUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1404,78 +3447,87 @@ func (x *CreateClusterRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateClusterRequest.ProtoReflect.Descriptor instead.
-func (*CreateClusterRequest) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use QuotaUsage.ProtoReflect.Descriptor instead.
+func (*QuotaUsage) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *CreateClusterRequest) GetID() string {
+func (x *QuotaUsage) GetDescription() string {
 	if x != nil {
-		return x.ID
+		return x.Description
 	}
 	return ""
 }
 
-func (x *CreateClusterRequest) GetLifespan() *durationpb.Duration {
+func (x *QuotaUsage) GetOwner() string {
 	if x != nil {
-		return x.Lifespan
+		return x.Owner
 	}
-	return nil
+	return ""
 }
 
-func (x *CreateClusterRequest) GetParameters() map[string]string {
+func (x *QuotaUsage) GetFlavor() string {
 	if x != nil {
-		return x.Parameters
+		return x.Flavor
 	}
-	return nil
+	return ""
 }
 
-func (x *CreateClusterRequest) GetDescription() string {
+func (x *QuotaUsage) GetLimit() int32 {
 	if x != nil {
-		return x.Description
+		return x.Limit
 	}
-	return ""
+	return 0
 }
 
-func (x *CreateClusterRequest) GetNoSlack() bool {
+func (x *QuotaUsage) GetUsed() int32 {
 	if x != nil {
-		return x.NoSlack
+		return x.Used
 	}
-	return false
+	return 0
 }
 
-func (x *CreateClusterRequest) GetSlackDM() bool {
+func (x *QuotaUsage) GetClusters() []string {
 	if x != nil {
-		return x.SlackDM
+		return x.Clusters
 	}
-	return false
+	return nil
 }
 
-type Artifact struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
-	Description   string                 `protobuf:"bytes,2,opt,name=Description,proto3" json:"Description,omitempty"`
-	URL           string                 `protobuf:"bytes,3,opt,name=URL,proto3" json:"URL,omitempty"`
-	Mode          int32                  `protobuf:"varint,4,opt,name=Mode,proto3" json:"Mode,omitempty"`
+func (x *QuotaUsage) GetTeam() string {
+	if x != nil {
+		return x.Team
+	}
+	return ""
+}
+
+// QuotaResponse represents the quota usage of the current principal.
+type QuotaResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Exempt indicates that the current principal is not subject to any
+	// quota.
+	Exempt bool `protobuf:"varint,1,opt,name=Exempt,proto3" json:"Exempt,omitempty"`
+	// Usage is the usage of every limit applying to the current principal.
+	Usage         []*QuotaUsage `protobuf:"bytes,2,rep,name=Usage,proto3" json:"Usage,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Artifact) Reset() {
-	*x = Artifact{}
-	mi := &file_service_proto_msgTypes[16]
+func (x *QuotaResponse) Reset() {
+	*x = QuotaResponse{}
+	mi := &file_service_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Artifact) String() string {
+func (x *QuotaResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Artifact) ProtoMessage() {}
+func (*QuotaResponse) ProtoMessage() {}
 
-func (x *Artifact) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[16]
+func (x *QuotaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1486,61 +3538,60 @@ func (x *Artifact) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Artifact.ProtoReflect.Descriptor instead.
-func (*Artifact) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{16}
-}
-
-func (x *Artifact) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *Artifact) GetDescription() string {
-	if x != nil {
-		return x.Description
-	}
-	return ""
+// Deprecated: Use QuotaResponse.ProtoReflect.Descriptor instead.
+func (*QuotaResponse) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *Artifact) GetURL() string {
+func (x *QuotaResponse) GetExempt() bool {
 	if x != nil {
-		return x.URL
+		return x.Exempt
 	}
-	return ""
+	return false
 }
 
-func (x *Artifact) GetMode() int32 {
+func (x *QuotaResponse) GetUsage() []*QuotaUsage {
 	if x != nil {
-		return x.Mode
+		return x.Usage
 	}
-	return 0
+	return nil
 }
 
-type ClusterArtifacts struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Artifacts     []*Artifact            `protobuf:"bytes,1,rep,name=Artifacts,proto3" json:"Artifacts,omitempty"`
+// AuditEvent represents a single audited operation.
+type AuditEvent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Time is when the operation was requested.
+	Time *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=Time,proto3" json:"Time,omitempty"`
+	// Actor is the email of the user or service account that requested the
+	// operation.
+	Actor string `protobuf:"bytes,2,opt,name=Actor,proto3" json:"Actor,omitempty"`
+	// Phase is the kind of operation, e.g. "cluster-delete".
+	Phase string `protobuf:"bytes,3,opt,name=Phase,proto3" json:"Phase,omitempty"`
+	// ClusterID is the ID of the affected cluster, if any.
+	ClusterID string `protobuf:"bytes,4,opt,name=ClusterID,proto3" json:"ClusterID,omitempty"`
+	// Message is a human readable description of the operation.
+	Message string `protobuf:"bytes,5,opt,name=Message,proto3" json:"Message,omitempty"`
+	// Details are the remaining fields of the operation.
+	Details       map[string]string `protobuf:"bytes,6,rep,name=Details,proto3" json:"Details,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ClusterArtifacts) Reset() {
-	*x = ClusterArtifacts{}
-	mi := &file_service_proto_msgTypes[17]
+func (x *AuditEvent) Reset() {
+	*x = AuditEvent{}
+	mi := &file_service_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ClusterArtifacts) String() string {
+func (x *AuditEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ClusterArtifacts) ProtoMessage() {}
+func (*AuditEvent) ProtoMessage() {}
 
-func (x *ClusterArtifacts) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[17]
+func (x *AuditEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1551,49 +3602,88 @@ func (x *ClusterArtifacts) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ClusterArtifacts.ProtoReflect.Descriptor instead.
-func (*ClusterArtifacts) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use AuditEvent.ProtoReflect.Descriptor instead.
+func (*AuditEvent) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *ClusterArtifacts) GetArtifacts() []*Artifact {
+func (x *AuditEvent) GetTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Artifacts
+		return x.Time
 	}
 	return nil
 }
 
-// Log represents the logs from a specific pod.
-type Log struct {
+func (x *AuditEvent) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetPhase() string {
+	if x != nil {
+		return x.Phase
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetClusterID() string {
+	if x != nil {
+		return x.ClusterID
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetDetails() map[string]string {
+	if x != nil {
+		return x.Details
+	}
+	return nil
+}
+
+// AuditListRequest represents a request to AuditService.List. Empty fields
+// match every event.
+type AuditListRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Name is the name given to this pod in the workflow.
-	Name string `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
-	// Started is the time at which this pod was started. Used for ordering
-	// between pods.
-	Started *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=Started,proto3" json:"Started,omitempty"`
-	// Body is the raw pod logs.
-	Body []byte `protobuf:"bytes,3,opt,name=Body,proto3" json:"Body,omitempty"`
-	// Message surfaces step state from Argo.
-	Message       string `protobuf:"bytes,4,opt,name=Message,proto3" json:"Message,omitempty"`
+	// Actor only selects the events requested by this email.
+	Actor string `protobuf:"bytes,1,opt,name=Actor,proto3" json:"Actor,omitempty"`
+	// ClusterID only selects the events affecting this cluster.
+	ClusterID string `protobuf:"bytes,2,opt,name=ClusterID,proto3" json:"ClusterID,omitempty"`
+	// Phase only selects the events of this kind.
+	Phase string `protobuf:"bytes,3,opt,name=Phase,proto3" json:"Phase,omitempty"`
+	// Since only selects the events at or after this time.
+	Since *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=Since,proto3" json:"Since,omitempty"`
+	// Until only selects the events at or before this time.
+	Until *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=Until,proto3" json:"Until,omitempty"`
+	// Limit is the maximum number of events to return. Defaults to 100.
+	Limit         int32 `protobuf:"varint,6,opt,name=Limit,proto3" json:"Limit,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Log) Reset() {
-	*x = Log{}
-	mi := &file_service_proto_msgTypes[18]
+func (x *AuditListRequest) Reset() {
+	*x = AuditListRequest{}
+	mi := &file_service_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Log) String() string {
+func (x *AuditListRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Log) ProtoMessage() {}
+func (*AuditListRequest) ProtoMessage() {}
 
-func (x *Log) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[18]
+func (x *AuditListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1604,63 +3694,77 @@ func (x *Log) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Log.ProtoReflect.Descriptor instead.
-func (*Log) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use AuditListRequest.ProtoReflect.Descriptor instead.
+func (*AuditListRequest) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *Log) GetName() string {
+func (x *AuditListRequest) GetActor() string {
 	if x != nil {
-		return x.Name
+		return x.Actor
 	}
 	return ""
 }
 
-func (x *Log) GetStarted() *timestamppb.Timestamp {
+func (x *AuditListRequest) GetClusterID() string {
 	if x != nil {
-		return x.Started
+		return x.ClusterID
+	}
+	return ""
+}
+
+func (x *AuditListRequest) GetPhase() string {
+	if x != nil {
+		return x.Phase
+	}
+	return ""
+}
+
+func (x *AuditListRequest) GetSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Since
 	}
 	return nil
 }
 
-func (x *Log) GetBody() []byte {
+func (x *AuditListRequest) GetUntil() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Body
+		return x.Until
 	}
 	return nil
 }
 
-func (x *Log) GetMessage() string {
+func (x *AuditListRequest) GetLimit() int32 {
 	if x != nil {
-		return x.Message
+		return x.Limit
 	}
-	return ""
+	return 0
 }
 
-// LogsResponse represents a collection of logs.
-type LogsResponse struct {
+// AuditListResponse represents the selected audit events.
+type AuditListResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Logs are all of the logs from a cluster.
-	Logs          []*Log `protobuf:"bytes,1,rep,name=Logs,proto3" json:"Logs,omitempty"`
+	// Events are the selected events, most recent first.
+	Events        []*AuditEvent `protobuf:"bytes,1,rep,name=Events,proto3" json:"Events,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LogsResponse) Reset() {
-	*x = LogsResponse{}
-	mi := &file_service_proto_msgTypes[19]
+func (x *AuditListResponse) Reset() {
+	*x = AuditListResponse{}
+	mi := &file_service_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LogsResponse) String() string {
+func (x *AuditListResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LogsResponse) ProtoMessage() {}
+func (*AuditListResponse) ProtoMessage() {}
 
-func (x *LogsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[19]
+func (x *AuditListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1671,14 +3775,14 @@ func (x *LogsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LogsResponse.ProtoReflect.Descriptor instead.
-func (*LogsResponse) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use AuditListResponse.ProtoReflect.Descriptor instead.
+func (*AuditListResponse) Descriptor() ([]byte, []int) {
+	return file_service_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *LogsResponse) GetLogs() []*Log {
+func (x *AuditListResponse) GetEvents() []*AuditEvent {
 	if x != nil {
-		return x.Logs
+		return x.Events
 	}
 	return nil
 }
@@ -1693,7 +3797,7 @@ type CliUpgradeRequest struct {
 
 func (x *CliUpgradeRequest) Reset() {
 	*x = CliUpgradeRequest{}
-	mi := &file_service_proto_msgTypes[20]
+	mi := &file_service_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1705,7 +3809,7 @@ func (x *CliUpgradeRequest) String() string {
 func (*CliUpgradeRequest) ProtoMessage() {}
 
 func (x *CliUpgradeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[20]
+	mi := &file_service_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1718,7 +3822,7 @@ func (x *CliUpgradeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CliUpgradeRequest.ProtoReflect.Descriptor instead.
 func (*CliUpgradeRequest) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{20}
+	return file_service_proto_rawDescGZIP(), []int{46}
 }
 
 func (x *CliUpgradeRequest) GetOs() string {
@@ -1744,7 +3848,7 @@ type CliUpgradeResponse struct {
 
 func (x *CliUpgradeResponse) Reset() {
 	*x = CliUpgradeResponse{}
-	mi := &file_service_proto_msgTypes[21]
+	mi := &file_service_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1756,7 +3860,7 @@ func (x *CliUpgradeResponse) String() string {
 func (*CliUpgradeResponse) ProtoMessage() {}
 
 func (x *CliUpgradeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[21]
+	mi := &file_service_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1769,7 +3873,7 @@ func (x *CliUpgradeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CliUpgradeResponse.ProtoReflect.Descriptor instead.
 func (*CliUpgradeResponse) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{21}
+	return file_service_proto_rawDescGZIP(), []int{47}
 }
 
 func (x *CliUpgradeResponse) GetFileChunk() []byte {
@@ -1791,7 +3895,7 @@ type InfraStatus struct {
 
 func (x *InfraStatus) Reset() {
 	*x = InfraStatus{}
-	mi := &file_service_proto_msgTypes[22]
+	mi := &file_service_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1803,7 +3907,7 @@ func (x *InfraStatus) String() string {
 func (*InfraStatus) ProtoMessage() {}
 
 func (x *InfraStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_service_proto_msgTypes[22]
+	mi := &file_service_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1816,7 +3920,7 @@ func (x *InfraStatus) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InfraStatus.ProtoReflect.Descriptor instead.
 func (*InfraStatus) Descriptor() ([]byte, []int) {
-	return file_service_proto_rawDescGZIP(), []int{22}
+	return file_service_proto_rawDescGZIP(), []int{48}
 }
 
 func (x *InfraStatus) GetMaintenanceActive() bool {
@@ -1837,7 +3941,7 @@ var File_service_proto protoreflect.FileDescriptor
 
 const file_service_proto_rawDesc = "" +
 	"\n" +
-	"\rservice.proto\x12\x02v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1egoogle/protobuf/duration.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x1e\n" +
+	"\rservice.proto\x12\x02v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1egoogle/protobuf/duration.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1egoogle/protobuf/wrappers.proto\"\x1e\n" +
 	"\fResourceByID\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"\xd1\x01\n" +
 	"\aVersion\x128\n" +
@@ -1846,26 +3950,45 @@ const file_service_proto_rawDesc = "" +
 	"\tGoVersion\x18\x03 \x01(\tR\tGoVersion\x12\x1a\n" +
 	"\bPlatform\x18\x04 \x01(\tR\bPlatform\x12\x18\n" +
 	"\aVersion\x18\x05 \x01(\tR\aVersion\x12\x1a\n" +
-	"\bWorkflow\x18\x06 \x01(\tR\bWorkflow\"{\n" +
+	"\bWorkflow\x18\x06 \x01(\tR\bWorkflow\"\xa7\x01\n" +
 	"\x0eWhoamiResponse\x12\x1e\n" +
 	"\x04User\x18\x01 \x01(\v2\b.v1.UserH\x00R\x04User\x12<\n" +
-	"\x0eServiceAccount\x18\x02 \x01(\v2\x12.v1.ServiceAccountH\x00R\x0eServiceAccountB\v\n" +
-	"\tprincipal\"~\n" +
+	"\x0eServiceAccount\x18\x02 \x01(\v2\x12.v1.ServiceAccountH\x00R\x0eServiceAccount\x12\x14\n" +
+	"\x05Roles\x18\x03 \x03(\tR\x05Roles\x12\x14\n" +
+	"\x05Teams\x18\x04 \x03(\tR\x05TeamsB\v\n" +
+	"\tprincipal\"\x96\x01\n" +
 	"\x04User\x122\n" +
 	"\x06Expiry\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x06Expiry\x12\x12\n" +
 	"\x04Name\x18\x02 \x01(\tR\x04Name\x12\x14\n" +
 	"\x05Email\x18\x03 \x01(\tR\x05Email\x12\x18\n" +
-	"\aPicture\x18\x04 \x01(\tR\aPicture\"\xb4\x01\n" +
+	"\aPicture\x18\x04 \x01(\tR\aPicture\x12\x16\n" +
+	"\x06Groups\x18\x05 \x03(\tR\x06Groups\"\x84\x02\n" +
 	"\x0eServiceAccount\x12\x12\n" +
 	"\x04Name\x18\x01 \x01(\tR\x04Name\x12 \n" +
 	"\vDescription\x18\x02 \x01(\tR\vDescription\x12\x14\n" +
 	"\x05Email\x18\x03 \x01(\tR\x05Email\x12\x1a\n" +
 	"\bIssuedAt\x18\x04 \x01(\x03R\bIssuedAt\x12\x1c\n" +
 	"\tNotBefore\x18\x05 \x01(\x03R\tNotBefore\x12\x1c\n" +
-	"\tExpiresAt\x18\x06 \x01(\x03R\tExpiresAt\"S\n" +
+	"\tExpiresAt\x18\x06 \x01(\x03R\tExpiresAt\x12\x10\n" +
+	"\x03jti\x18\a \x01(\tR\x03jti\x12$\n" +
+	"\x05Scope\x18\b \x01(\v2\x0e.v1.TokenScopeR\x05Scope\x12\x16\n" +
+	"\x06Groups\x18\t \x03(\tR\x06Groups\"\x9f\x01\n" +
+	"\n" +
+	"TokenScope\x12\x18\n" +
+	"\aMethods\x18\x01 \x03(\tR\aMethods\x12\x18\n" +
+	"\aFlavors\x18\x02 \x03(\tR\aFlavors\x12;\n" +
+	"\vMaxLifespan\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\vMaxLifespan\x12 \n" +
+	"\vMaxClusters\x18\x04 \x01(\x05R\vMaxClusters\"S\n" +
 	"\rTokenResponse\x12,\n" +
 	"\aAccount\x18\x01 \x01(\v2\x12.v1.ServiceAccountR\aAccount\x12\x14\n" +
-	"\x05Token\x18\x02 \x01(\tR\x05Token\"\xd5\x01\n" +
+	"\x05Token\x18\x02 \x01(\tR\x05Token\"\xb1\x01\n" +
+	"\vIssuedToken\x12,\n" +
+	"\aAccount\x18\x01 \x01(\v2\x12.v1.ServiceAccountR\aAccount\x12\x1c\n" +
+	"\tCreatedBy\x18\x02 \x01(\tR\tCreatedBy\x128\n" +
+	"\tRevokedAt\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tRevokedAt\x12\x1c\n" +
+	"\tRevokedBy\x18\x04 \x01(\tR\tRevokedBy\"<\n" +
+	"\x11TokenListResponse\x12'\n" +
+	"\x06Tokens\x18\x01 \x03(\v2\x0f.v1.IssuedTokenR\x06Tokens\"\x9a\x03\n" +
 	"\tParameter\x12\x12\n" +
 	"\x04Name\x18\x01 \x01(\tR\x04Name\x12 \n" +
 	"\vDescription\x18\x02 \x01(\tR\vDescription\x12\x14\n" +
@@ -1874,14 +3997,20 @@ const file_service_proto_rawDesc = "" +
 	"\bInternal\x18\x05 \x01(\bR\bInternal\x12\x14\n" +
 	"\x05Order\x18\x06 \x01(\x05R\x05Order\x12\x12\n" +
 	"\x04Help\x18\a \x01(\tR\x04Help\x12\x1a\n" +
-	"\bFromFile\x18\b \x01(\bR\bFromFile\"\xc9\x01\n" +
+	"\bFromFile\x18\b \x01(\bR\bFromFile\x12%\n" +
+	"\x04Type\x18\t \x01(\x0e2\x11.v1.ParameterTypeR\x04Type\x12$\n" +
+	"\rAllowedValues\x18\n" +
+	" \x03(\tR\rAllowedValues\x12-\n" +
+	"\x03Min\x18\v \x01(\v2\x1b.google.protobuf.Int64ValueR\x03Min\x12-\n" +
+	"\x03Max\x18\f \x01(\v2\x1b.google.protobuf.Int64ValueR\x03Max\x12\x18\n" +
+	"\aPattern\x18\r \x01(\tR\aPattern\"\xc9\x01\n" +
 	"\x0eFlavorArtifact\x12\x12\n" +
 	"\x04Name\x18\x01 \x01(\tR\x04Name\x12 \n" +
 	"\vDescription\x18\x02 \x01(\tR\vDescription\x120\n" +
 	"\x04Tags\x18\x03 \x03(\v2\x1c.v1.FlavorArtifact.TagsEntryR\x04Tags\x1aO\n" +
 	"\tTagsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12,\n" +
-	"\x05value\x18\x02 \x01(\v2\x16.google.protobuf.EmptyR\x05value:\x028\x01\"\xa5\x04\n" +
+	"\x05value\x18\x02 \x01(\v2\x16.google.protobuf.EmptyR\x05value:\x028\x01\"\xbb\x04\n" +
 	"\x06Flavor\x12\x0e\n" +
 	"\x02ID\x18\x01 \x01(\tR\x02ID\x12\x12\n" +
 	"\x04Name\x18\x02 \x01(\tR\x04Name\x12 \n" +
@@ -1891,7 +4020,8 @@ const file_service_proto_rawDesc = "" +
 	"Parameters\x18\x05 \x03(\v2\x1a.v1.Flavor.ParametersEntryR\n" +
 	"Parameters\x127\n" +
 	"\tArtifacts\x18\x06 \x03(\v2\x19.v1.Flavor.ArtifactsEntryR\tArtifacts\x12\x18\n" +
-	"\aAliases\x18\a \x03(\tR\aAliases\x1aL\n" +
+	"\aAliases\x18\a \x03(\tR\aAliases\x12\x14\n" +
+	"\x05Teams\x18\b \x03(\tR\x05Teams\x1aL\n" +
 	"\x0fParametersEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12#\n" +
 	"\x05value\x18\x02 \x01(\v2\r.v1.ParameterR\x05value:\x028\x01\x1aP\n" +
@@ -1913,7 +4043,16 @@ const file_service_proto_rawDesc = "" +
 	"\x12FlavorListResponse\x12\x18\n" +
 	"\aDefault\x18\x01 \x01(\tR\aDefault\x12$\n" +
 	"\aFlavors\x18\x02 \x03(\v2\n" +
-	".v1.FlavorR\aFlavors\"\x97\x03\n" +
+	".v1.FlavorR\aFlavors\"\xd4\x01\n" +
+	"\x14FlavorRegistryStatus\x12\x1e\n" +
+	"\n" +
+	"ConfigHash\x18\x01 \x01(\tR\n" +
+	"ConfigHash\x126\n" +
+	"\bLoadedOn\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\bLoadedOn\x12:\n" +
+	"\n" +
+	"LastReload\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"LastReload\x12(\n" +
+	"\x0fLastReloadError\x18\x04 \x01(\tR\x0fLastReloadError\"\xbf\x04\n" +
 	"\aCluster\x12\x0e\n" +
 	"\x02ID\x18\x01 \x01(\tR\x02ID\x12\"\n" +
 	"\x06Status\x18\x02 \x01(\x0e2\n" +
@@ -1929,24 +4068,85 @@ const file_service_proto_rawDesc = "" +
 	" \x01(\tR\aConnect\x12-\n" +
 	"\n" +
 	"Parameters\x18\v \x03(\v2\r.v1.ParameterR\n" +
-	"Parameters\"\xb6\x01\n" +
+	"Parameters\x12$\n" +
+	"\rCollaborators\x18\f \x03(\tR\rCollaborators\x12/\n" +
+	"\x06Labels\x18\r \x03(\v2\x17.v1.Cluster.LabelsEntryR\x06Labels\x12\x14\n" +
+	"\x05Teams\x18\x0e \x03(\tR\x05Teams\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"@\n" +
+	"\x0eClusterRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1e\n" +
+	"\n" +
+	"generation\x18\x02 \x01(\tR\n" +
+	"generation\"\xa6\x01\n" +
+	"\x11ClusterGeneration\x12\x1e\n" +
+	"\n" +
+	"Generation\x18\x01 \x01(\x05R\n" +
+	"Generation\x12\"\n" +
+	"\fWorkflowName\x18\x02 \x01(\tR\fWorkflowName\x12%\n" +
+	"\aCluster\x18\x03 \x01(\v2\v.v1.ClusterR\aCluster\x12&\n" +
+	"\x0eFailureDetails\x18\x04 \x01(\tR\x0eFailureDetails\"Q\n" +
+	"\x16ClusterHistoryResponse\x127\n" +
+	"\vGenerations\x18\x01 \x03(\v2\x15.v1.ClusterGenerationR\vGenerations\"\xdc\x01\n" +
 	"\x12ClusterListRequest\x12\x10\n" +
 	"\x03all\x18\x01 \x01(\bR\x03all\x12\x18\n" +
 	"\aexpired\x18\x02 \x01(\bR\aexpired\x12\x16\n" +
 	"\x06prefix\x18\x03 \x01(\tR\x06prefix\x124\n" +
 	"\x0fallowedStatuses\x18\x04 \x03(\x0e2\n" +
 	".v1.StatusR\x0fallowedStatuses\x12&\n" +
-	"\x0eallowedFlavors\x18\x05 \x03(\tR\x0eallowedFlavors\">\n" +
+	"\x0eallowedFlavors\x18\x05 \x03(\tR\x0eallowedFlavors\x12$\n" +
+	"\rlabelSelector\x18\x06 \x01(\tR\rlabelSelector\">\n" +
 	"\x13ClusterListResponse\x12'\n" +
-	"\bClusters\x18\x01 \x03(\v2\v.v1.ClusterR\bClusters\"\xba\x01\n" +
+	"\bClusters\x18\x01 \x03(\v2\v.v1.ClusterR\bClusters\"\xe8\x01\n" +
 	"\x0fLifespanRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x125\n" +
 	"\bLifespan\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\bLifespan\x122\n" +
-	"\x06method\x18\x03 \x01(\x0e2\x1a.v1.LifespanRequest.MethodR\x06method\",\n" +
+	"\x06method\x18\x03 \x01(\x0e2\x1a.v1.LifespanRequest.MethodR\x06method\x12\x14\n" +
+	"\x05force\x18\x04 \x01(\bR\x05force\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\",\n" +
 	"\x06Method\x12\v\n" +
 	"\aREPLACE\x10\x00\x12\a\n" +
 	"\x03ADD\x10\x01\x12\f\n" +
-	"\bSUBTRACT\x10\x02\"\xbc\x02\n" +
+	"\bSUBTRACT\x10\x02\"T\n" +
+	"\x14DeleteClusterRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05force\x18\x02 \x01(\bR\x05force\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"\x9b\x01\n" +
+	"\x11BulkDeleteRequest\x12\x10\n" +
+	"\x03ids\x18\x01 \x03(\tR\x03ids\x12.\n" +
+	"\x06filter\x18\x02 \x01(\v2\x16.v1.ClusterListRequestR\x06filter\x12\x16\n" +
+	"\x06dryRun\x18\x03 \x01(\bR\x06dryRun\x12\x14\n" +
+	"\x05force\x18\x04 \x01(\bR\x05force\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\"\x88\x02\n" +
+	"\x13BulkLifespanRequest\x12\x10\n" +
+	"\x03ids\x18\x01 \x03(\tR\x03ids\x12.\n" +
+	"\x06filter\x18\x02 \x01(\v2\x16.v1.ClusterListRequestR\x06filter\x12\x16\n" +
+	"\x06dryRun\x18\x03 \x01(\bR\x06dryRun\x125\n" +
+	"\bLifespan\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\bLifespan\x122\n" +
+	"\x06method\x18\x05 \x01(\x0e2\x1a.v1.LifespanRequest.MethodR\x06method\x12\x14\n" +
+	"\x05force\x18\x06 \x01(\bR\x05force\x12\x16\n" +
+	"\x06reason\x18\a \x01(\tR\x06reason\"i\n" +
+	"\n" +
+	"BulkResult\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\x125\n" +
+	"\bLifespan\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\bLifespan\"P\n" +
+	"\fBulkResponse\x12(\n" +
+	"\aresults\x18\x01 \x03(\v2\x0e.v1.BulkResultR\aresults\x12\x16\n" +
+	"\x06dryRun\x18\x02 \x01(\bR\x06dryRun\"\x9c\x01\n" +
+	"\x16UpdateOwnershipRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05owner\x18\x02 \x01(\tR\x05owner\x12*\n" +
+	"\x10addCollaborators\x18\x03 \x03(\tR\x10addCollaborators\x120\n" +
+	"\x13removeCollaborators\x18\x04 \x03(\tR\x13removeCollaborators\"\xa9\x01\n" +
+	"\x13UpdateLabelsRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x122\n" +
+	"\x03set\x18\x02 \x03(\v2 .v1.UpdateLabelsRequest.SetEntryR\x03set\x12\x16\n" +
+	"\x06remove\x18\x03 \x03(\tR\x06remove\x1a6\n" +
+	"\bSetEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xb5\x03\n" +
 	"\x14CreateClusterRequest\x12\x0e\n" +
 	"\x02ID\x18\x01 \x01(\tR\x02ID\x125\n" +
 	"\bLifespan\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\bLifespan\x12H\n" +
@@ -1955,10 +4155,31 @@ const file_service_proto_rawDesc = "" +
 	"Parameters\x12 \n" +
 	"\vDescription\x18\x04 \x01(\tR\vDescription\x12\x18\n" +
 	"\aNoSlack\x18\x05 \x01(\bR\aNoSlack\x12\x18\n" +
-	"\aSlackDM\x18\x06 \x01(\bR\aSlackDM\x1a=\n" +
+	"\aSlackDM\x18\x06 \x01(\bR\aSlackDM\x12<\n" +
+	"\x06Labels\x18\a \x03(\v2$.v1.CreateClusterRequest.LabelsEntryR\x06Labels\x1a=\n" +
+	"\x0fParametersEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xba\x02\n" +
+	"\x13CloneClusterRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1e\n" +
+	"\n" +
+	"generation\x18\x02 \x01(\tR\n" +
+	"generation\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12G\n" +
+	"\n" +
+	"Parameters\x18\x04 \x03(\v2'.v1.CloneClusterRequest.ParametersEntryR\n" +
+	"Parameters\x125\n" +
+	"\bLifespan\x18\x05 \x01(\v2\x19.google.protobuf.DurationR\bLifespan\x12 \n" +
+	"\vDescription\x18\x06 \x01(\tR\vDescription\x1a=\n" +
 	"\x0fParametersEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"f\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"T\n" +
+	"\x14CloneClusterResponse\x12\x0e\n" +
+	"\x02ID\x18\x01 \x01(\tR\x02ID\x12,\n" +
+	"\x11DroppedParameters\x18\x02 \x03(\tR\x11DroppedParameters\"f\n" +
 	"\bArtifact\x12\x12\n" +
 	"\x04Name\x18\x01 \x01(\tR\x04Name\x12 \n" +
 	"\vDescription\x18\x02 \x01(\tR\vDescription\x12\x10\n" +
@@ -1973,6 +4194,62 @@ const file_service_proto_rawDesc = "" +
 	"\aMessage\x18\x04 \x01(\tR\aMessage\"+\n" +
 	"\fLogsResponse\x12\x1b\n" +
 	"\x04Logs\x18\x01 \x03(\v2\a.v1.LogR\x04Logs\"7\n" +
+	"\x11StreamLogsRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04step\x18\x02 \x01(\tR\x04step\"2\n" +
+	"\bLogChunk\x12\x12\n" +
+	"\x04Name\x18\x01 \x01(\tR\x04Name\x12\x12\n" +
+	"\x04Body\x18\x02 \x01(\fR\x04Body\"\xca\x03\n" +
+	"\bSchedule\x12\x0e\n" +
+	"\x02ID\x18\x01 \x01(\tR\x02ID\x122\n" +
+	"\aRequest\x18\x02 \x01(\v2\x18.v1.CreateClusterRequestR\aRequest\x12\x14\n" +
+	"\x05Owner\x18\x03 \x01(\tR\x05Owner\x12*\n" +
+	"\x02At\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x02At\x12\x12\n" +
+	"\x04Cron\x18\x05 \x01(\tR\x04Cron\x124\n" +
+	"\aNextRun\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\aNextRun\x124\n" +
+	"\aLastRun\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\aLastRun\x12$\n" +
+	"\rLastClusterID\x18\b \x01(\tR\rLastClusterID\x12\x1c\n" +
+	"\tLastError\x18\t \x01(\tR\tLastError\x128\n" +
+	"\tCreatedOn\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tCreatedOn\x12$\n" +
+	"\x05Scope\x18\v \x01(\v2\x0e.v1.TokenScopeR\x05Scope\x12\x14\n" +
+	"\x05Teams\x18\f \x03(\tR\x05Teams\"'\n" +
+	"\x13ScheduleListRequest\x12\x10\n" +
+	"\x03all\x18\x01 \x01(\bR\x03all\"B\n" +
+	"\x14ScheduleListResponse\x12*\n" +
+	"\tSchedules\x18\x01 \x03(\v2\f.v1.ScheduleR\tSchedules\"\xb6\x01\n" +
+	"\n" +
+	"QuotaUsage\x12 \n" +
+	"\vDescription\x18\x01 \x01(\tR\vDescription\x12\x14\n" +
+	"\x05Owner\x18\x02 \x01(\tR\x05Owner\x12\x16\n" +
+	"\x06Flavor\x18\x03 \x01(\tR\x06Flavor\x12\x14\n" +
+	"\x05Limit\x18\x04 \x01(\x05R\x05Limit\x12\x12\n" +
+	"\x04Used\x18\x05 \x01(\x05R\x04Used\x12\x1a\n" +
+	"\bClusters\x18\x06 \x03(\tR\bClusters\x12\x12\n" +
+	"\x04Team\x18\a \x01(\tR\x04Team\"M\n" +
+	"\rQuotaResponse\x12\x16\n" +
+	"\x06Exempt\x18\x01 \x01(\bR\x06Exempt\x12$\n" +
+	"\x05Usage\x18\x02 \x03(\v2\x0e.v1.QuotaUsageR\x05Usage\"\x93\x02\n" +
+	"\n" +
+	"AuditEvent\x12.\n" +
+	"\x04Time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x04Time\x12\x14\n" +
+	"\x05Actor\x18\x02 \x01(\tR\x05Actor\x12\x14\n" +
+	"\x05Phase\x18\x03 \x01(\tR\x05Phase\x12\x1c\n" +
+	"\tClusterID\x18\x04 \x01(\tR\tClusterID\x12\x18\n" +
+	"\aMessage\x18\x05 \x01(\tR\aMessage\x125\n" +
+	"\aDetails\x18\x06 \x03(\v2\x1b.v1.AuditEvent.DetailsEntryR\aDetails\x1a:\n" +
+	"\fDetailsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xd6\x01\n" +
+	"\x10AuditListRequest\x12\x14\n" +
+	"\x05Actor\x18\x01 \x01(\tR\x05Actor\x12\x1c\n" +
+	"\tClusterID\x18\x02 \x01(\tR\tClusterID\x12\x14\n" +
+	"\x05Phase\x18\x03 \x01(\tR\x05Phase\x120\n" +
+	"\x05Since\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x05Since\x120\n" +
+	"\x05Until\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x05Until\x12\x14\n" +
+	"\x05Limit\x18\x06 \x01(\x05R\x05Limit\";\n" +
+	"\x11AuditListResponse\x12&\n" +
+	"\x06Events\x18\x01 \x03(\v2\x0e.v1.AuditEventR\x06Events\"7\n" +
 	"\x11CliUpgradeRequest\x12\x0e\n" +
 	"\x02os\x18\x01 \x01(\tR\x02os\x12\x12\n" +
 	"\x04arch\x18\x02 \x01(\tR\x04arch\"2\n" +
@@ -1982,7 +4259,15 @@ const file_service_proto_rawDesc = "" +
 	"\x11MaintenanceActive\x18\x01 \x01(\bR\x11MaintenanceActive\x12\x1e\n" +
 	"\n" +
 	"Maintainer\x18\x02 \x01(\tR\n" +
-	"Maintainer*K\n" +
+	"Maintainer*Q\n" +
+	"\rParameterType\x12\n" +
+	"\n" +
+	"\x06STRING\x10\x00\x12\b\n" +
+	"\x04ENUM\x10\x01\x12\a\n" +
+	"\x03INT\x10\x02\x12\b\n" +
+	"\x04BOOL\x10\x03\x12\f\n" +
+	"\bDURATION\x10\x04\x12\t\n" +
+	"\x05IMAGE\x10\x05*K\n" +
 	"\x06Status\x12\n" +
 	"\n" +
 	"\x06FAILED\x10\x00\x12\f\n" +
@@ -1993,25 +4278,51 @@ const file_service_proto_rawDesc = "" +
 	"\bFINISHED\x10\x042X\n" +
 	"\x0eVersionService\x12F\n" +
 	"\n" +
-	"GetVersion\x12\x16.google.protobuf.Empty\x1a\v.v1.Version\"\x13\x82\xd3\xe4\x93\x02\r\x12\v/v1/version2\xf4\x01\n" +
+	"GetVersion\x12\x16.google.protobuf.Empty\x1a\v.v1.Version\"\x13\x82\xd3\xe4\x93\x02\r\x12\v/v1/version2\x97\x03\n" +
 	"\vUserService\x12H\n" +
 	"\x06Whoami\x12\x16.google.protobuf.Empty\x1a\x12.v1.WhoamiResponse\"\x12\x82\xd3\xe4\x93\x02\f\x12\n" +
 	"/v1/whoami\x12Q\n" +
 	"\vCreateToken\x12\x12.v1.ServiceAccount\x1a\x11.v1.TokenResponse\"\x1b\x82\xd3\xe4\x93\x02\x15:\x01*\"\x10/v1/token-create\x12H\n" +
-	"\x05Token\x12\x16.google.protobuf.Empty\x1a\x11.v1.TokenResponse\"\x14\x82\xd3\xe4\x93\x02\x0e:\x01*\"\t/v1/token2\x99\x01\n" +
+	"\x05Token\x12\x16.google.protobuf.Empty\x1a\x11.v1.TokenResponse\"\x14\x82\xd3\xe4\x93\x02\x0e:\x01*\"\t/v1/token\x12O\n" +
+	"\n" +
+	"ListTokens\x12\x16.google.protobuf.Empty\x1a\x15.v1.TokenListResponse\"\x12\x82\xd3\xe4\x93\x02\f\x12\n" +
+	"/v1/tokens\x12P\n" +
+	"\vRevokeToken\x12\x10.v1.ResourceByID\x1a\x0f.v1.IssuedToken\"\x1e\x82\xd3\xe4\x93\x02\x18\"\x16/v1/tokens/{id}/revoke2\xd1\x02\n" +
 	"\rFlavorService\x12I\n" +
 	"\x04List\x12\x15.v1.FlavorListRequest\x1a\x16.v1.FlavorListResponse\"\x12\x82\xd3\xe4\x93\x02\f\x12\n" +
 	"/v1/flavor\x12=\n" +
 	"\x04Info\x12\x10.v1.ResourceByID\x1a\n" +
-	".v1.Flavor\"\x17\x82\xd3\xe4\x93\x02\x11\x12\x0f/v1/flavor/{id}2\xc1\x04\n" +
-	"\x0eClusterService\x12?\n" +
-	"\x04Info\x12\x10.v1.ResourceByID\x1a\v.v1.Cluster\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/v1/cluster/{id}\x12L\n" +
+	".v1.Flavor\"\x17\x82\xd3\xe4\x93\x02\x11\x12\x0f/v1/flavor/{id}\x12V\n" +
+	"\x06Reload\x12\x16.google.protobuf.Empty\x1a\x18.v1.FlavorRegistryStatus\"\x1a\x82\xd3\xe4\x93\x02\x14\"\x12/v1/flavors/reload\x12^\n" +
+	"\x0eRegistryStatus\x12\x16.google.protobuf.Empty\x1a\x18.v1.FlavorRegistryStatus\"\x1a\x82\xd3\xe4\x93\x02\x14\x12\x12/v1/flavors/status2\xec\n" +
+	"\n" +
+	"\x0eClusterService\x12A\n" +
+	"\x04Info\x12\x12.v1.ClusterRequest\x1a\v.v1.Cluster\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/v1/cluster/{id}\x12L\n" +
 	"\x04List\x12\x16.v1.ClusterListRequest\x1a\x17.v1.ClusterListResponse\"\x13\x82\xd3\xe4\x93\x02\r\x12\v/v1/cluster\x12`\n" +
-	"\bLifespan\x12\x13.v1.LifespanRequest\x1a\x19.google.protobuf.Duration\"$\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/v1/cluster/{id}/lifespan\x12L\n" +
-	"\x06Create\x12\x18.v1.CreateClusterRequest\x1a\x10.v1.ResourceByID\"\x16\x82\xd3\xe4\x93\x02\x10:\x01*\"\v/v1/cluster\x12W\n" +
-	"\tArtifacts\x12\x10.v1.ResourceByID\x1a\x14.v1.ClusterArtifacts\"\"\x82\xd3\xe4\x93\x02\x1c\x12\x1a/v1/cluster/{id}/artifacts\x12L\n" +
-	"\x06Delete\x12\x10.v1.ResourceByID\x1a\x16.google.protobuf.Empty\"\x18\x82\xd3\xe4\x93\x02\x12*\x10/v1/cluster/{id}\x12I\n" +
-	"\x04Logs\x12\x10.v1.ResourceByID\x1a\x10.v1.LogsResponse\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/v1/cluster/{id}/logs2m\n" +
+	"\bLifespan\x12\x13.v1.LifespanRequest\x1a\x19.google.protobuf.Duration\"$\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/v1/cluster/{id}/lifespan\x12a\n" +
+	"\x0fUpdateOwnership\x12\x1a.v1.UpdateOwnershipRequest\x1a\v.v1.Cluster\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/v1/cluster/{id}/ownership\x12X\n" +
+	"\fUpdateLabels\x12\x17.v1.UpdateLabelsRequest\x1a\v.v1.Cluster\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/v1/cluster/{id}/labels\x12L\n" +
+	"\x06Create\x12\x18.v1.CreateClusterRequest\x1a\x10.v1.ResourceByID\"\x16\x82\xd3\xe4\x93\x02\x10:\x01*\"\v/v1/cluster\x12]\n" +
+	"\x05Clone\x12\x17.v1.CloneClusterRequest\x1a\x18.v1.CloneClusterResponse\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/v1/cluster/{id}/clone\x12K\n" +
+	"\x05Retry\x12\x10.v1.ResourceByID\x1a\x10.v1.ResourceByID\"\x1e\x82\xd3\xe4\x93\x02\x18\"\x16/v1/cluster/{id}/retry\x12Y\n" +
+	"\tArtifacts\x12\x12.v1.ClusterRequest\x1a\x14.v1.ClusterArtifacts\"\"\x82\xd3\xe4\x93\x02\x1c\x12\x1a/v1/cluster/{id}/artifacts\x12Y\n" +
+	"\aHistory\x12\x10.v1.ResourceByID\x1a\x1a.v1.ClusterHistoryResponse\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/v1/cluster/{id}/history\x12T\n" +
+	"\x06Delete\x12\x18.v1.DeleteClusterRequest\x1a\x16.google.protobuf.Empty\"\x18\x82\xd3\xe4\x93\x02\x12*\x10/v1/cluster/{id}\x12U\n" +
+	"\n" +
+	"BulkDelete\x12\x15.v1.BulkDeleteRequest\x1a\x10.v1.BulkResponse\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/v1/clusters/delete\x12[\n" +
+	"\fBulkLifespan\x12\x17.v1.BulkLifespanRequest\x1a\x10.v1.BulkResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/v1/clusters/lifespan\x12K\n" +
+	"\x04Logs\x12\x12.v1.ClusterRequest\x1a\x10.v1.LogsResponse\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/v1/cluster/{id}/logs\x12H\n" +
+	"\x05Watch\x12\x10.v1.ResourceByID\x1a\v.v1.Cluster\"\x1e\x82\xd3\xe4\x93\x02\x18\x12\x16/v1/cluster/{id}/watch0\x01\x12Y\n" +
+	"\n" +
+	"StreamLogs\x12\x15.v1.StreamLogsRequest\x1a\f.v1.LogChunk\"$\x82\xd3\xe4\x93\x02\x1e\x12\x1c/v1/cluster/{id}/logs/stream0\x012\xf0\x01\n" +
+	"\x0fScheduleService\x12=\n" +
+	"\x06Create\x12\f.v1.Schedule\x1a\f.v1.Schedule\"\x17\x82\xd3\xe4\x93\x02\x11:\x01*\"\f/v1/schedule\x12O\n" +
+	"\x04List\x12\x17.v1.ScheduleListRequest\x1a\x18.v1.ScheduleListResponse\"\x14\x82\xd3\xe4\x93\x02\x0e\x12\f/v1/schedule\x12M\n" +
+	"\x06Delete\x12\x10.v1.ResourceByID\x1a\x16.google.protobuf.Empty\"\x19\x82\xd3\xe4\x93\x02\x13*\x11/v1/schedule/{id}2S\n" +
+	"\fQuotaService\x12C\n" +
+	"\x03Get\x12\x16.google.protobuf.Empty\x1a\x11.v1.QuotaResponse\"\x11\x82\xd3\xe4\x93\x02\v\x12\t/v1/quota2V\n" +
+	"\fAuditService\x12F\n" +
+	"\x04List\x12\x14.v1.AuditListRequest\x1a\x15.v1.AuditListResponse\"\x11\x82\xd3\xe4\x93\x02\v\x12\t/v1/audit2m\n" +
 	"\n" +
 	"CliService\x12_\n" +
 	"\aUpgrade\x12\x15.v1.CliUpgradeRequest\x1a\x16.v1.CliUpgradeResponse\"#\x82\xd3\xe4\x93\x02\x1d\x12\x1b/v1/cli/{os}/{arch}/upgrade0\x012\xed\x01\n" +
@@ -2035,110 +4346,215 @@ func file_service_proto_rawDescGZIP() []byte {
 	return file_service_proto_rawDescData
 }
 
-var file_service_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_service_proto_msgTypes = make([]protoimpl.MessageInfo, 27)
+var file_service_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_service_proto_msgTypes = make([]protoimpl.MessageInfo, 58)
 var file_service_proto_goTypes = []any{
-	(Status)(0),                   // 0: v1.Status
-	(FlavorAvailability)(0),       // 1: v1.Flavor.availability
-	(LifespanRequest_Method)(0),   // 2: v1.LifespanRequest.Method
-	(*ResourceByID)(nil),          // 3: v1.ResourceByID
-	(*Version)(nil),               // 4: v1.Version
-	(*WhoamiResponse)(nil),        // 5: v1.WhoamiResponse
-	(*User)(nil),                  // 6: v1.User
-	(*ServiceAccount)(nil),        // 7: v1.ServiceAccount
-	(*TokenResponse)(nil),         // 8: v1.TokenResponse
-	(*Parameter)(nil),             // 9: v1.Parameter
-	(*FlavorArtifact)(nil),        // 10: v1.FlavorArtifact
-	(*Flavor)(nil),                // 11: v1.Flavor
-	(*FlavorListRequest)(nil),     // 12: v1.FlavorListRequest
-	(*FlavorListResponse)(nil),    // 13: v1.FlavorListResponse
-	(*Cluster)(nil),               // 14: v1.Cluster
-	(*ClusterListRequest)(nil),    // 15: v1.ClusterListRequest
-	(*ClusterListResponse)(nil),   // 16: v1.ClusterListResponse
-	(*LifespanRequest)(nil),       // 17: v1.LifespanRequest
-	(*CreateClusterRequest)(nil),  // 18: v1.CreateClusterRequest
-	(*Artifact)(nil),              // 19: v1.Artifact
-	(*ClusterArtifacts)(nil),      // 20: v1.ClusterArtifacts
-	(*Log)(nil),                   // 21: v1.Log
-	(*LogsResponse)(nil),          // 22: v1.LogsResponse
-	(*CliUpgradeRequest)(nil),     // 23: v1.CliUpgradeRequest
-	(*CliUpgradeResponse)(nil),    // 24: v1.CliUpgradeResponse
-	(*InfraStatus)(nil),           // 25: v1.InfraStatus
-	nil,                           // 26: v1.FlavorArtifact.TagsEntry
-	nil,                           // 27: v1.Flavor.ParametersEntry
-	nil,                           // 28: v1.Flavor.ArtifactsEntry
-	nil,                           // 29: v1.CreateClusterRequest.ParametersEntry
-	(*timestamppb.Timestamp)(nil), // 30: google.protobuf.Timestamp
-	(*durationpb.Duration)(nil),   // 31: google.protobuf.Duration
-	(*emptypb.Empty)(nil),         // 32: google.protobuf.Empty
+	(ParameterType)(0),             // 0: v1.ParameterType
+	(Status)(0),                    // 1: v1.Status
+	(FlavorAvailability)(0),        // 2: v1.Flavor.availability
+	(LifespanRequest_Method)(0),    // 3: v1.LifespanRequest.Method
+	(*ResourceByID)(nil),           // 4: v1.ResourceByID
+	(*Version)(nil),                // 5: v1.Version
+	(*WhoamiResponse)(nil),         // 6: v1.WhoamiResponse
+	(*User)(nil),                   // 7: v1.User
+	(*ServiceAccount)(nil),         // 8: v1.ServiceAccount
+	(*TokenScope)(nil),             // 9: v1.TokenScope
+	(*TokenResponse)(nil),          // 10: v1.TokenResponse
+	(*IssuedToken)(nil),            // 11: v1.IssuedToken
+	(*TokenListResponse)(nil),      // 12: v1.TokenListResponse
+	(*Parameter)(nil),              // 13: v1.Parameter
+	(*FlavorArtifact)(nil),         // 14: v1.FlavorArtifact
+	(*Flavor)(nil),                 // 15: v1.Flavor
+	(*FlavorListRequest)(nil),      // 16: v1.FlavorListRequest
+	(*FlavorListResponse)(nil),     // 17: v1.FlavorListResponse
+	(*FlavorRegistryStatus)(nil),   // 18: v1.FlavorRegistryStatus
+	(*Cluster)(nil),                // 19: v1.Cluster
+	(*ClusterRequest)(nil),         // 20: v1.ClusterRequest
+	(*ClusterGeneration)(nil),      // 21: v1.ClusterGeneration
+	(*ClusterHistoryResponse)(nil), // 22: v1.ClusterHistoryResponse
+	(*ClusterListRequest)(nil),     // 23: v1.ClusterListRequest
+	(*ClusterListResponse)(nil),    // 24: v1.ClusterListResponse
+	(*LifespanRequest)(nil),        // 25: v1.LifespanRequest
+	(*DeleteClusterRequest)(nil),   // 26: v1.DeleteClusterRequest
+	(*BulkDeleteRequest)(nil),      // 27: v1.BulkDeleteRequest
+	(*BulkLifespanRequest)(nil),    // 28: v1.BulkLifespanRequest
+	(*BulkResult)(nil),             // 29: v1.BulkResult
+	(*BulkResponse)(nil),           // 30: v1.BulkResponse
+	(*UpdateOwnershipRequest)(nil), // 31: v1.UpdateOwnershipRequest
+	(*UpdateLabelsRequest)(nil),    // 32: v1.UpdateLabelsRequest
+	(*CreateClusterRequest)(nil),   // 33: v1.CreateClusterRequest
+	(*CloneClusterRequest)(nil),    // 34: v1.CloneClusterRequest
+	(*CloneClusterResponse)(nil),   // 35: v1.CloneClusterResponse
+	(*Artifact)(nil),               // 36: v1.Artifact
+	(*ClusterArtifacts)(nil),       // 37: v1.ClusterArtifacts
+	(*Log)(nil),                    // 38: v1.Log
+	(*LogsResponse)(nil),           // 39: v1.LogsResponse
+	(*StreamLogsRequest)(nil),      // 40: v1.StreamLogsRequest
+	(*LogChunk)(nil),               // 41: v1.LogChunk
+	(*Schedule)(nil),               // 42: v1.Schedule
+	(*ScheduleListRequest)(nil),    // 43: v1.ScheduleListRequest
+	(*ScheduleListResponse)(nil),   // 44: v1.ScheduleListResponse
+	(*QuotaUsage)(nil),             // 45: v1.QuotaUsage
+	(*QuotaResponse)(nil),          // 46: v1.QuotaResponse
+	(*AuditEvent)(nil),             // 47: v1.AuditEvent
+	(*AuditListRequest)(nil),       // 48: v1.AuditListRequest
+	(*AuditListResponse)(nil),      // 49: v1.AuditListResponse
+	(*CliUpgradeRequest)(nil),      // 50: v1.CliUpgradeRequest
+	(*CliUpgradeResponse)(nil),     // 51: v1.CliUpgradeResponse
+	(*InfraStatus)(nil),            // 52: v1.InfraStatus
+	nil,                            // 53: v1.FlavorArtifact.TagsEntry
+	nil,                            // 54: v1.Flavor.ParametersEntry
+	nil,                            // 55: v1.Flavor.ArtifactsEntry
+	nil,                            // 56: v1.Cluster.LabelsEntry
+	nil,                            // 57: v1.UpdateLabelsRequest.SetEntry
+	nil,                            // 58: v1.CreateClusterRequest.ParametersEntry
+	nil,                            // 59: v1.CreateClusterRequest.LabelsEntry
+	nil,                            // 60: v1.CloneClusterRequest.ParametersEntry
+	nil,                            // 61: v1.AuditEvent.DetailsEntry
+	(*timestamppb.Timestamp)(nil),  // 62: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),    // 63: google.protobuf.Duration
+	(*wrapperspb.Int64Value)(nil),  // 64: google.protobuf.Int64Value
+	(*emptypb.Empty)(nil),          // 65: google.protobuf.Empty
 }
 var file_service_proto_depIdxs = []int32{
-	30, // 0: v1.Version.BuildDate:type_name -> google.protobuf.Timestamp
-	6,  // 1: v1.WhoamiResponse.User:type_name -> v1.User
-	7,  // 2: v1.WhoamiResponse.ServiceAccount:type_name -> v1.ServiceAccount
-	30, // 3: v1.User.Expiry:type_name -> google.protobuf.Timestamp
-	7,  // 4: v1.TokenResponse.Account:type_name -> v1.ServiceAccount
-	26, // 5: v1.FlavorArtifact.Tags:type_name -> v1.FlavorArtifact.TagsEntry
-	1,  // 6: v1.Flavor.Availability:type_name -> v1.Flavor.availability
-	27, // 7: v1.Flavor.Parameters:type_name -> v1.Flavor.ParametersEntry
-	28, // 8: v1.Flavor.Artifacts:type_name -> v1.Flavor.ArtifactsEntry
-	11, // 9: v1.FlavorListResponse.Flavors:type_name -> v1.Flavor
-	0,  // 10: v1.Cluster.Status:type_name -> v1.Status
-	30, // 11: v1.Cluster.CreatedOn:type_name -> google.protobuf.Timestamp
-	30, // 12: v1.Cluster.DestroyedOn:type_name -> google.protobuf.Timestamp
-	31, // 13: v1.Cluster.Lifespan:type_name -> google.protobuf.Duration
-	9,  // 14: v1.Cluster.Parameters:type_name -> v1.Parameter
-	0,  // 15: v1.ClusterListRequest.allowedStatuses:type_name -> v1.Status
-	14, // 16: v1.ClusterListResponse.Clusters:type_name -> v1.Cluster
-	31, // 17: v1.LifespanRequest.Lifespan:type_name -> google.protobuf.Duration
-	2,  // 18: v1.LifespanRequest.method:type_name -> v1.LifespanRequest.Method
-	31, // 19: v1.CreateClusterRequest.Lifespan:type_name -> google.protobuf.Duration
-	29, // 20: v1.CreateClusterRequest.Parameters:type_name -> v1.CreateClusterRequest.ParametersEntry
-	19, // 21: v1.ClusterArtifacts.Artifacts:type_name -> v1.Artifact
-	30, // 22: v1.Log.Started:type_name -> google.protobuf.Timestamp
-	21, // 23: v1.LogsResponse.Logs:type_name -> v1.Log
-	32, // 24: v1.FlavorArtifact.TagsEntry.value:type_name -> google.protobuf.Empty
-	9,  // 25: v1.Flavor.ParametersEntry.value:type_name -> v1.Parameter
-	10, // 26: v1.Flavor.ArtifactsEntry.value:type_name -> v1.FlavorArtifact
-	32, // 27: v1.VersionService.GetVersion:input_type -> google.protobuf.Empty
-	32, // 28: v1.UserService.Whoami:input_type -> google.protobuf.Empty
-	7,  // 29: v1.UserService.CreateToken:input_type -> v1.ServiceAccount
-	32, // 30: v1.UserService.Token:input_type -> google.protobuf.Empty
-	12, // 31: v1.FlavorService.List:input_type -> v1.FlavorListRequest
-	3,  // 32: v1.FlavorService.Info:input_type -> v1.ResourceByID
-	3,  // 33: v1.ClusterService.Info:input_type -> v1.ResourceByID
-	15, // 34: v1.ClusterService.List:input_type -> v1.ClusterListRequest
-	17, // 35: v1.ClusterService.Lifespan:input_type -> v1.LifespanRequest
-	18, // 36: v1.ClusterService.Create:input_type -> v1.CreateClusterRequest
-	3,  // 37: v1.ClusterService.Artifacts:input_type -> v1.ResourceByID
-	3,  // 38: v1.ClusterService.Delete:input_type -> v1.ResourceByID
-	3,  // 39: v1.ClusterService.Logs:input_type -> v1.ResourceByID
-	23, // 40: v1.CliService.Upgrade:input_type -> v1.CliUpgradeRequest
-	32, // 41: v1.InfraStatusService.GetStatus:input_type -> google.protobuf.Empty
-	32, // 42: v1.InfraStatusService.ResetStatus:input_type -> google.protobuf.Empty
-	25, // 43: v1.InfraStatusService.SetStatus:input_type -> v1.InfraStatus
-	4,  // 44: v1.VersionService.GetVersion:output_type -> v1.Version
-	5,  // 45: v1.UserService.Whoami:output_type -> v1.WhoamiResponse
-	8,  // 46: v1.UserService.CreateToken:output_type -> v1.TokenResponse
-	8,  // 47: v1.UserService.Token:output_type -> v1.TokenResponse
-	13, // 48: v1.FlavorService.List:output_type -> v1.FlavorListResponse
-	11, // 49: v1.FlavorService.Info:output_type -> v1.Flavor
-	14, // 50: v1.ClusterService.Info:output_type -> v1.Cluster
-	16, // 51: v1.ClusterService.List:output_type -> v1.ClusterListResponse
-	31, // 52: v1.ClusterService.Lifespan:output_type -> google.protobuf.Duration
-	3,  // 53: v1.ClusterService.Create:output_type -> v1.ResourceByID
-	20, // 54: v1.ClusterService.Artifacts:output_type -> v1.ClusterArtifacts
-	32, // 55: v1.ClusterService.Delete:output_type -> google.protobuf.Empty
-	22, // 56: v1.ClusterService.Logs:output_type -> v1.LogsResponse
-	24, // 57: v1.CliService.Upgrade:output_type -> v1.CliUpgradeResponse
-	25, // 58: v1.InfraStatusService.GetStatus:output_type -> v1.InfraStatus
-	25, // 59: v1.InfraStatusService.ResetStatus:output_type -> v1.InfraStatus
-	25, // 60: v1.InfraStatusService.SetStatus:output_type -> v1.InfraStatus
-	44, // [44:61] is the sub-list for method output_type
-	27, // [27:44] is the sub-list for method input_type
-	27, // [27:27] is the sub-list for extension type_name
-	27, // [27:27] is the sub-list for extension extendee
-	0,  // [0:27] is the sub-list for field type_name
+	62, // 0: v1.Version.BuildDate:type_name -> google.protobuf.Timestamp
+	7,  // 1: v1.WhoamiResponse.User:type_name -> v1.User
+	8,  // 2: v1.WhoamiResponse.ServiceAccount:type_name -> v1.ServiceAccount
+	62, // 3: v1.User.Expiry:type_name -> google.protobuf.Timestamp
+	9,  // 4: v1.ServiceAccount.Scope:type_name -> v1.TokenScope
+	63, // 5: v1.TokenScope.MaxLifespan:type_name -> google.protobuf.Duration
+	8,  // 6: v1.TokenResponse.Account:type_name -> v1.ServiceAccount
+	8,  // 7: v1.IssuedToken.Account:type_name -> v1.ServiceAccount
+	62, // 8: v1.IssuedToken.RevokedAt:type_name -> google.protobuf.Timestamp
+	11, // 9: v1.TokenListResponse.Tokens:type_name -> v1.IssuedToken
+	0,  // 10: v1.Parameter.Type:type_name -> v1.ParameterType
+	64, // 11: v1.Parameter.Min:type_name -> google.protobuf.Int64Value
+	64, // 12: v1.Parameter.Max:type_name -> google.protobuf.Int64Value
+	53, // 13: v1.FlavorArtifact.Tags:type_name -> v1.FlavorArtifact.TagsEntry
+	2,  // 14: v1.Flavor.Availability:type_name -> v1.Flavor.availability
+	54, // 15: v1.Flavor.Parameters:type_name -> v1.Flavor.ParametersEntry
+	55, // 16: v1.Flavor.Artifacts:type_name -> v1.Flavor.ArtifactsEntry
+	15, // 17: v1.FlavorListResponse.Flavors:type_name -> v1.Flavor
+	62, // 18: v1.FlavorRegistryStatus.LoadedOn:type_name -> google.protobuf.Timestamp
+	62, // 19: v1.FlavorRegistryStatus.LastReload:type_name -> google.protobuf.Timestamp
+	1,  // 20: v1.Cluster.Status:type_name -> v1.Status
+	62, // 21: v1.Cluster.CreatedOn:type_name -> google.protobuf.Timestamp
+	62, // 22: v1.Cluster.DestroyedOn:type_name -> google.protobuf.Timestamp
+	63, // 23: v1.Cluster.Lifespan:type_name -> google.protobuf.Duration
+	13, // 24: v1.Cluster.Parameters:type_name -> v1.Parameter
+	56, // 25: v1.Cluster.Labels:type_name -> v1.Cluster.LabelsEntry
+	19, // 26: v1.ClusterGeneration.Cluster:type_name -> v1.Cluster
+	21, // 27: v1.ClusterHistoryResponse.Generations:type_name -> v1.ClusterGeneration
+	1,  // 28: v1.ClusterListRequest.allowedStatuses:type_name -> v1.Status
+	19, // 29: v1.ClusterListResponse.Clusters:type_name -> v1.Cluster
+	63, // 30: v1.LifespanRequest.Lifespan:type_name -> google.protobuf.Duration
+	3,  // 31: v1.LifespanRequest.method:type_name -> v1.LifespanRequest.Method
+	23, // 32: v1.BulkDeleteRequest.filter:type_name -> v1.ClusterListRequest
+	23, // 33: v1.BulkLifespanRequest.filter:type_name -> v1.ClusterListRequest
+	63, // 34: v1.BulkLifespanRequest.Lifespan:type_name -> google.protobuf.Duration
+	3,  // 35: v1.BulkLifespanRequest.method:type_name -> v1.LifespanRequest.Method
+	63, // 36: v1.BulkResult.Lifespan:type_name -> google.protobuf.Duration
+	29, // 37: v1.BulkResponse.results:type_name -> v1.BulkResult
+	57, // 38: v1.UpdateLabelsRequest.set:type_name -> v1.UpdateLabelsRequest.SetEntry
+	63, // 39: v1.CreateClusterRequest.Lifespan:type_name -> google.protobuf.Duration
+	58, // 40: v1.CreateClusterRequest.Parameters:type_name -> v1.CreateClusterRequest.ParametersEntry
+	59, // 41: v1.CreateClusterRequest.Labels:type_name -> v1.CreateClusterRequest.LabelsEntry
+	60, // 42: v1.CloneClusterRequest.Parameters:type_name -> v1.CloneClusterRequest.ParametersEntry
+	63, // 43: v1.CloneClusterRequest.Lifespan:type_name -> google.protobuf.Duration
+	36, // 44: v1.ClusterArtifacts.Artifacts:type_name -> v1.Artifact
+	62, // 45: v1.Log.Started:type_name -> google.protobuf.Timestamp
+	38, // 46: v1.LogsResponse.Logs:type_name -> v1.Log
+	33, // 47: v1.Schedule.Request:type_name -> v1.CreateClusterRequest
+	62, // 48: v1.Schedule.At:type_name -> google.protobuf.Timestamp
+	62, // 49: v1.Schedule.NextRun:type_name -> google.protobuf.Timestamp
+	62, // 50: v1.Schedule.LastRun:type_name -> google.protobuf.Timestamp
+	62, // 51: v1.Schedule.CreatedOn:type_name -> google.protobuf.Timestamp
+	9,  // 52: v1.Schedule.Scope:type_name -> v1.TokenScope
+	42, // 53: v1.ScheduleListResponse.Schedules:type_name -> v1.Schedule
+	45, // 54: v1.QuotaResponse.Usage:type_name -> v1.QuotaUsage
+	62, // 55: v1.AuditEvent.Time:type_name -> google.protobuf.Timestamp
+	61, // 56: v1.AuditEvent.Details:type_name -> v1.AuditEvent.DetailsEntry
+	62, // 57: v1.AuditListRequest.Since:type_name -> google.protobuf.Timestamp
+	62, // 58: v1.AuditListRequest.Until:type_name -> google.protobuf.Timestamp
+	47, // 59: v1.AuditListResponse.Events:type_name -> v1.AuditEvent
+	65, // 60: v1.FlavorArtifact.TagsEntry.value:type_name -> google.protobuf.Empty
+	13, // 61: v1.Flavor.ParametersEntry.value:type_name -> v1.Parameter
+	14, // 62: v1.Flavor.ArtifactsEntry.value:type_name -> v1.FlavorArtifact
+	65, // 63: v1.VersionService.GetVersion:input_type -> google.protobuf.Empty
+	65, // 64: v1.UserService.Whoami:input_type -> google.protobuf.Empty
+	8,  // 65: v1.UserService.CreateToken:input_type -> v1.ServiceAccount
+	65, // 66: v1.UserService.Token:input_type -> google.protobuf.Empty
+	65, // 67: v1.UserService.ListTokens:input_type -> google.protobuf.Empty
+	4,  // 68: v1.UserService.RevokeToken:input_type -> v1.ResourceByID
+	16, // 69: v1.FlavorService.List:input_type -> v1.FlavorListRequest
+	4,  // 70: v1.FlavorService.Info:input_type -> v1.ResourceByID
+	65, // 71: v1.FlavorService.Reload:input_type -> google.protobuf.Empty
+	65, // 72: v1.FlavorService.RegistryStatus:input_type -> google.protobuf.Empty
+	20, // 73: v1.ClusterService.Info:input_type -> v1.ClusterRequest
+	23, // 74: v1.ClusterService.List:input_type -> v1.ClusterListRequest
+	25, // 75: v1.ClusterService.Lifespan:input_type -> v1.LifespanRequest
+	31, // 76: v1.ClusterService.UpdateOwnership:input_type -> v1.UpdateOwnershipRequest
+	32, // 77: v1.ClusterService.UpdateLabels:input_type -> v1.UpdateLabelsRequest
+	33, // 78: v1.ClusterService.Create:input_type -> v1.CreateClusterRequest
+	34, // 79: v1.ClusterService.Clone:input_type -> v1.CloneClusterRequest
+	4,  // 80: v1.ClusterService.Retry:input_type -> v1.ResourceByID
+	20, // 81: v1.ClusterService.Artifacts:input_type -> v1.ClusterRequest
+	4,  // 82: v1.ClusterService.History:input_type -> v1.ResourceByID
+	26, // 83: v1.ClusterService.Delete:input_type -> v1.DeleteClusterRequest
+	27, // 84: v1.ClusterService.BulkDelete:input_type -> v1.BulkDeleteRequest
+	28, // 85: v1.ClusterService.BulkLifespan:input_type -> v1.BulkLifespanRequest
+	20, // 86: v1.ClusterService.Logs:input_type -> v1.ClusterRequest
+	4,  // 87: v1.ClusterService.Watch:input_type -> v1.ResourceByID
+	40, // 88: v1.ClusterService.StreamLogs:input_type -> v1.StreamLogsRequest
+	42, // 89: v1.ScheduleService.Create:input_type -> v1.Schedule
+	43, // 90: v1.ScheduleService.List:input_type -> v1.ScheduleListRequest
+	4,  // 91: v1.ScheduleService.Delete:input_type -> v1.ResourceByID
+	65, // 92: v1.QuotaService.Get:input_type -> google.protobuf.Empty
+	48, // 93: v1.AuditService.List:input_type -> v1.AuditListRequest
+	50, // 94: v1.CliService.Upgrade:input_type -> v1.CliUpgradeRequest
+	65, // 95: v1.InfraStatusService.GetStatus:input_type -> google.protobuf.Empty
+	65, // 96: v1.InfraStatusService.ResetStatus:input_type -> google.protobuf.Empty
+	52, // 97: v1.InfraStatusService.SetStatus:input_type -> v1.InfraStatus
+	5,  // 98: v1.VersionService.GetVersion:output_type -> v1.Version
+	6,  // 99: v1.UserService.Whoami:output_type -> v1.WhoamiResponse
+	10, // 100: v1.UserService.CreateToken:output_type -> v1.TokenResponse
+	10, // 101: v1.UserService.Token:output_type -> v1.TokenResponse
+	12, // 102: v1.UserService.ListTokens:output_type -> v1.TokenListResponse
+	11, // 103: v1.UserService.RevokeToken:output_type -> v1.IssuedToken
+	17, // 104: v1.FlavorService.List:output_type -> v1.FlavorListResponse
+	15, // 105: v1.FlavorService.Info:output_type -> v1.Flavor
+	18, // 106: v1.FlavorService.Reload:output_type -> v1.FlavorRegistryStatus
+	18, // 107: v1.FlavorService.RegistryStatus:output_type -> v1.FlavorRegistryStatus
+	19, // 108: v1.ClusterService.Info:output_type -> v1.Cluster
+	24, // 109: v1.ClusterService.List:output_type -> v1.ClusterListResponse
+	63, // 110: v1.ClusterService.Lifespan:output_type -> google.protobuf.Duration
+	19, // 111: v1.ClusterService.UpdateOwnership:output_type -> v1.Cluster
+	19, // 112: v1.ClusterService.UpdateLabels:output_type -> v1.Cluster
+	4,  // 113: v1.ClusterService.Create:output_type -> v1.ResourceByID
+	35, // 114: v1.ClusterService.Clone:output_type -> v1.CloneClusterResponse
+	4,  // 115: v1.ClusterService.Retry:output_type -> v1.ResourceByID
+	37, // 116: v1.ClusterService.Artifacts:output_type -> v1.ClusterArtifacts
+	22, // 117: v1.ClusterService.History:output_type -> v1.ClusterHistoryResponse
+	65, // 118: v1.ClusterService.Delete:output_type -> google.protobuf.Empty
+	30, // 119: v1.ClusterService.BulkDelete:output_type -> v1.BulkResponse
+	30, // 120: v1.ClusterService.BulkLifespan:output_type -> v1.BulkResponse
+	39, // 121: v1.ClusterService.Logs:output_type -> v1.LogsResponse
+	19, // 122: v1.ClusterService.Watch:output_type -> v1.Cluster
+	41, // 123: v1.ClusterService.StreamLogs:output_type -> v1.LogChunk
+	42, // 124: v1.ScheduleService.Create:output_type -> v1.Schedule
+	44, // 125: v1.ScheduleService.List:output_type -> v1.ScheduleListResponse
+	65, // 126: v1.ScheduleService.Delete:output_type -> google.protobuf.Empty
+	46, // 127: v1.QuotaService.Get:output_type -> v1.QuotaResponse
+	49, // 128: v1.AuditService.List:output_type -> v1.AuditListResponse
+	51, // 129: v1.CliService.Upgrade:output_type -> v1.CliUpgradeResponse
+	52, // 130: v1.InfraStatusService.GetStatus:output_type -> v1.InfraStatus
+	52, // 131: v1.InfraStatusService.ResetStatus:output_type -> v1.InfraStatus
+	52, // 132: v1.InfraStatusService.SetStatus:output_type -> v1.InfraStatus
+	98, // [98:133] is the sub-list for method output_type
+	63, // [63:98] is the sub-list for method input_type
+	63, // [63:63] is the sub-list for extension type_name
+	63, // [63:63] is the sub-list for extension extendee
+	0,  // [0:63] is the sub-list for field type_name
 }
 
 func init() { file_service_proto_init() }
@@ -2155,10 +4571,10 @@ func file_service_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_service_proto_rawDesc), len(file_service_proto_rawDesc)),
-			NumEnums:      3,
-			NumMessages:   27,
+			NumEnums:      4,
+			NumMessages:   58,
 			NumExtensions: 0,
-			NumServices:   6,
+			NumServices:   9,
 		},
 		GoTypes:           file_service_proto_goTypes,
 		DependencyIndexes: file_service_proto_depIdxs,
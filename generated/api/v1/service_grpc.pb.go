@@ -126,6 +126,8 @@ const (
 	UserService_Whoami_FullMethodName      = "/v1.UserService/Whoami"
 	UserService_CreateToken_FullMethodName = "/v1.UserService/CreateToken"
 	UserService_Token_FullMethodName       = "/v1.UserService/Token"
+	UserService_ListTokens_FullMethodName  = "/v1.UserService/ListTokens"
+	UserService_RevokeToken_FullMethodName = "/v1.UserService/RevokeToken"
 )
 
 // UserServiceClient is the client API for UserService service.
@@ -138,6 +140,11 @@ type UserServiceClient interface {
 	CreateToken(ctx context.Context, in *ServiceAccount, opts ...grpc.CallOption) (*TokenResponse, error)
 	// Token generates a service account token for the current user.
 	Token(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*TokenResponse, error)
+	// ListTokens lists the unexpired service account tokens of the current
+	// user, or of everyone for admins.
+	ListTokens(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*TokenListResponse, error)
+	// RevokeToken revokes the service account token with the given ID.
+	RevokeToken(ctx context.Context, in *ResourceByID, opts ...grpc.CallOption) (*IssuedToken, error)
 }
 
 type userServiceClient struct {
@@ -178,6 +185,26 @@ func (c *userServiceClient) Token(ctx context.Context, in *emptypb.Empty, opts .
 	return out, nil
 }
 
+func (c *userServiceClient) ListTokens(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*TokenListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TokenListResponse)
+	err := c.cc.Invoke(ctx, UserService_ListTokens_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) RevokeToken(ctx context.Context, in *ResourceByID, opts ...grpc.CallOption) (*IssuedToken, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(IssuedToken)
+	err := c.cc.Invoke(ctx, UserService_RevokeToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UserServiceServer is the server API for UserService service.
 // All implementations must embed UnimplementedUserServiceServer
 // for forward compatibility.
@@ -188,6 +215,11 @@ type UserServiceServer interface {
 	CreateToken(context.Context, *ServiceAccount) (*TokenResponse, error)
 	// Token generates a service account token for the current user.
 	Token(context.Context, *emptypb.Empty) (*TokenResponse, error)
+	// ListTokens lists the unexpired service account tokens of the current
+	// user, or of everyone for admins.
+	ListTokens(context.Context, *emptypb.Empty) (*TokenListResponse, error)
+	// RevokeToken revokes the service account token with the given ID.
+	RevokeToken(context.Context, *ResourceByID) (*IssuedToken, error)
 	mustEmbedUnimplementedUserServiceServer()
 }
 
@@ -207,6 +239,12 @@ func (UnimplementedUserServiceServer) CreateToken(context.Context, *ServiceAccou
 func (UnimplementedUserServiceServer) Token(context.Context, *emptypb.Empty) (*TokenResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Token not implemented")
 }
+func (UnimplementedUserServiceServer) ListTokens(context.Context, *emptypb.Empty) (*TokenListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTokens not implemented")
+}
+func (UnimplementedUserServiceServer) RevokeToken(context.Context, *ResourceByID) (*IssuedToken, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeToken not implemented")
+}
 func (UnimplementedUserServiceServer) mustEmbedUnimplementedUserServiceServer() {}
 func (UnimplementedUserServiceServer) testEmbeddedByValue()                     {}
 
@@ -282,6 +320,42 @@ func _UserService_Token_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_ListTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ListTokens(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ListTokens_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ListTokens(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_RevokeToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResourceByID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).RevokeToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_RevokeToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).RevokeToken(ctx, req.(*ResourceByID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // UserService_ServiceDesc is the grpc.ServiceDesc for UserService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -301,14 +375,24 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Token",
 			Handler:    _UserService_Token_Handler,
 		},
+		{
+			MethodName: "ListTokens",
+			Handler:    _UserService_ListTokens_Handler,
+		},
+		{
+			MethodName: "RevokeToken",
+			Handler:    _UserService_RevokeToken_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "service.proto",
 }
 
 const (
-	FlavorService_List_FullMethodName = "/v1.FlavorService/List"
-	FlavorService_Info_FullMethodName = "/v1.FlavorService/Info"
+	FlavorService_List_FullMethodName           = "/v1.FlavorService/List"
+	FlavorService_Info_FullMethodName           = "/v1.FlavorService/Info"
+	FlavorService_Reload_FullMethodName         = "/v1.FlavorService/Reload"
+	FlavorService_RegistryStatus_FullMethodName = "/v1.FlavorService/RegistryStatus"
 )
 
 // FlavorServiceClient is the client API for FlavorService service.
@@ -321,6 +405,10 @@ type FlavorServiceClient interface {
 	List(ctx context.Context, in *FlavorListRequest, opts ...grpc.CallOption) (*FlavorListResponse, error)
 	// Info provides information about a specific flavor.
 	Info(ctx context.Context, in *ResourceByID, opts ...grpc.CallOption) (*Flavor, error)
+	// Reload reloads the flavor config and workflows.
+	Reload(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*FlavorRegistryStatus, error)
+	// RegistryStatus provides the outcome of loading the flavor config.
+	RegistryStatus(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*FlavorRegistryStatus, error)
 }
 
 type flavorServiceClient struct {
@@ -351,6 +439,26 @@ func (c *flavorServiceClient) Info(ctx context.Context, in *ResourceByID, opts .
 	return out, nil
 }
 
+func (c *flavorServiceClient) Reload(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*FlavorRegistryStatus, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FlavorRegistryStatus)
+	err := c.cc.Invoke(ctx, FlavorService_Reload_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *flavorServiceClient) RegistryStatus(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*FlavorRegistryStatus, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FlavorRegistryStatus)
+	err := c.cc.Invoke(ctx, FlavorService_RegistryStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // FlavorServiceServer is the server API for FlavorService service.
 // All implementations must embed UnimplementedFlavorServiceServer
 // for forward compatibility.
@@ -361,6 +469,10 @@ type FlavorServiceServer interface {
 	List(context.Context, *FlavorListRequest) (*FlavorListResponse, error)
 	// Info provides information about a specific flavor.
 	Info(context.Context, *ResourceByID) (*Flavor, error)
+	// Reload reloads the flavor config and workflows.
+	Reload(context.Context, *emptypb.Empty) (*FlavorRegistryStatus, error)
+	// RegistryStatus provides the outcome of loading the flavor config.
+	RegistryStatus(context.Context, *emptypb.Empty) (*FlavorRegistryStatus, error)
 	mustEmbedUnimplementedFlavorServiceServer()
 }
 
@@ -377,6 +489,12 @@ func (UnimplementedFlavorServiceServer) List(context.Context, *FlavorListRequest
 func (UnimplementedFlavorServiceServer) Info(context.Context, *ResourceByID) (*Flavor, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Info not implemented")
 }
+func (UnimplementedFlavorServiceServer) Reload(context.Context, *emptypb.Empty) (*FlavorRegistryStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Reload not implemented")
+}
+func (UnimplementedFlavorServiceServer) RegistryStatus(context.Context, *emptypb.Empty) (*FlavorRegistryStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegistryStatus not implemented")
+}
 func (UnimplementedFlavorServiceServer) mustEmbedUnimplementedFlavorServiceServer() {}
 func (UnimplementedFlavorServiceServer) testEmbeddedByValue()                       {}
 
@@ -434,6 +552,42 @@ func _FlavorService_Info_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _FlavorService_Reload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FlavorServiceServer).Reload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FlavorService_Reload_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FlavorServiceServer).Reload(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FlavorService_RegistryStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FlavorServiceServer).RegistryStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FlavorService_RegistryStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FlavorServiceServer).RegistryStatus(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // FlavorService_ServiceDesc is the grpc.ServiceDesc for FlavorService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -449,19 +603,36 @@ var FlavorService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Info",
 			Handler:    _FlavorService_Info_Handler,
 		},
+		{
+			MethodName: "Reload",
+			Handler:    _FlavorService_Reload_Handler,
+		},
+		{
+			MethodName: "RegistryStatus",
+			Handler:    _FlavorService_RegistryStatus_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "service.proto",
 }
 
 const (
-	ClusterService_Info_FullMethodName      = "/v1.ClusterService/Info"
-	ClusterService_List_FullMethodName      = "/v1.ClusterService/List"
-	ClusterService_Lifespan_FullMethodName  = "/v1.ClusterService/Lifespan"
-	ClusterService_Create_FullMethodName    = "/v1.ClusterService/Create"
-	ClusterService_Artifacts_FullMethodName = "/v1.ClusterService/Artifacts"
-	ClusterService_Delete_FullMethodName    = "/v1.ClusterService/Delete"
-	ClusterService_Logs_FullMethodName      = "/v1.ClusterService/Logs"
+	ClusterService_Info_FullMethodName            = "/v1.ClusterService/Info"
+	ClusterService_List_FullMethodName            = "/v1.ClusterService/List"
+	ClusterService_Lifespan_FullMethodName        = "/v1.ClusterService/Lifespan"
+	ClusterService_UpdateOwnership_FullMethodName = "/v1.ClusterService/UpdateOwnership"
+	ClusterService_UpdateLabels_FullMethodName    = "/v1.ClusterService/UpdateLabels"
+	ClusterService_Create_FullMethodName          = "/v1.ClusterService/Create"
+	ClusterService_Clone_FullMethodName           = "/v1.ClusterService/Clone"
+	ClusterService_Retry_FullMethodName           = "/v1.ClusterService/Retry"
+	ClusterService_Artifacts_FullMethodName       = "/v1.ClusterService/Artifacts"
+	ClusterService_History_FullMethodName         = "/v1.ClusterService/History"
+	ClusterService_Delete_FullMethodName          = "/v1.ClusterService/Delete"
+	ClusterService_BulkDelete_FullMethodName      = "/v1.ClusterService/BulkDelete"
+	ClusterService_BulkLifespan_FullMethodName    = "/v1.ClusterService/BulkLifespan"
+	ClusterService_Logs_FullMethodName            = "/v1.ClusterService/Logs"
+	ClusterService_Watch_FullMethodName           = "/v1.ClusterService/Watch"
+	ClusterService_StreamLogs_FullMethodName      = "/v1.ClusterService/StreamLogs"
 )
 
 // ClusterServiceClient is the client API for ClusterService service.
@@ -471,19 +642,44 @@ const (
 // FlavorService provides flavor based functionality.
 type ClusterServiceClient interface {
 	// Info provides information about a specific cluster.
-	Info(ctx context.Context, in *ResourceByID, opts ...grpc.CallOption) (*Cluster, error)
+	Info(ctx context.Context, in *ClusterRequest, opts ...grpc.CallOption) (*Cluster, error)
 	// List provides information about the available clusters.
 	List(ctx context.Context, in *ClusterListRequest, opts ...grpc.CallOption) (*ClusterListResponse, error)
 	// Lifespan updates the lifespan for a specific cluster.
 	Lifespan(ctx context.Context, in *LifespanRequest, opts ...grpc.CallOption) (*durationpb.Duration, error)
+	// UpdateOwnership transfers a specific cluster to a new owner, and/or
+	// updates its collaborators.
+	UpdateOwnership(ctx context.Context, in *UpdateOwnershipRequest, opts ...grpc.CallOption) (*Cluster, error)
+	// UpdateLabels adds, replaces and removes the user-defined labels of a
+	// specific cluster.
+	UpdateLabels(ctx context.Context, in *UpdateLabelsRequest, opts ...grpc.CallOption) (*Cluster, error)
 	// Create launches a new cluster.
 	Create(ctx context.Context, in *CreateClusterRequest, opts ...grpc.CallOption) (*ResourceByID, error)
+	// Clone launches a new cluster with the flavor, parameters, description
+	// and Slack preferences of an existing cluster.
+	Clone(ctx context.Context, in *CloneClusterRequest, opts ...grpc.CallOption) (*CloneClusterResponse, error)
+	// Retry resumes a failed cluster from its failed steps, instead of
+	// provisioning it again from scratch.
+	Retry(ctx context.Context, in *ResourceByID, opts ...grpc.CallOption) (*ResourceByID, error)
 	// Artifacts returns the artifacts for a specific cluster.
-	Artifacts(ctx context.Context, in *ResourceByID, opts ...grpc.CallOption) (*ClusterArtifacts, error)
+	Artifacts(ctx context.Context, in *ClusterRequest, opts ...grpc.CallOption) (*ClusterArtifacts, error)
+	// History provides information about every generation of a cluster, as
+	// a cluster ID may be reused once an earlier cluster failed or finished.
+	History(ctx context.Context, in *ResourceByID, opts ...grpc.CallOption) (*ClusterHistoryResponse, error)
 	// Delete deletes an existing cluster.
-	Delete(ctx context.Context, in *ResourceByID, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	Delete(ctx context.Context, in *DeleteClusterRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// BulkDelete deletes several clusters at once.
+	BulkDelete(ctx context.Context, in *BulkDeleteRequest, opts ...grpc.CallOption) (*BulkResponse, error)
+	// BulkLifespan updates the lifespan of several clusters at once.
+	BulkLifespan(ctx context.Context, in *BulkLifespanRequest, opts ...grpc.CallOption) (*BulkResponse, error)
 	// Logs returns the logs for a specific cluster.
-	Logs(ctx context.Context, in *ResourceByID, opts ...grpc.CallOption) (*LogsResponse, error)
+	Logs(ctx context.Context, in *ClusterRequest, opts ...grpc.CallOption) (*LogsResponse, error)
+	// Watch streams the current state of a specific cluster, followed by an
+	// update every time its status, lifespan, URL or connect command changes.
+	Watch(ctx context.Context, in *ResourceByID, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Cluster], error)
+	// StreamLogs follows the logs for a specific cluster, as they are
+	// produced by each step of the workflow.
+	StreamLogs(ctx context.Context, in *StreamLogsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[LogChunk], error)
 }
 
 type clusterServiceClient struct {
@@ -494,7 +690,7 @@ func NewClusterServiceClient(cc grpc.ClientConnInterface) ClusterServiceClient {
 	return &clusterServiceClient{cc}
 }
 
-func (c *clusterServiceClient) Info(ctx context.Context, in *ResourceByID, opts ...grpc.CallOption) (*Cluster, error) {
+func (c *clusterServiceClient) Info(ctx context.Context, in *ClusterRequest, opts ...grpc.CallOption) (*Cluster, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(Cluster)
 	err := c.cc.Invoke(ctx, ClusterService_Info_FullMethodName, in, out, cOpts...)
@@ -524,6 +720,26 @@ func (c *clusterServiceClient) Lifespan(ctx context.Context, in *LifespanRequest
 	return out, nil
 }
 
+func (c *clusterServiceClient) UpdateOwnership(ctx context.Context, in *UpdateOwnershipRequest, opts ...grpc.CallOption) (*Cluster, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Cluster)
+	err := c.cc.Invoke(ctx, ClusterService_UpdateOwnership_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterServiceClient) UpdateLabels(ctx context.Context, in *UpdateLabelsRequest, opts ...grpc.CallOption) (*Cluster, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Cluster)
+	err := c.cc.Invoke(ctx, ClusterService_UpdateLabels_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *clusterServiceClient) Create(ctx context.Context, in *CreateClusterRequest, opts ...grpc.CallOption) (*ResourceByID, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ResourceByID)
@@ -534,7 +750,27 @@ func (c *clusterServiceClient) Create(ctx context.Context, in *CreateClusterRequ
 	return out, nil
 }
 
-func (c *clusterServiceClient) Artifacts(ctx context.Context, in *ResourceByID, opts ...grpc.CallOption) (*ClusterArtifacts, error) {
+func (c *clusterServiceClient) Clone(ctx context.Context, in *CloneClusterRequest, opts ...grpc.CallOption) (*CloneClusterResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CloneClusterResponse)
+	err := c.cc.Invoke(ctx, ClusterService_Clone_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterServiceClient) Retry(ctx context.Context, in *ResourceByID, opts ...grpc.CallOption) (*ResourceByID, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResourceByID)
+	err := c.cc.Invoke(ctx, ClusterService_Retry_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterServiceClient) Artifacts(ctx context.Context, in *ClusterRequest, opts ...grpc.CallOption) (*ClusterArtifacts, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ClusterArtifacts)
 	err := c.cc.Invoke(ctx, ClusterService_Artifacts_FullMethodName, in, out, cOpts...)
@@ -544,7 +780,17 @@ func (c *clusterServiceClient) Artifacts(ctx context.Context, in *ResourceByID,
 	return out, nil
 }
 
-func (c *clusterServiceClient) Delete(ctx context.Context, in *ResourceByID, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+func (c *clusterServiceClient) History(ctx context.Context, in *ResourceByID, opts ...grpc.CallOption) (*ClusterHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ClusterHistoryResponse)
+	err := c.cc.Invoke(ctx, ClusterService_History_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterServiceClient) Delete(ctx context.Context, in *DeleteClusterRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, ClusterService_Delete_FullMethodName, in, out, cOpts...)
@@ -554,7 +800,27 @@ func (c *clusterServiceClient) Delete(ctx context.Context, in *ResourceByID, opt
 	return out, nil
 }
 
-func (c *clusterServiceClient) Logs(ctx context.Context, in *ResourceByID, opts ...grpc.CallOption) (*LogsResponse, error) {
+func (c *clusterServiceClient) BulkDelete(ctx context.Context, in *BulkDeleteRequest, opts ...grpc.CallOption) (*BulkResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BulkResponse)
+	err := c.cc.Invoke(ctx, ClusterService_BulkDelete_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterServiceClient) BulkLifespan(ctx context.Context, in *BulkLifespanRequest, opts ...grpc.CallOption) (*BulkResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BulkResponse)
+	err := c.cc.Invoke(ctx, ClusterService_BulkLifespan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterServiceClient) Logs(ctx context.Context, in *ClusterRequest, opts ...grpc.CallOption) (*LogsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(LogsResponse)
 	err := c.cc.Invoke(ctx, ClusterService_Logs_FullMethodName, in, out, cOpts...)
@@ -564,6 +830,44 @@ func (c *clusterServiceClient) Logs(ctx context.Context, in *ResourceByID, opts
 	return out, nil
 }
 
+func (c *clusterServiceClient) Watch(ctx context.Context, in *ResourceByID, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Cluster], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ClusterService_ServiceDesc.Streams[0], ClusterService_Watch_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ResourceByID, Cluster]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ClusterService_WatchClient = grpc.ServerStreamingClient[Cluster]
+
+func (c *clusterServiceClient) StreamLogs(ctx context.Context, in *StreamLogsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[LogChunk], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ClusterService_ServiceDesc.Streams[1], ClusterService_StreamLogs_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[StreamLogsRequest, LogChunk]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ClusterService_StreamLogsClient = grpc.ServerStreamingClient[LogChunk]
+
 // ClusterServiceServer is the server API for ClusterService service.
 // All implementations must embed UnimplementedClusterServiceServer
 // for forward compatibility.
@@ -571,19 +875,44 @@ func (c *clusterServiceClient) Logs(ctx context.Context, in *ResourceByID, opts
 // FlavorService provides flavor based functionality.
 type ClusterServiceServer interface {
 	// Info provides information about a specific cluster.
-	Info(context.Context, *ResourceByID) (*Cluster, error)
+	Info(context.Context, *ClusterRequest) (*Cluster, error)
 	// List provides information about the available clusters.
 	List(context.Context, *ClusterListRequest) (*ClusterListResponse, error)
 	// Lifespan updates the lifespan for a specific cluster.
 	Lifespan(context.Context, *LifespanRequest) (*durationpb.Duration, error)
+	// UpdateOwnership transfers a specific cluster to a new owner, and/or
+	// updates its collaborators.
+	UpdateOwnership(context.Context, *UpdateOwnershipRequest) (*Cluster, error)
+	// UpdateLabels adds, replaces and removes the user-defined labels of a
+	// specific cluster.
+	UpdateLabels(context.Context, *UpdateLabelsRequest) (*Cluster, error)
 	// Create launches a new cluster.
 	Create(context.Context, *CreateClusterRequest) (*ResourceByID, error)
+	// Clone launches a new cluster with the flavor, parameters, description
+	// and Slack preferences of an existing cluster.
+	Clone(context.Context, *CloneClusterRequest) (*CloneClusterResponse, error)
+	// Retry resumes a failed cluster from its failed steps, instead of
+	// provisioning it again from scratch.
+	Retry(context.Context, *ResourceByID) (*ResourceByID, error)
 	// Artifacts returns the artifacts for a specific cluster.
-	Artifacts(context.Context, *ResourceByID) (*ClusterArtifacts, error)
+	Artifacts(context.Context, *ClusterRequest) (*ClusterArtifacts, error)
+	// History provides information about every generation of a cluster, as
+	// a cluster ID may be reused once an earlier cluster failed or finished.
+	History(context.Context, *ResourceByID) (*ClusterHistoryResponse, error)
 	// Delete deletes an existing cluster.
-	Delete(context.Context, *ResourceByID) (*emptypb.Empty, error)
+	Delete(context.Context, *DeleteClusterRequest) (*emptypb.Empty, error)
+	// BulkDelete deletes several clusters at once.
+	BulkDelete(context.Context, *BulkDeleteRequest) (*BulkResponse, error)
+	// BulkLifespan updates the lifespan of several clusters at once.
+	BulkLifespan(context.Context, *BulkLifespanRequest) (*BulkResponse, error)
 	// Logs returns the logs for a specific cluster.
-	Logs(context.Context, *ResourceByID) (*LogsResponse, error)
+	Logs(context.Context, *ClusterRequest) (*LogsResponse, error)
+	// Watch streams the current state of a specific cluster, followed by an
+	// update every time its status, lifespan, URL or connect command changes.
+	Watch(*ResourceByID, grpc.ServerStreamingServer[Cluster]) error
+	// StreamLogs follows the logs for a specific cluster, as they are
+	// produced by each step of the workflow.
+	StreamLogs(*StreamLogsRequest, grpc.ServerStreamingServer[LogChunk]) error
 	mustEmbedUnimplementedClusterServiceServer()
 }
 
@@ -594,7 +923,7 @@ type ClusterServiceServer interface {
 // pointer dereference when methods are called.
 type UnimplementedClusterServiceServer struct{}
 
-func (UnimplementedClusterServiceServer) Info(context.Context, *ResourceByID) (*Cluster, error) {
+func (UnimplementedClusterServiceServer) Info(context.Context, *ClusterRequest) (*Cluster, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Info not implemented")
 }
 func (UnimplementedClusterServiceServer) List(context.Context, *ClusterListRequest) (*ClusterListResponse, error) {
@@ -603,18 +932,45 @@ func (UnimplementedClusterServiceServer) List(context.Context, *ClusterListReque
 func (UnimplementedClusterServiceServer) Lifespan(context.Context, *LifespanRequest) (*durationpb.Duration, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Lifespan not implemented")
 }
+func (UnimplementedClusterServiceServer) UpdateOwnership(context.Context, *UpdateOwnershipRequest) (*Cluster, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateOwnership not implemented")
+}
+func (UnimplementedClusterServiceServer) UpdateLabels(context.Context, *UpdateLabelsRequest) (*Cluster, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateLabels not implemented")
+}
 func (UnimplementedClusterServiceServer) Create(context.Context, *CreateClusterRequest) (*ResourceByID, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
 }
-func (UnimplementedClusterServiceServer) Artifacts(context.Context, *ResourceByID) (*ClusterArtifacts, error) {
+func (UnimplementedClusterServiceServer) Clone(context.Context, *CloneClusterRequest) (*CloneClusterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Clone not implemented")
+}
+func (UnimplementedClusterServiceServer) Retry(context.Context, *ResourceByID) (*ResourceByID, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Retry not implemented")
+}
+func (UnimplementedClusterServiceServer) Artifacts(context.Context, *ClusterRequest) (*ClusterArtifacts, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Artifacts not implemented")
 }
-func (UnimplementedClusterServiceServer) Delete(context.Context, *ResourceByID) (*emptypb.Empty, error) {
+func (UnimplementedClusterServiceServer) History(context.Context, *ResourceByID) (*ClusterHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method History not implemented")
+}
+func (UnimplementedClusterServiceServer) Delete(context.Context, *DeleteClusterRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
 }
-func (UnimplementedClusterServiceServer) Logs(context.Context, *ResourceByID) (*LogsResponse, error) {
+func (UnimplementedClusterServiceServer) BulkDelete(context.Context, *BulkDeleteRequest) (*BulkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BulkDelete not implemented")
+}
+func (UnimplementedClusterServiceServer) BulkLifespan(context.Context, *BulkLifespanRequest) (*BulkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BulkLifespan not implemented")
+}
+func (UnimplementedClusterServiceServer) Logs(context.Context, *ClusterRequest) (*LogsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Logs not implemented")
 }
+func (UnimplementedClusterServiceServer) Watch(*ResourceByID, grpc.ServerStreamingServer[Cluster]) error {
+	return status.Errorf(codes.Unimplemented, "method Watch not implemented")
+}
+func (UnimplementedClusterServiceServer) StreamLogs(*StreamLogsRequest, grpc.ServerStreamingServer[LogChunk]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamLogs not implemented")
+}
 func (UnimplementedClusterServiceServer) mustEmbedUnimplementedClusterServiceServer() {}
 func (UnimplementedClusterServiceServer) testEmbeddedByValue()                        {}
 
@@ -637,7 +993,7 @@ func RegisterClusterServiceServer(s grpc.ServiceRegistrar, srv ClusterServiceSer
 }
 
 func _ClusterService_Info_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ResourceByID)
+	in := new(ClusterRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -649,7 +1005,7 @@ func _ClusterService_Info_Handler(srv interface{}, ctx context.Context, dec func
 		FullMethod: ClusterService_Info_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClusterServiceServer).Info(ctx, req.(*ResourceByID))
+		return srv.(ClusterServiceServer).Info(ctx, req.(*ClusterRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -690,6 +1046,42 @@ func _ClusterService_Lifespan_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ClusterService_UpdateOwnership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateOwnershipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterServiceServer).UpdateOwnership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClusterService_UpdateOwnership_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterServiceServer).UpdateOwnership(ctx, req.(*UpdateOwnershipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClusterService_UpdateLabels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateLabelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterServiceServer).UpdateLabels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClusterService_UpdateLabels_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterServiceServer).UpdateLabels(ctx, req.(*UpdateLabelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ClusterService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateClusterRequest)
 	if err := dec(in); err != nil {
@@ -708,11 +1100,47 @@ func _ClusterService_Create_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ClusterService_Artifacts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _ClusterService_Clone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloneClusterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterServiceServer).Clone(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClusterService_Clone_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterServiceServer).Clone(ctx, req.(*CloneClusterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClusterService_Retry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ResourceByID)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
+	if interceptor == nil {
+		return srv.(ClusterServiceServer).Retry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClusterService_Retry_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterServiceServer).Retry(ctx, req.(*ResourceByID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClusterService_Artifacts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClusterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
 	if interceptor == nil {
 		return srv.(ClusterServiceServer).Artifacts(ctx, in)
 	}
@@ -721,49 +1149,125 @@ func _ClusterService_Artifacts_Handler(srv interface{}, ctx context.Context, dec
 		FullMethod: ClusterService_Artifacts_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClusterServiceServer).Artifacts(ctx, req.(*ResourceByID))
+		return srv.(ClusterServiceServer).Artifacts(ctx, req.(*ClusterRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ClusterService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _ClusterService_History_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ResourceByID)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ClusterServiceServer).Delete(ctx, in)
+		return srv.(ClusterServiceServer).History(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ClusterService_Delete_FullMethodName,
+		FullMethod: ClusterService_History_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClusterServiceServer).Delete(ctx, req.(*ResourceByID))
+		return srv.(ClusterServiceServer).History(ctx, req.(*ResourceByID))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ClusterService_Logs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ResourceByID)
+func _ClusterService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteClusterRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ClusterServiceServer).Logs(ctx, in)
+		return srv.(ClusterServiceServer).Delete(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ClusterService_Logs_FullMethodName,
+		FullMethod: ClusterService_Delete_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ClusterServiceServer).Logs(ctx, req.(*ResourceByID))
+		return srv.(ClusterServiceServer).Delete(ctx, req.(*DeleteClusterRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-// ClusterService_ServiceDesc is the grpc.ServiceDesc for ClusterService service.
-// It's only intended for direct use with grpc.RegisterService,
+func _ClusterService_BulkDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkDeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterServiceServer).BulkDelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClusterService_BulkDelete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterServiceServer).BulkDelete(ctx, req.(*BulkDeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClusterService_BulkLifespan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkLifespanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterServiceServer).BulkLifespan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClusterService_BulkLifespan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterServiceServer).BulkLifespan(ctx, req.(*BulkLifespanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClusterService_Logs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClusterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterServiceServer).Logs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClusterService_Logs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterServiceServer).Logs(ctx, req.(*ClusterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClusterService_Watch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ResourceByID)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ClusterServiceServer).Watch(m, &grpc.GenericServerStream[ResourceByID, Cluster]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ClusterService_WatchServer = grpc.ServerStreamingServer[Cluster]
+
+func _ClusterService_StreamLogs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamLogsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ClusterServiceServer).StreamLogs(m, &grpc.GenericServerStream[StreamLogsRequest, LogChunk]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ClusterService_StreamLogsServer = grpc.ServerStreamingServer[LogChunk]
+
+// ClusterService_ServiceDesc is the grpc.ServiceDesc for ClusterService service.
+// It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
 var ClusterService_ServiceDesc = grpc.ServiceDesc{
 	ServiceName: "v1.ClusterService",
@@ -781,23 +1285,470 @@ var ClusterService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Lifespan",
 			Handler:    _ClusterService_Lifespan_Handler,
 		},
+		{
+			MethodName: "UpdateOwnership",
+			Handler:    _ClusterService_UpdateOwnership_Handler,
+		},
+		{
+			MethodName: "UpdateLabels",
+			Handler:    _ClusterService_UpdateLabels_Handler,
+		},
 		{
 			MethodName: "Create",
 			Handler:    _ClusterService_Create_Handler,
 		},
+		{
+			MethodName: "Clone",
+			Handler:    _ClusterService_Clone_Handler,
+		},
+		{
+			MethodName: "Retry",
+			Handler:    _ClusterService_Retry_Handler,
+		},
 		{
 			MethodName: "Artifacts",
 			Handler:    _ClusterService_Artifacts_Handler,
 		},
+		{
+			MethodName: "History",
+			Handler:    _ClusterService_History_Handler,
+		},
 		{
 			MethodName: "Delete",
 			Handler:    _ClusterService_Delete_Handler,
 		},
+		{
+			MethodName: "BulkDelete",
+			Handler:    _ClusterService_BulkDelete_Handler,
+		},
+		{
+			MethodName: "BulkLifespan",
+			Handler:    _ClusterService_BulkLifespan_Handler,
+		},
 		{
 			MethodName: "Logs",
 			Handler:    _ClusterService_Logs_Handler,
 		},
 	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Watch",
+			Handler:       _ClusterService_Watch_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamLogs",
+			Handler:       _ClusterService_StreamLogs_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "service.proto",
+}
+
+const (
+	ScheduleService_Create_FullMethodName = "/v1.ScheduleService/Create"
+	ScheduleService_List_FullMethodName   = "/v1.ScheduleService/List"
+	ScheduleService_Delete_FullMethodName = "/v1.ScheduleService/Delete"
+)
+
+// ScheduleServiceClient is the client API for ScheduleService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// ScheduleService provides scheduled cluster creation.
+type ScheduleServiceClient interface {
+	// Create registers a new schedule.
+	Create(ctx context.Context, in *Schedule, opts ...grpc.CallOption) (*Schedule, error)
+	// List provides information about the registered schedules.
+	List(ctx context.Context, in *ScheduleListRequest, opts ...grpc.CallOption) (*ScheduleListResponse, error)
+	// Delete removes an existing schedule. Clusters that were already created
+	// by the schedule are not affected.
+	Delete(ctx context.Context, in *ResourceByID, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type scheduleServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewScheduleServiceClient(cc grpc.ClientConnInterface) ScheduleServiceClient {
+	return &scheduleServiceClient{cc}
+}
+
+func (c *scheduleServiceClient) Create(ctx context.Context, in *Schedule, opts ...grpc.CallOption) (*Schedule, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Schedule)
+	err := c.cc.Invoke(ctx, ScheduleService_Create_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scheduleServiceClient) List(ctx context.Context, in *ScheduleListRequest, opts ...grpc.CallOption) (*ScheduleListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ScheduleListResponse)
+	err := c.cc.Invoke(ctx, ScheduleService_List_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scheduleServiceClient) Delete(ctx context.Context, in *ResourceByID, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ScheduleService_Delete_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ScheduleServiceServer is the server API for ScheduleService service.
+// All implementations must embed UnimplementedScheduleServiceServer
+// for forward compatibility.
+//
+// ScheduleService provides scheduled cluster creation.
+type ScheduleServiceServer interface {
+	// Create registers a new schedule.
+	Create(context.Context, *Schedule) (*Schedule, error)
+	// List provides information about the registered schedules.
+	List(context.Context, *ScheduleListRequest) (*ScheduleListResponse, error)
+	// Delete removes an existing schedule. Clusters that were already created
+	// by the schedule are not affected.
+	Delete(context.Context, *ResourceByID) (*emptypb.Empty, error)
+	mustEmbedUnimplementedScheduleServiceServer()
+}
+
+// UnimplementedScheduleServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedScheduleServiceServer struct{}
+
+func (UnimplementedScheduleServiceServer) Create(context.Context, *Schedule) (*Schedule, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+}
+func (UnimplementedScheduleServiceServer) List(context.Context, *ScheduleListRequest) (*ScheduleListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (UnimplementedScheduleServiceServer) Delete(context.Context, *ResourceByID) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (UnimplementedScheduleServiceServer) mustEmbedUnimplementedScheduleServiceServer() {}
+func (UnimplementedScheduleServiceServer) testEmbeddedByValue()                         {}
+
+// UnsafeScheduleServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ScheduleServiceServer will
+// result in compilation errors.
+type UnsafeScheduleServiceServer interface {
+	mustEmbedUnimplementedScheduleServiceServer()
+}
+
+func RegisterScheduleServiceServer(s grpc.ServiceRegistrar, srv ScheduleServiceServer) {
+	// If the following call pancis, it indicates UnimplementedScheduleServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ScheduleService_ServiceDesc, srv)
+}
+
+func _ScheduleService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Schedule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScheduleServiceServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScheduleService_Create_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScheduleServiceServer).Create(ctx, req.(*Schedule))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScheduleService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScheduleListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScheduleServiceServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScheduleService_List_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScheduleServiceServer).List(ctx, req.(*ScheduleListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScheduleService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResourceByID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScheduleServiceServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScheduleService_Delete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScheduleServiceServer).Delete(ctx, req.(*ResourceByID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ScheduleService_ServiceDesc is the grpc.ServiceDesc for ScheduleService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ScheduleService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "v1.ScheduleService",
+	HandlerType: (*ScheduleServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _ScheduleService_Create_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _ScheduleService_List_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _ScheduleService_Delete_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "service.proto",
+}
+
+const (
+	QuotaService_Get_FullMethodName = "/v1.QuotaService/Get"
+)
+
+// QuotaServiceClient is the client API for QuotaService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// QuotaService provides information about cluster quotas.
+type QuotaServiceClient interface {
+	// Get reports the current usage against the configured quota limits.
+	Get(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*QuotaResponse, error)
+}
+
+type quotaServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewQuotaServiceClient(cc grpc.ClientConnInterface) QuotaServiceClient {
+	return &quotaServiceClient{cc}
+}
+
+func (c *quotaServiceClient) Get(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*QuotaResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QuotaResponse)
+	err := c.cc.Invoke(ctx, QuotaService_Get_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QuotaServiceServer is the server API for QuotaService service.
+// All implementations must embed UnimplementedQuotaServiceServer
+// for forward compatibility.
+//
+// QuotaService provides information about cluster quotas.
+type QuotaServiceServer interface {
+	// Get reports the current usage against the configured quota limits.
+	Get(context.Context, *emptypb.Empty) (*QuotaResponse, error)
+	mustEmbedUnimplementedQuotaServiceServer()
+}
+
+// UnimplementedQuotaServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedQuotaServiceServer struct{}
+
+func (UnimplementedQuotaServiceServer) Get(context.Context, *emptypb.Empty) (*QuotaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (UnimplementedQuotaServiceServer) mustEmbedUnimplementedQuotaServiceServer() {}
+func (UnimplementedQuotaServiceServer) testEmbeddedByValue()                      {}
+
+// UnsafeQuotaServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to QuotaServiceServer will
+// result in compilation errors.
+type UnsafeQuotaServiceServer interface {
+	mustEmbedUnimplementedQuotaServiceServer()
+}
+
+func RegisterQuotaServiceServer(s grpc.ServiceRegistrar, srv QuotaServiceServer) {
+	// If the following call pancis, it indicates UnimplementedQuotaServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&QuotaService_ServiceDesc, srv)
+}
+
+func _QuotaService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuotaServiceServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuotaService_Get_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuotaServiceServer).Get(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// QuotaService_ServiceDesc is the grpc.ServiceDesc for QuotaService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var QuotaService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "v1.QuotaService",
+	HandlerType: (*QuotaServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Get",
+			Handler:    _QuotaService_Get_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "service.proto",
+}
+
+const (
+	AuditService_List_FullMethodName = "/v1.AuditService/List"
+)
+
+// AuditServiceClient is the client API for AuditService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// AuditService provides the persisted audit events.
+type AuditServiceClient interface {
+	// List returns the audit events visible to the caller. Admins see every
+	// event, others see their own operations and those on their clusters.
+	List(ctx context.Context, in *AuditListRequest, opts ...grpc.CallOption) (*AuditListResponse, error)
+}
+
+type auditServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAuditServiceClient(cc grpc.ClientConnInterface) AuditServiceClient {
+	return &auditServiceClient{cc}
+}
+
+func (c *auditServiceClient) List(ctx context.Context, in *AuditListRequest, opts ...grpc.CallOption) (*AuditListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AuditListResponse)
+	err := c.cc.Invoke(ctx, AuditService_List_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AuditServiceServer is the server API for AuditService service.
+// All implementations must embed UnimplementedAuditServiceServer
+// for forward compatibility.
+//
+// AuditService provides the persisted audit events.
+type AuditServiceServer interface {
+	// List returns the audit events visible to the caller. Admins see every
+	// event, others see their own operations and those on their clusters.
+	List(context.Context, *AuditListRequest) (*AuditListResponse, error)
+	mustEmbedUnimplementedAuditServiceServer()
+}
+
+// UnimplementedAuditServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAuditServiceServer struct{}
+
+func (UnimplementedAuditServiceServer) List(context.Context, *AuditListRequest) (*AuditListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (UnimplementedAuditServiceServer) mustEmbedUnimplementedAuditServiceServer() {}
+func (UnimplementedAuditServiceServer) testEmbeddedByValue()                      {}
+
+// UnsafeAuditServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AuditServiceServer will
+// result in compilation errors.
+type UnsafeAuditServiceServer interface {
+	mustEmbedUnimplementedAuditServiceServer()
+}
+
+func RegisterAuditServiceServer(s grpc.ServiceRegistrar, srv AuditServiceServer) {
+	// If the following call pancis, it indicates UnimplementedAuditServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&AuditService_ServiceDesc, srv)
+}
+
+func _AuditService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuditListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuditServiceServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuditService_List_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuditServiceServer).List(ctx, req.(*AuditListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AuditService_ServiceDesc is the grpc.ServiceDesc for AuditService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AuditService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "v1.AuditService",
+	HandlerType: (*AuditServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "List",
+			Handler:    _AuditService_List_Handler,
+		},
+	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "service.proto",
 }
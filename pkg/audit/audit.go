@@ -0,0 +1,255 @@
+// Package audit persists audit events, so that they can be listed after the
+// server logs have rotated away.
+package audit
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stackrox/infra/pkg/config"
+	"github.com/stackrox/infra/pkg/logging"
+)
+
+const (
+	// recordTimeout bounds how long recording a single event may take.
+	recordTimeout = 10 * time.Second
+
+	// recordBacklog is the number of events that are buffered while earlier
+	// events are still being recorded.
+	recordBacklog = 1000
+
+	// anonymousRecordLimit is the number of events without an actor, such as
+	// failed logins, that are recorded per anonymousRecordWindow. Anonymous
+	// callers cannot flush the recorded events this way.
+	anonymousRecordLimit = 20
+
+	// anonymousRecordWindow is the period that anonymousRecordLimit applies
+	// to.
+	anonymousRecordWindow = time.Minute
+)
+
+var log = logging.CreateProductionLogger()
+
+// Event represents a single audited operation.
+type Event struct {
+	// Time is when the operation was requested.
+	Time time.Time `json:"time"`
+
+	// Actor is the email of the user or service account that requested the
+	// operation.
+	Actor string `json:"actor,omitempty"`
+
+	// Phase is the kind of operation, e.g. "cluster-delete".
+	Phase string `json:"phase"`
+
+	// ClusterID is the ID of the affected cluster, if any.
+	ClusterID string `json:"clusterID,omitempty"`
+
+	// Message is a human readable description of the operation.
+	Message string `json:"message"`
+
+	// Details are the remaining audit log fields.
+	Details map[string]string `json:"details,omitempty"`
+}
+
+// NewEvent creates an event at the current time from the arguments to
+// logging.Logger.AuditLog. The "actor" and "cluster-id" fields are extracted
+// from the keys and values.
+func NewEvent(phase string, msg string, keysAndValues ...interface{}) Event {
+	event := Event{
+		Time:    time.Now().UTC(),
+		Phase:   phase,
+		Message: msg,
+	}
+
+	for i := 0; i+1 < len(keysAndValues); i += 2 {
+		key := fmt.Sprint(keysAndValues[i])
+		value := fmt.Sprint(keysAndValues[i+1])
+		switch key {
+		case "actor":
+			event.Actor = value
+		case "cluster-id":
+			event.ClusterID = value
+		default:
+			if event.Details == nil {
+				event.Details = make(map[string]string)
+			}
+			event.Details[key] = value
+		}
+	}
+
+	return event
+}
+
+// Filter selects audit events. Empty fields match every event.
+type Filter struct {
+	Actor     string
+	ClusterID string
+	Phase     string
+
+	// Since and Until bound the time of the events, inclusively.
+	Since time.Time
+	Until time.Time
+
+	// VisibleTo restricts the events to those visible to a caller, when set.
+	VisibleTo *Visibility
+
+	// Limit is the maximum number of events to return. Zero means no limit.
+	Limit int
+}
+
+// Visibility selects the events of the operations by an actor, and of the
+// operations on the given clusters.
+type Visibility struct {
+	Actor      string
+	ClusterIDs []string
+}
+
+// Matches reports whether the given event is visible.
+func (v *Visibility) Matches(event Event) bool {
+	return event.Actor == v.Actor || (event.ClusterID != "" && slices.Contains(v.ClusterIDs, event.ClusterID))
+}
+
+// Matches reports whether the given event is selected by the filter,
+// ignoring the limit.
+func (f Filter) Matches(event Event) bool {
+	switch {
+	case f.Actor != "" && event.Actor != f.Actor:
+		return false
+	case f.ClusterID != "" && event.ClusterID != f.ClusterID:
+		return false
+	case f.Phase != "" && event.Phase != f.Phase:
+		return false
+	case !f.Since.IsZero() && event.Time.Before(f.Since):
+		return false
+	case !f.Until.IsZero() && event.Time.After(f.Until):
+		return false
+	case f.VisibleTo != nil && !f.VisibleTo.Matches(event):
+		return false
+	default:
+		return true
+	}
+}
+
+// apply returns the events selected by the filter, most recent first.
+func (f Filter) apply(events []Event) []Event {
+	var selected []Event
+	for _, event := range events {
+		if f.Matches(event) {
+			selected = append(selected, event)
+		}
+	}
+
+	sort.SliceStable(selected, func(i, j int) bool {
+		return selected[i].Time.After(selected[j].Time)
+	})
+
+	if f.Limit > 0 && len(selected) > f.Limit {
+		selected = selected[:f.Limit]
+	}
+
+	return selected
+}
+
+// Sink persists audit events.
+type Sink interface {
+	// Record persists the given event.
+	Record(ctx context.Context, event Event) error
+
+	// List returns the persisted events selected by the given filter, most
+	// recent first.
+	List(ctx context.Context, filter Filter) ([]Event, error)
+}
+
+// New creates the sink described by the given configuration. A ConfigMap
+// sink is created when no configuration is given.
+func New(cfg *config.AuditConfig) (Sink, error) {
+	if cfg == nil {
+		cfg = &config.AuditConfig{}
+	}
+
+	switch cfg.Type {
+	case "", "configmap":
+		return NewConfigMap(cfg.ConfigMap)
+	case "file":
+		if cfg.File == nil {
+			return nil, errors.New("file audit sink requires file configuration")
+		}
+		return NewFile(*cfg.File)
+	case "bigquery":
+		if cfg.BigQuery == nil {
+			return nil, errors.New("bigquery audit sink requires bigQuery configuration")
+		}
+		return NewBigQuery(*cfg.BigQuery)
+	default:
+		return nil, errors.Errorf("unknown audit sink type %q", cfg.Type)
+	}
+}
+
+// NewRecorder returns a logging.AuditRecorder that records every audit event
+// to the given sink in the background, so that audited operations are not
+// slowed down by the sink. Events are dropped when the sink falls behind, and
+// events without an actor are dropped beyond anonymousRecordLimit. Dropped
+// events are still logged.
+func NewRecorder(sink Sink) logging.AuditRecorder {
+	events := make(chan Event, recordBacklog)
+	anonymous := newWindowLimiter(anonymousRecordLimit, anonymousRecordWindow)
+
+	go func() {
+		for event := range events {
+			ctx, cancel := context.WithTimeout(context.Background(), recordTimeout)
+			if err := sink.Record(ctx, event); err != nil {
+				log.Log(logging.ERROR, "failed to record audit event", "phase", event.Phase, "error", err)
+			}
+			cancel()
+		}
+	}()
+
+	return func(phase string, msg string, keysAndValues ...interface{}) {
+		event := NewEvent(phase, msg, keysAndValues...)
+		if event.Actor == "" && !anonymous.allow(event.Time) {
+			return
+		}
+
+		select {
+		case events <- event:
+		default:
+			log.Log(logging.WARN, "dropped audit event because the sink is behind", "phase", phase)
+		}
+	}
+}
+
+// windowLimiter allows a limited number of events per fixed time window.
+type windowLimiter struct {
+	limit  int
+	window time.Duration
+
+	lock  sync.Mutex
+	start time.Time
+	count int
+}
+
+func newWindowLimiter(limit int, window time.Duration) *windowLimiter {
+	return &windowLimiter{limit: limit, window: window}
+}
+
+// allow reports whether an event at the given time is within the limit.
+func (l *windowLimiter) allow(now time.Time) bool {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	if now.Sub(l.start) >= l.window {
+		l.start = now
+		l.count = 0
+	}
+	if l.count >= l.limit {
+		return false
+	}
+	l.count++
+	return true
+}
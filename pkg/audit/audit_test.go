@@ -0,0 +1,190 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stackrox/infra/pkg/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestNewEvent(t *testing.T) {
+	event := NewEvent("cluster-lifespan", "received a lifespan update request for infra cluster",
+		"actor", "jane@example.com",
+		"cluster-id", "example",
+		"lifespan", 3*time.Hour,
+	)
+
+	assert.Equal(t, "cluster-lifespan", event.Phase)
+	assert.Equal(t, "received a lifespan update request for infra cluster", event.Message)
+	assert.Equal(t, "jane@example.com", event.Actor)
+	assert.Equal(t, "example", event.ClusterID)
+	assert.Equal(t, map[string]string{"lifespan": "3h0m0s"}, event.Details)
+	assert.WithinDuration(t, time.Now(), event.Time, time.Minute)
+}
+
+func TestFilter(t *testing.T) {
+	now := time.Now()
+	events := []Event{
+		{Time: now.Add(-3 * time.Hour), Actor: "a@example.com", Phase: "cluster-create", ClusterID: "one"},
+		{Time: now.Add(-2 * time.Hour), Actor: "b@example.com", Phase: "cluster-lifespan", ClusterID: "one"},
+		{Time: now.Add(-1 * time.Hour), Actor: "a@example.com", Phase: "cluster-delete", ClusterID: "two"},
+		{Time: now, Actor: "b@example.com", Phase: "infra-status"},
+	}
+
+	tests := []struct {
+		name     string
+		filter   Filter
+		expected []int
+	}{
+		{
+			name:     "everything, most recent first",
+			filter:   Filter{},
+			expected: []int{3, 2, 1, 0},
+		},
+		{
+			name:     "by actor",
+			filter:   Filter{Actor: "a@example.com"},
+			expected: []int{2, 0},
+		},
+		{
+			name:     "by cluster and phase",
+			filter:   Filter{ClusterID: "one", Phase: "cluster-lifespan"},
+			expected: []int{1},
+		},
+		{
+			name:     "by time range",
+			filter:   Filter{Since: now.Add(-2 * time.Hour), Until: now.Add(-time.Hour)},
+			expected: []int{2, 1},
+		},
+		{
+			name:     "limited",
+			filter:   Filter{Limit: 2},
+			expected: []int{3, 2},
+		},
+		{
+			name:     "no match",
+			filter:   Filter{ClusterID: "three"},
+			expected: []int{},
+		},
+		{
+			name:     "visible to an actor owning a cluster",
+			filter:   Filter{VisibleTo: &Visibility{Actor: "b@example.com", ClusterIDs: []string{"two"}}, Limit: 2},
+			expected: []int{3, 2},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			expected := []Event{}
+			for _, i := range tt.expected {
+				expected = append(expected, events[i])
+			}
+			assert.Equal(t, expected, append([]Event{}, tt.filter.apply(events)...))
+		})
+	}
+}
+
+func testSink(t *testing.T, sink Sink) {
+	ctx := context.Background()
+
+	events, err := sink.List(ctx, Filter{})
+	require.NoError(t, err)
+	assert.Empty(t, events)
+
+	first := NewEvent("cluster-create", "created", "actor", "a@example.com", "cluster-id", "one")
+	second := NewEvent("cluster-delete", "deleted", "actor", "b@example.com", "cluster-id", "one", "force", true)
+	second.Time = first.Time.Add(time.Second)
+	require.NoError(t, sink.Record(ctx, first))
+	require.NoError(t, sink.Record(ctx, second))
+
+	events, err = sink.List(ctx, Filter{})
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+	assert.Equal(t, "cluster-delete", events[0].Phase)
+	assert.Equal(t, map[string]string{"force": "true"}, events[0].Details)
+	assert.True(t, first.Time.Equal(events[1].Time))
+
+	events, err = sink.List(ctx, Filter{Actor: "a@example.com"})
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	assert.Equal(t, "cluster-create", events[0].Phase)
+}
+
+func TestFileSink(t *testing.T) {
+	sink, err := NewFile(config.AuditFileConfig{Path: filepath.Join(t.TempDir(), "audit", "events.jsonl")})
+	require.NoError(t, err)
+	testSink(t, sink)
+}
+
+func TestConfigMapSink(t *testing.T) {
+	client := fake.NewClientset().CoreV1().ConfigMaps("infra")
+	testSink(t, newConfigMapSink(client, "", 0))
+}
+
+func TestConfigMapSinkRingBuffer(t *testing.T) {
+	ctx := context.Background()
+	client := fake.NewClientset().CoreV1().ConfigMaps("infra")
+	sink := newConfigMapSink(client, "audit", 3)
+
+	for _, phase := range []string{"one", "two", "three", "four", "five"} {
+		require.NoError(t, sink.Record(ctx, NewEvent(phase, "message")))
+	}
+
+	events, err := sink.List(ctx, Filter{})
+	require.NoError(t, err)
+
+	var phases []string
+	for _, event := range events {
+		phases = append(phases, event.Phase)
+	}
+	assert.ElementsMatch(t, []string{"three", "four", "five"}, phases)
+}
+
+func TestConfigMapSinkMaxBytes(t *testing.T) {
+	ctx := context.Background()
+	client := fake.NewClientset().CoreV1().ConfigMaps("infra")
+	sink := newConfigMapSink(client, "audit", 100)
+
+	line, err := json.Marshal(NewEvent("one", "message"))
+	require.NoError(t, err)
+	sink.maxBytes = 3 * (len(line) + 1)
+
+	for _, phase := range []string{"one", "two", "six", "ten"} {
+		require.NoError(t, sink.Record(ctx, NewEvent(phase, "message")))
+	}
+
+	configMap, err := client.Get(ctx, "audit", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.LessOrEqual(t, len(configMap.Data[configMapEventsKey]), sink.maxBytes)
+
+	events, err := sink.List(ctx, Filter{})
+	require.NoError(t, err)
+	var phases []string
+	for _, event := range events {
+		phases = append(phases, event.Phase)
+	}
+	assert.ElementsMatch(t, []string{"two", "six", "ten"}, phases)
+
+	// Events that could never fit are rejected.
+	assert.Error(t, sink.Record(ctx, NewEvent("large", strings.Repeat("x", sink.maxBytes))))
+}
+
+func TestWindowLimiter(t *testing.T) {
+	now := time.Now()
+	limiter := newWindowLimiter(2, time.Minute)
+
+	assert.True(t, limiter.allow(now))
+	assert.True(t, limiter.allow(now.Add(time.Second)))
+	assert.False(t, limiter.allow(now.Add(2*time.Second)))
+
+	// The next window starts over.
+	assert.True(t, limiter.allow(now.Add(time.Minute)))
+}
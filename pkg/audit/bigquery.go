@@ -0,0 +1,144 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"cloud.google.com/go/bigquery"
+	"github.com/pkg/errors"
+	"github.com/stackrox/infra/pkg/config"
+	"google.golang.org/api/iterator"
+	"google.golang.org/api/option"
+)
+
+// BigQuerySink inserts audit events into a Google BigQuery table.
+type BigQuerySink struct {
+	client   *bigquery.Client
+	inserter *bigquery.Inserter
+	table    string
+}
+
+var _ Sink = (*BigQuerySink)(nil)
+
+// bigQueryRecord is the schema of the audit events table. Details are stored
+// as a JSON object.
+type bigQueryRecord struct {
+	Time      bigquery.NullTimestamp `bigquery:"Time"`
+	Actor     string                 `bigquery:"Actor"`
+	Phase     string                 `bigquery:"Phase"`
+	ClusterID string                 `bigquery:"ClusterID"`
+	Message   string                 `bigquery:"Message"`
+	Details   string                 `bigquery:"Details"`
+}
+
+// NewBigQuery creates a BigQuerySink.
+func NewBigQuery(cfg config.AuditBigQueryConfig) (*BigQuerySink, error) {
+	if cfg.CredentialsFile == "" || cfg.Project == "" || cfg.Dataset == "" || cfg.Table == "" {
+		return nil, errors.New("malformed BigQuery audit config: all of credentialsFile, project, dataset, table must be defined")
+	}
+
+	client, err := bigquery.NewClient(context.Background(), cfg.Project, option.WithAuthCredentialsFile(option.ServiceAccount, cfg.CredentialsFile))
+	if err != nil {
+		return nil, errors.Wrap(err, "creating BigQuery client")
+	}
+
+	return &BigQuerySink{
+		client:   client,
+		inserter: client.Dataset(cfg.Dataset).Table(cfg.Table).Inserter(),
+		table:    fmt.Sprintf("`%s.%s.%s`", cfg.Project, cfg.Dataset, cfg.Table),
+	}, nil
+}
+
+// Record implements Sink.Record.
+func (s *BigQuerySink) Record(ctx context.Context, event Event) error {
+	details, err := json.Marshal(event.Details)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal audit event details")
+	}
+
+	return s.inserter.Put(ctx, &bigQueryRecord{
+		Time:      bigquery.NullTimestamp{Timestamp: event.Time, Valid: true},
+		Actor:     event.Actor,
+		Phase:     event.Phase,
+		ClusterID: event.ClusterID,
+		Message:   event.Message,
+		Details:   string(details),
+	})
+}
+
+// List implements Sink.List.
+func (s *BigQuerySink) List(ctx context.Context, filter Filter) ([]Event, error) {
+	var conditions []string
+	var params []bigquery.QueryParameter
+	addCondition := func(condition string, name string, value interface{}) {
+		conditions = append(conditions, condition)
+		params = append(params, bigquery.QueryParameter{Name: name, Value: value})
+	}
+
+	if filter.Actor != "" {
+		addCondition("Actor = @actor", "actor", filter.Actor)
+	}
+	if filter.ClusterID != "" {
+		addCondition("ClusterID = @cluster", "cluster", filter.ClusterID)
+	}
+	if filter.Phase != "" {
+		addCondition("Phase = @phase", "phase", filter.Phase)
+	}
+	if !filter.Since.IsZero() {
+		addCondition("Time >= @since", "since", filter.Since)
+	}
+	if !filter.Until.IsZero() {
+		addCondition("Time <= @until", "until", filter.Until)
+	}
+	if filter.VisibleTo != nil {
+		conditions = append(conditions, "(Actor = @visibleActor OR ClusterID IN UNNEST(@visibleClusters))")
+		params = append(params,
+			bigquery.QueryParameter{Name: "visibleActor", Value: filter.VisibleTo.Actor},
+			bigquery.QueryParameter{Name: "visibleClusters", Value: append([]string{}, filter.VisibleTo.ClusterIDs...)},
+		)
+	}
+
+	sql := "SELECT Time, Actor, Phase, ClusterID, Message, Details FROM " + s.table
+	if len(conditions) > 0 {
+		sql += " WHERE " + strings.Join(conditions, " AND ")
+	}
+	sql += " ORDER BY Time DESC"
+	if filter.Limit > 0 {
+		sql += fmt.Sprintf(" LIMIT %d", filter.Limit)
+	}
+
+	query := s.client.Query(sql)
+	query.Parameters = params
+	rows, err := query.Read(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to query audit events")
+	}
+
+	var events []Event
+	for {
+		var record bigQueryRecord
+		err := rows.Next(&record)
+		if errors.Is(err, iterator.Done) {
+			break
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to read audit events")
+		}
+
+		event := Event{
+			Time:      record.Time.Timestamp,
+			Actor:     record.Actor,
+			Phase:     record.Phase,
+			ClusterID: record.ClusterID,
+			Message:   record.Message,
+		}
+		if record.Details != "" {
+			_ = json.Unmarshal([]byte(record.Details), &event.Details)
+		}
+		events = append(events, event)
+	}
+
+	return events, nil
+}
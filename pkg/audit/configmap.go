@@ -0,0 +1,172 @@
+package audit
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/stackrox/infra/pkg/config"
+	"github.com/stackrox/infra/pkg/kube"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sv1 "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/util/retry"
+)
+
+const (
+	defaultConfigMapNamespace = "infra"
+	defaultConfigMapName      = "audit"
+	defaultConfigMapMaxEvents = 1000
+
+	// configMapMaxBytes is the size that the stored events are trimmed to,
+	// which leaves room below the 1 MiB limit of Kubernetes objects.
+	configMapMaxBytes = 900 * 1024
+
+	// configMapEventsKey is the ConfigMap key under which the events are
+	// stored as JSON lines, oldest first.
+	configMapEventsKey = "events"
+)
+
+// ConfigMapSink keeps the most recent audit events in a ConfigMap.
+type ConfigMapSink struct {
+	client    k8sv1.ConfigMapInterface
+	name      string
+	maxEvents int
+	maxBytes  int
+}
+
+var _ Sink = (*ConfigMapSink)(nil)
+
+// NewConfigMap creates a ConfigMapSink. Defaults are used for a missing
+// configuration.
+func NewConfigMap(cfg *config.AuditConfigMapConfig) (*ConfigMapSink, error) {
+	if cfg == nil {
+		cfg = &config.AuditConfigMapConfig{}
+	}
+
+	namespace := cfg.Namespace
+	if namespace == "" {
+		namespace = defaultConfigMapNamespace
+	}
+
+	client, err := kube.GetK8sConfigMapClient(namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	return newConfigMapSink(client, cfg.Name, cfg.MaxEvents), nil
+}
+
+func newConfigMapSink(client k8sv1.ConfigMapInterface, name string, maxEvents int) *ConfigMapSink {
+	if name == "" {
+		name = defaultConfigMapName
+	}
+	if maxEvents <= 0 {
+		maxEvents = defaultConfigMapMaxEvents
+	}
+
+	return &ConfigMapSink{
+		client:    client,
+		name:      name,
+		maxEvents: maxEvents,
+		maxBytes:  configMapMaxBytes,
+	}
+}
+
+// Record implements Sink.Record. The oldest events are dropped once the
+// maximum number of events or the maximum size is reached.
+func (s *ConfigMapSink) Record(ctx context.Context, event Event) error {
+	line, err := json.Marshal(event)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal audit event")
+	}
+	if len(line)+1 > s.maxBytes {
+		return errors.Errorf("audit event of %d bytes exceeds the maximum size of %d bytes", len(line)+1, s.maxBytes)
+	}
+
+	// Other replicas may record events concurrently.
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		configMap, err := s.client.Get(ctx, s.name, metav1.GetOptions{})
+		if k8serrors.IsNotFound(err) {
+			_, err = s.client.Create(ctx, &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: s.name},
+				Data:       map[string]string{configMapEventsKey: string(line) + "\n"},
+			}, metav1.CreateOptions{})
+			if k8serrors.IsAlreadyExists(err) {
+				// Retry as an update of the ConfigMap created meanwhile.
+				return k8serrors.NewConflict(corev1.Resource("configmaps"), s.name, err)
+			}
+			return err
+		}
+		if err != nil {
+			return err
+		}
+
+		lines := strings.SplitAfter(configMap.Data[configMapEventsKey], "\n")
+		if last := len(lines) - 1; lines[last] == "" {
+			lines = lines[:last]
+		}
+		lines = append(lines, string(line)+"\n")
+		if len(lines) > s.maxEvents {
+			lines = lines[len(lines)-s.maxEvents:]
+		}
+		size := 0
+		for _, line := range lines {
+			size += len(line)
+		}
+		for size > s.maxBytes {
+			size -= len(lines[0])
+			lines = lines[1:]
+		}
+
+		if configMap.Data == nil {
+			configMap.Data = make(map[string]string)
+		}
+		configMap.Data[configMapEventsKey] = strings.Join(lines, "")
+		_, err = s.client.Update(ctx, configMap, metav1.UpdateOptions{})
+		return err
+	})
+}
+
+// List implements Sink.List.
+func (s *ConfigMapSink) List(ctx context.Context, filter Filter) ([]Event, error) {
+	configMap, err := s.client.Get(ctx, s.name, metav1.GetOptions{})
+	if k8serrors.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	events, err := readEvents(strings.NewReader(configMap.Data[configMapEventsKey]))
+	if err != nil {
+		return nil, err
+	}
+
+	return filter.apply(events), nil
+}
+
+// readEvents reads audit events stored as JSON lines. Malformed lines are
+// skipped.
+func readEvents(r io.Reader) ([]Event, error) {
+	var events []Event
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(nil, 1024*1024)
+	for scanner.Scan() {
+		if len(bytes.TrimSpace(scanner.Bytes())) == 0 {
+			continue
+		}
+		var event Event
+		if err := json.Unmarshal(scanner.Bytes(), &event); err != nil {
+			continue
+		}
+		events = append(events, event)
+	}
+
+	return events, errors.Wrap(scanner.Err(), "failed to read audit events")
+}
@@ -0,0 +1,80 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/stackrox/infra/pkg/config"
+)
+
+// FileSink appends audit events to a local JSON lines file.
+type FileSink struct {
+	path string
+
+	// lock serializes access to the file from this server.
+	lock sync.Mutex
+}
+
+var _ Sink = (*FileSink)(nil)
+
+// NewFile creates a FileSink.
+func NewFile(cfg config.AuditFileConfig) (*FileSink, error) {
+	if cfg.Path == "" {
+		return nil, errors.New("file audit sink requires a path")
+	}
+
+	if err := os.MkdirAll(filepath.Dir(cfg.Path), 0o755); err != nil {
+		return nil, errors.Wrap(err, "failed to create audit log directory")
+	}
+
+	return &FileSink{path: cfg.Path}, nil
+}
+
+// Record implements Sink.Record.
+func (s *FileSink) Record(_ context.Context, event Event) error {
+	line, err := json.Marshal(event)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal audit event")
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	file, err := os.OpenFile(s.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return errors.Wrap(err, "failed to open audit log")
+	}
+
+	if _, err := file.Write(append(line, '\n')); err != nil {
+		_ = file.Close()
+		return errors.Wrap(err, "failed to write audit log")
+	}
+
+	return file.Close()
+}
+
+// List implements Sink.List.
+func (s *FileSink) List(_ context.Context, filter Filter) ([]Event, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	file, err := os.Open(s.path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to open audit log")
+	}
+	defer file.Close() //nolint:errcheck
+
+	events, err := readEvents(file)
+	if err != nil {
+		return nil, err
+	}
+
+	return filter.apply(events), nil
+}
@@ -7,6 +7,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"regexp"
+	"strconv"
 	"strings"
 
 	"github.com/jeremywohl/flatten/v2"
@@ -23,8 +24,45 @@ const (
 
 	// Equal defines claim check if value is equal.
 	Equal operation = "eq"
+
+	// Match defines claim check if value matches a regular expression
+	// anywhere, or any value of the slice does.
+	Match operation = "match"
+
+	// Exists defines claim check if the claim is present.
+	Exists operation = "exists"
+
+	// GreaterThan defines claim check if the numeric value is greater.
+	GreaterThan operation = "gt"
+
+	// GreaterOrEqual defines claim check if the numeric value is greater or
+	// equal.
+	GreaterOrEqual operation = "ge"
+
+	// LessThan defines claim check if the numeric value is less.
+	LessThan operation = "lt"
+
+	// LessOrEqual defines claim check if the numeric value is less or equal.
+	LessOrEqual operation = "le"
 )
 
+// operations are the supported operations.
+var operations = map[operation]bool{
+	In:             true,
+	Equal:          true,
+	Match:          true,
+	Exists:         true,
+	GreaterThan:    true,
+	GreaterOrEqual: true,
+	LessThan:       true,
+	LessOrEqual:    true,
+}
+
+// isNumeric determines if the operation compares numbers.
+func (op operation) isNumeric() bool {
+	return op == GreaterThan || op == GreaterOrEqual || op == LessThan || op == LessOrEqual
+}
+
 // UnmarshalJSON does validation of supported operations for claim rule.
 func (op *operation) UnmarshalJSON(b []byte) error {
 	var strOp string
@@ -34,7 +72,7 @@ func (op *operation) UnmarshalJSON(b []byte) error {
 	}
 
 	*op = operation(strOp)
-	if *op != In && *op != Equal {
+	if !operations[*op] {
 		return errors.Errorf("unsupported operation %q in claim rule", *op)
 	}
 
@@ -42,20 +80,168 @@ func (op *operation) UnmarshalJSON(b []byte) error {
 }
 
 // ClaimRule represents the configuration for checking access token claims.
+// A rule either checks a single claim, or groups other rules with AnyOf or
+// AllOf.
 type ClaimRule struct {
 	// Values is used to compare with retrieved value from token claims.
 	Value interface{} `json:"value"`
 
 	// Op represents defined operation for the claim rule that should be used
-	// during checking of token claims. It can be "eq" or "in".
+	// during checking of token claims.
 	// - "eq" is used to compare single value from token claims.
 	// - "in" is used to look if defined value is in the list of values for
 	//   defined token claim path.
+	// - "match" is used to look for the regular expression anywhere in the
+	//   single value, or in any of the list of values.
+	// - "exists" is used to check that the claim is present, regardless of
+	//   its value.
+	// - "gt", "ge", "lt" and "le" are used to compare a single numeric value.
 	Op operation `json:"op"`
 
 	// Path represent JSON path to specific key in the token claims. Nested
 	// fields are separated by '.'. i.e. "top_level.field.sub_field".
 	Path string `json:"path"`
+
+	// Not negates the rule, which is then fulfilled if the check or group is
+	// not.
+	Not bool `json:"not"`
+
+	// AnyOf is a group of rules, of which at least one has to be fulfilled.
+	AnyOf ClaimRules `json:"anyOf"`
+
+	// AllOf is a group of rules, which all have to be fulfilled.
+	AllOf ClaimRules `json:"allOf"`
+}
+
+var _ json.Unmarshaler = (*ClaimRule)(nil)
+
+// ruleError is an error of the claim rule at a path of indices and groups,
+// such as "1.anyOf.0".
+type ruleError struct {
+	path string
+	err  error
+}
+
+func (e *ruleError) Error() string {
+	return fmt.Sprintf("claim rule %s: %v", e.path, e.err)
+}
+
+func (e *ruleError) Unwrap() error {
+	return e.err
+}
+
+// groupError is the error of a rule group of which no rule is fulfilled. It
+// keeps the error of every rule of the group, with its path.
+type groupError struct {
+	reasons []error
+}
+
+func (e *groupError) Error() string {
+	reasons := make([]string, 0, len(e.reasons))
+	for _, reason := range e.reasons {
+		reasons = append(reasons, reason.Error())
+	}
+	return fmt.Sprintf("no rule of group is fulfilled: %s", strings.Join(reasons, "; "))
+}
+
+func (e *groupError) Unwrap() []error {
+	return e.reasons
+}
+
+// withRulePath prefixes the path of the given rule error, or turns the given
+// error into a rule error. The paths of the rules of a group error are
+// prefixed as well, so that every error names the full path of its rule.
+func withRulePath(prefix string, err error) error {
+	// The rule errors of a group belong to its rules, not to the group.
+	if _, ok := err.(*groupError); ok {
+		return &ruleError{path: prefix, err: withGroupPath(prefix, err)}
+	}
+
+	var ruleErr *ruleError
+	if errors.As(err, &ruleErr) {
+		return &ruleError{path: prefix + "." + ruleErr.path, err: withGroupPath(prefix, ruleErr.err)}
+	}
+	return &ruleError{path: prefix, err: withGroupPath(prefix, err)}
+}
+
+// withGroupPath prefixes the paths of the rules of the given group error.
+// Other errors are returned as is.
+func withGroupPath(prefix string, err error) error {
+	groupErr, ok := err.(*groupError)
+	if !ok {
+		return err
+	}
+
+	reasons := make([]error, 0, len(groupErr.reasons))
+	for _, reason := range groupErr.reasons {
+		reasons = append(reasons, withRulePath(prefix, reason))
+	}
+	return &groupError{reasons: reasons}
+}
+
+// UnmarshalJSON unmarshals and validates a claim rule and its groups.
+func (cr *ClaimRule) UnmarshalJSON(b []byte) error {
+	var raw struct {
+		Value interface{}     `json:"value"`
+		Op    operation       `json:"op"`
+		Path  string          `json:"path"`
+		Not   bool            `json:"not"`
+		AnyOf json.RawMessage `json:"anyOf"`
+		AllOf json.RawMessage `json:"allOf"`
+	}
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return err
+	}
+
+	*cr = ClaimRule{Value: raw.Value, Op: raw.Op, Path: raw.Path, Not: raw.Not}
+	if len(raw.AnyOf) > 0 {
+		if err := json.Unmarshal(raw.AnyOf, &cr.AnyOf); err != nil {
+			return withRulePath("anyOf", err)
+		}
+	}
+	if len(raw.AllOf) > 0 {
+		if err := json.Unmarshal(raw.AllOf, &cr.AllOf); err != nil {
+			return withRulePath("allOf", err)
+		}
+	}
+
+	return cr.validate()
+}
+
+// validate checks that the rule is well-formed, without checking its
+// groups.
+func (cr *ClaimRule) validate() error {
+	isGroup := cr.AnyOf != nil || cr.AllOf != nil
+	switch {
+	case cr.AnyOf != nil && cr.AllOf != nil:
+		return errors.New("anyOf and allOf must be given in separate rules")
+	case isGroup && (cr.Path != "" || cr.Op != "" || cr.Value != nil):
+		return errors.New("a rule group cannot have a path, operation or value")
+	case isGroup && len(cr.AnyOf)+len(cr.AllOf) == 0:
+		return errors.New("empty rule group")
+	case isGroup:
+		return nil
+	case cr.Path == "":
+		return errors.New("no path given")
+	case !operations[cr.Op]:
+		return errors.Errorf("unsupported operation %q for claim rule path %q", cr.Op, cr.Path)
+	case cr.Op == Exists:
+		return nil
+	case cr.Op.isNumeric():
+		if _, ok := toNumber(cr.Value); !ok {
+			return errors.Errorf("value %v of claim rule path %q is not a number", cr.Value, cr.Path)
+		}
+		return nil
+	}
+
+	pattern, ok := cr.Value.(string)
+	if !ok {
+		return errors.Errorf("value %v of claim rule path %q is not a string", cr.Value, cr.Path)
+	}
+	if _, err := regexp.Compile(pattern); err != nil {
+		return errors.Wrapf(err, "invalid regular expression for claim rule path %q", cr.Path)
+	}
+	return nil
 }
 
 // jsonPathExists checks if jsonPath exists in flat token claims.
@@ -73,12 +259,79 @@ func (cr *ClaimRule) equalCheck(flatTokenClaims map[string]interface{}, jsonPath
 	}
 
 	pattern := fmt.Sprintf("^%s$", cr.Value)
-	found, err := regexp.MatchString(pattern, tokenClaimValue.(string))
+	found, err := regexp.MatchString(pattern, fmt.Sprint(tokenClaimValue))
+	if err != nil {
+		return errors.Wrapf(err, "error matching claim %v to expected value", tokenClaimValue)
+	}
 	if !found {
 		return errors.Errorf("expected claim %q is not correct", jsonPath)
 	}
+
+	return nil
+}
+
+// matchCheck checks whether the claim value, or any value of the claim list,
+// contains a match of the regular expression.
+func (cr *ClaimRule) matchCheck(flatTokenClaims map[string]interface{}) error {
+	pattern, err := regexp.Compile(fmt.Sprint(cr.Value))
 	if err != nil {
-		return errors.Wrapf(err, "error matching claim %s to expected value", tokenClaimValue)
+		return errors.Wrapf(err, "invalid regular expression for claim rule path %q", cr.Path)
+	}
+
+	values := claimValues(flatTokenClaims, cr.Path)
+	if len(values) == 0 {
+		return errors.Errorf("expected claim %q is not found", cr.Path)
+	}
+	for _, value := range values {
+		if pattern.MatchString(fmt.Sprint(value)) {
+			return nil
+		}
+	}
+
+	return errors.Errorf("claim %q does not match %q", cr.Path, cr.Value)
+}
+
+// existsCheck checks whether the claim is present, as a single value, a list
+// or an object.
+func (cr *ClaimRule) existsCheck(flatTokenClaims map[string]interface{}) error {
+	for jsonPath := range flatTokenClaims {
+		if jsonPath == cr.Path || strings.HasPrefix(jsonPath, cr.Path+".") {
+			return nil
+		}
+	}
+
+	return errors.Errorf("expected claim %q is not found", cr.Path)
+}
+
+// numericCheck compares the numeric claim value to the rule value.
+func (cr *ClaimRule) numericCheck(flatTokenClaims map[string]interface{}) error {
+	expected, ok := toNumber(cr.Value)
+	if !ok {
+		return errors.Errorf("value %v of claim rule path %q is not a number", cr.Value, cr.Path)
+	}
+
+	tokenClaimValue, found := flatTokenClaims[cr.Path]
+	if !found {
+		return errors.Errorf("expected claim %q is not found", cr.Path)
+	}
+	actual, ok := toNumber(tokenClaimValue)
+	if !ok {
+		return errors.Errorf("claim %q is not a number", cr.Path)
+	}
+
+	var fulfilled bool
+	switch cr.Op {
+	case GreaterThan:
+		fulfilled = actual > expected
+	case GreaterOrEqual:
+		fulfilled = actual >= expected
+	case LessThan:
+		fulfilled = actual < expected
+	case LessOrEqual:
+		fulfilled = actual <= expected
+	}
+	if !fulfilled {
+		return errors.Errorf("claim %q is %v, expected %s %v", cr.Path, tokenClaimValue, cr.Op, cr.Value)
 	}
 
 	return nil
@@ -87,11 +340,54 @@ func (cr *ClaimRule) equalCheck(flatTokenClaims map[string]interface{}, jsonPath
 // Checks expected claim against token claims. This function expects flattened
 // JSON created from access token claims.
 func (cr *ClaimRule) check(flatTokenClaims map[string]interface{}) error {
-	if cr.Op == Equal {
-		return cr.equalCheck(flatTokenClaims, cr.Path)
+	err := cr.checkUnnegated(flatTokenClaims)
+	if !cr.Not {
+		return err
+	}
+
+	if err == nil {
+		if cr.Path != "" {
+			return errors.Errorf("negated claim rule for path %q is fulfilled", cr.Path)
+		}
+		return errors.New("negated claim rule group is fulfilled")
 	}
+	return nil
+}
+
+func (cr *ClaimRule) checkUnnegated(flatTokenClaims map[string]interface{}) error {
+	switch {
+	case cr.AnyOf != nil:
+		var reasons []error
+		for index, rule := range cr.AnyOf {
+			errCheck := rule.check(flatTokenClaims)
+			if errCheck == nil {
+				return nil
+			}
+			reasons = append(reasons, withRulePath(fmt.Sprintf("anyOf.%d", index), errCheck))
+		}
+		return &groupError{reasons: reasons}
 
-	if cr.Op == In {
+	case cr.AllOf != nil:
+		for index, rule := range cr.AllOf {
+			if errCheck := rule.check(flatTokenClaims); errCheck != nil {
+				return withRulePath(fmt.Sprintf("allOf.%d", index), errCheck)
+			}
+		}
+		return nil
+
+	case cr.Op == Equal:
+		return cr.equalCheck(flatTokenClaims, cr.Path)
+
+	case cr.Op == Match:
+		return cr.matchCheck(flatTokenClaims)
+
+	case cr.Op == Exists:
+		return cr.existsCheck(flatTokenClaims)
+
+	case cr.Op.isNumeric():
+		return cr.numericCheck(flatTokenClaims)
+
+	case cr.Op == In:
 		// Loop will exit when we check for not existing key in flatTokenClaims.
 		// Or early exit if value is found in list.
 		for i := 0; ; i++ {
@@ -112,10 +408,73 @@ func (cr *ClaimRule) check(flatTokenClaims map[string]interface{}) error {
 	return errors.Errorf("unsupported operation %q for claim rule path %q", cr.Op, cr.Path)
 }
 
+// claimValues returns the single value of the claim at the given path, or the
+// values of the claim list.
+func claimValues(flatTokenClaims map[string]interface{}, jsonPath string) []interface{} {
+	if value, found := flatTokenClaims[jsonPath]; found {
+		return []interface{}{value}
+	}
+
+	var values []interface{}
+	for i := 0; ; i++ {
+		value, found := flatTokenClaims[fmt.Sprintf("%s.%d", jsonPath, i)]
+		if !found {
+			return values
+		}
+		values = append(values, value)
+	}
+}
+
+// toNumber converts a numeric JSON or Go value to a float64.
+func toNumber(value interface{}) (float64, bool) {
+	switch value := value.(type) {
+	case float64:
+		return value, true
+	case float32:
+		return float64(value), true
+	case int:
+		return float64(value), true
+	case int32:
+		return float64(value), true
+	case int64:
+		return float64(value), true
+	case json.Number:
+		number, err := value.Float64()
+		return number, err == nil
+	default:
+		return 0, false
+	}
+}
+
 // ClaimRules represents the collection of claim ruels that should be validated
 // against an access token claims.
 type ClaimRules []ClaimRule
 
+var _ json.Unmarshaler = (*ClaimRules)(nil)
+
+// UnmarshalJSON unmarshals and validates claim rules. Errors name the path of
+// the offending rule, such as "claim rule 1.anyOf.0".
+func (cos *ClaimRules) UnmarshalJSON(b []byte) error {
+	var rawRules []json.RawMessage
+	if err := json.Unmarshal(b, &rawRules); err != nil {
+		return err
+	}
+	if rawRules == nil {
+		*cos = nil
+		return nil
+	}
+
+	rules := make(ClaimRules, len(rawRules))
+	for index, rawRule := range rawRules {
+		if err := json.Unmarshal(rawRule, &rules[index]); err != nil {
+			return withRulePath(strconv.Itoa(index), err)
+		}
+	}
+	*cos = rules
+
+	return nil
+}
+
 func decodeAccessToken(rawAccessToken string) (map[string]interface{}, error) {
 	rawTokenParts := strings.Split(rawAccessToken, ".")
 	if len(rawTokenParts) < 2 {
@@ -133,6 +492,12 @@ func decodeAccessToken(rawAccessToken string) (map[string]interface{}, error) {
 	return tokenClaims, errDecode
 }
 
+// DecodeClaims decodes the claims of the given raw token, without verifying
+// its signature.
+func DecodeClaims(rawToken string) (map[string]interface{}, error) {
+	return decodeAccessToken(rawToken)
+}
+
 // IsEmpty returns if claim rules are not defined.
 func (cos *ClaimRules) IsEmpty() bool {
 	return cos == nil || len(*cos) == 0
@@ -154,9 +519,9 @@ func (cos *ClaimRules) Validate(rawAccessToken string) error {
 		return errFlatten
 	}
 
-	for _, expectedClaim := range *cos {
+	for index, expectedClaim := range *cos {
 		if errCheck := expectedClaim.check(flatTokenClaims); errCheck != nil {
-			return errCheck
+			return withRulePath(strconv.Itoa(index), errCheck)
 		}
 	}
 
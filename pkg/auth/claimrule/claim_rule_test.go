@@ -219,6 +219,285 @@ func getDataSets() map[string]dataSet {
 			}},
 			err: false,
 		},
+		"eq-non-string-claim": {
+			tokenClaims: map[string]interface{}{
+				"field": true,
+			},
+			rules: ClaimRules{{
+				Value: "true",
+				Path:  "field",
+				Op:    "eq",
+			}},
+			err: false,
+		},
+		"match-valid": {
+			tokenClaims: map[string]interface{}{
+				"email": "user@redhat.com",
+			},
+			rules: ClaimRules{{
+				Value: "@redhat\\.com$",
+				Path:  "email",
+				Op:    "match",
+			}},
+			err: false,
+		},
+		"match-no-match": {
+			tokenClaims: map[string]interface{}{
+				"email": "user@redhat.com.example.com",
+			},
+			rules: ClaimRules{{
+				Value: "@redhat\\.com$",
+				Path:  "email",
+				Op:    "match",
+			}},
+			err: true,
+		},
+		"match-list-valid": {
+			tokenClaims: map[string]interface{}{
+				"field": []string{"team-sre", "team-qa"},
+			},
+			rules: ClaimRules{{
+				Value: "^team-",
+				Path:  "field",
+				Op:    "match",
+			}},
+			err: false,
+		},
+		"match-no-field": {
+			tokenClaims: map[string]interface{}{
+				"no-field": "val",
+			},
+			rules: ClaimRules{{
+				Value: "val",
+				Path:  "field",
+				Op:    "match",
+			}},
+			err: true,
+		},
+		"exists-valid": {
+			tokenClaims: map[string]interface{}{
+				"field": "",
+			},
+			rules: ClaimRules{{
+				Path: "field",
+				Op:   "exists",
+			}},
+			err: false,
+		},
+		"exists-nested-valid": {
+			tokenClaims: map[string]interface{}{
+				"field": map[string]interface{}{
+					"nested": []string{"val"},
+				},
+			},
+			rules: ClaimRules{{
+				Path: "field",
+				Op:   "exists",
+			}},
+			err: false,
+		},
+		"exists-prefix-only": {
+			tokenClaims: map[string]interface{}{
+				"fields": "val",
+			},
+			rules: ClaimRules{{
+				Path: "field",
+				Op:   "exists",
+			}},
+			err: true,
+		},
+		"not-exists-valid": {
+			tokenClaims: map[string]interface{}{
+				"field": "val",
+			},
+			rules: ClaimRules{{
+				Path: "suspended",
+				Op:   "exists",
+				Not:  true,
+			}},
+			err: false,
+		},
+		"not-in-valid": {
+			tokenClaims: map[string]interface{}{
+				"roles": []string{"user", "admin"},
+			},
+			rules: ClaimRules{{
+				Value: "suspended",
+				Path:  "roles",
+				Op:    "in",
+				Not:   true,
+			}},
+			err: false,
+		},
+		"not-in-fulfilled": {
+			tokenClaims: map[string]interface{}{
+				"roles": []string{"user", "suspended"},
+			},
+			rules: ClaimRules{{
+				Value: "suspended",
+				Path:  "roles",
+				Op:    "in",
+				Not:   true,
+			}},
+			err: true,
+		},
+		"gt-valid": {
+			tokenClaims: map[string]interface{}{
+				"level": 5,
+			},
+			rules: ClaimRules{{
+				Value: 4,
+				Path:  "level",
+				Op:    "gt",
+			}},
+			err: false,
+		},
+		"gt-equal": {
+			tokenClaims: map[string]interface{}{
+				"level": 5,
+			},
+			rules: ClaimRules{{
+				Value: 5,
+				Path:  "level",
+				Op:    "gt",
+			}},
+			err: true,
+		},
+		"ge-equal": {
+			tokenClaims: map[string]interface{}{
+				"level": 5,
+			},
+			rules: ClaimRules{{
+				Value: 5,
+				Path:  "level",
+				Op:    "ge",
+			}},
+			err: false,
+		},
+		"lt-valid": {
+			tokenClaims: map[string]interface{}{
+				"level": 2.5,
+			},
+			rules: ClaimRules{{
+				Value: 3,
+				Path:  "level",
+				Op:    "lt",
+			}},
+			err: false,
+		},
+		"le-greater": {
+			tokenClaims: map[string]interface{}{
+				"level": 4,
+			},
+			rules: ClaimRules{{
+				Value: 3.5,
+				Path:  "level",
+				Op:    "le",
+			}},
+			err: true,
+		},
+		"gt-not-a-number": {
+			tokenClaims: map[string]interface{}{
+				"level": "5",
+			},
+			rules: ClaimRules{{
+				Value: 4,
+				Path:  "level",
+				Op:    "gt",
+			}},
+			err: true,
+		},
+		"anyOf-first-valid": {
+			tokenClaims: map[string]interface{}{
+				"email": "user@redhat.com",
+			},
+			rules: ClaimRules{{
+				AnyOf: ClaimRules{
+					{Value: "@redhat\\.com$", Path: "email", Op: "match"},
+					{Value: "contractor@example.com", Path: "email", Op: "eq"},
+				},
+			}},
+			err: false,
+		},
+		"anyOf-second-valid": {
+			tokenClaims: map[string]interface{}{
+				"email": "contractor@example.com",
+			},
+			rules: ClaimRules{{
+				AnyOf: ClaimRules{
+					{Value: "@redhat\\.com$", Path: "email", Op: "match"},
+					{Value: "contractor@example.com", Path: "email", Op: "eq"},
+				},
+			}},
+			err: false,
+		},
+		"anyOf-none-valid": {
+			tokenClaims: map[string]interface{}{
+				"email": "someone@example.com",
+			},
+			rules: ClaimRules{{
+				AnyOf: ClaimRules{
+					{Value: "@redhat\\.com$", Path: "email", Op: "match"},
+					{Value: "contractor@example.com", Path: "email", Op: "eq"},
+				},
+			}},
+			err: true,
+		},
+		"allOf-valid": {
+			tokenClaims: map[string]interface{}{
+				"email": "user@redhat.com",
+				"roles": []string{"user"},
+			},
+			rules: ClaimRules{{
+				AllOf: ClaimRules{
+					{Value: "@redhat\\.com$", Path: "email", Op: "match"},
+					{Value: "suspended", Path: "roles", Op: "in", Not: true},
+				},
+			}},
+			err: false,
+		},
+		"allOf-one-invalid": {
+			tokenClaims: map[string]interface{}{
+				"email": "user@redhat.com",
+				"roles": []string{"suspended"},
+			},
+			rules: ClaimRules{{
+				AllOf: ClaimRules{
+					{Value: "@redhat\\.com$", Path: "email", Op: "match"},
+					{Value: "suspended", Path: "roles", Op: "in", Not: true},
+				},
+			}},
+			err: true,
+		},
+		"not-anyOf-valid": {
+			tokenClaims: map[string]interface{}{
+				"roles": []string{"user"},
+			},
+			rules: ClaimRules{{
+				Not: true,
+				AnyOf: ClaimRules{
+					{Value: "suspended", Path: "roles", Op: "in"},
+					{Value: "banned", Path: "roles", Op: "in"},
+				},
+			}},
+			err: false,
+		},
+		"nested-groups-valid": {
+			tokenClaims: map[string]interface{}{
+				"email": "contractor@example.com",
+				"level": 3,
+			},
+			rules: ClaimRules{{
+				AnyOf: ClaimRules{
+					{Value: "@redhat\\.com$", Path: "email", Op: "match"},
+					{AllOf: ClaimRules{
+						{Value: "contractor@example.com", Path: "email", Op: "eq"},
+						{Value: 2, Path: "level", Op: "ge"},
+					}},
+				},
+			}},
+			err: false,
+		},
 	}
 }
 
@@ -239,6 +518,31 @@ func TestClaimRulesValidate(t *testing.T) {
 	}
 }
 
+func TestClaimRulesValidateErrorPath(t *testing.T) {
+	rawToken := getRawToken(t, map[string]interface{}{
+		"email": "someone@example.com",
+		"level": 3,
+	})
+	rules := ClaimRules{
+		{Op: Exists, Path: "level"},
+		{AnyOf: ClaimRules{
+			{Value: "user@redhat.com", Path: "email", Op: Equal},
+			{AllOf: ClaimRules{
+				{Value: 2, Path: "level", Op: GreaterOrEqual},
+				{AnyOf: ClaimRules{
+					{Value: "contractor@example.com", Path: "email", Op: Equal},
+				}},
+			}},
+		}},
+	}
+
+	err := rules.Validate(rawToken)
+	assert.EqualError(t, err, `claim rule 1: no rule of group is fulfilled: `+
+		`claim rule 1.anyOf.0: expected claim "email" is not correct; `+
+		`claim rule 1.anyOf.1.allOf.1: no rule of group is fulfilled: `+
+		`claim rule 1.anyOf.1.allOf.1.anyOf.0: expected claim "email" is not correct`)
+}
+
 func TestClaimRulesValidateNotJWT(t *testing.T) {
 	rules := ClaimRules{{
 		Value: "val",
@@ -299,6 +603,30 @@ func TestOperationUnmarshalJSON(t *testing.T) {
 			rawData: []byte("\"eq\""),
 			error:   false,
 		},
+		"match": {
+			rawData: []byte("\"match\""),
+			error:   false,
+		},
+		"exists": {
+			rawData: []byte("\"exists\""),
+			error:   false,
+		},
+		"gt": {
+			rawData: []byte("\"gt\""),
+			error:   false,
+		},
+		"ge": {
+			rawData: []byte("\"ge\""),
+			error:   false,
+		},
+		"lt": {
+			rawData: []byte("\"lt\""),
+			error:   false,
+		},
+		"le": {
+			rawData: []byte("\"le\""),
+			error:   false,
+		},
 	}
 
 	for testName, testCase := range testCases {
@@ -310,3 +638,82 @@ func TestOperationUnmarshalJSON(t *testing.T) {
 		})
 	}
 }
+
+func TestClaimRulesUnmarshalJSON(t *testing.T) {
+	testCases := map[string]struct {
+		rawData  string
+		expected ClaimRules
+		error    string
+	}{
+		"valid": {
+			rawData: `[
+				{"op": "match", "path": "email", "value": "@redhat\\.com$"},
+				{"not": true, "anyOf": [
+					{"op": "in", "path": "roles", "value": "suspended"},
+					{"op": "exists", "path": "banned"}
+				]},
+				{"op": "ge", "path": "level", "value": 2}
+			]`,
+			expected: ClaimRules{
+				{Op: Match, Path: "email", Value: "@redhat\\.com$"},
+				{Not: true, AnyOf: ClaimRules{
+					{Op: In, Path: "roles", Value: "suspended"},
+					{Op: Exists, Path: "banned"},
+				}},
+				{Op: GreaterOrEqual, Path: "level", Value: float64(2)},
+			},
+		},
+		"null": {
+			rawData: `null`,
+		},
+		"unsupported-operation": {
+			rawData: `[{"op": "eq", "path": "a", "value": "b"}, {"op": "abc", "path": "a"}]`,
+			error:   `claim rule 1: unsupported operation "abc"`,
+		},
+		"nested-unsupported-operation": {
+			rawData: `[{"anyOf": [{"op": "eq", "path": "a", "value": "b"}, {"allOf": [{"op": "abc", "path": "a"}]}]}]`,
+			error:   `claim rule 0.anyOf.1.allOf.0: unsupported operation "abc"`,
+		},
+		"missing-path": {
+			rawData: `[{"op": "exists"}]`,
+			error:   "claim rule 0: no path given",
+		},
+		"missing-operation": {
+			rawData: `[{"path": "a", "value": "b"}]`,
+			error:   `claim rule 0: unsupported operation ""`,
+		},
+		"numeric-string-value": {
+			rawData: `[{"allOf": [{"op": "gt", "path": "level", "value": "2"}]}]`,
+			error:   `claim rule 0.allOf.0: value 2 of claim rule path "level" is not a number`,
+		},
+		"invalid-regex": {
+			rawData: `[{"op": "match", "path": "email", "value": "("}]`,
+			error:   "claim rule 0: invalid regular expression",
+		},
+		"group-with-path": {
+			rawData: `[{"path": "a", "anyOf": [{"op": "exists", "path": "b"}]}]`,
+			error:   "claim rule 0: a rule group cannot have a path",
+		},
+		"empty-group": {
+			rawData: `[{"anyOf": []}]`,
+			error:   "claim rule 0: empty rule group",
+		},
+		"both-groups": {
+			rawData: `[{"anyOf": [{"op": "exists", "path": "a"}], "allOf": [{"op": "exists", "path": "b"}]}]`,
+			error:   "claim rule 0: anyOf and allOf must be given in separate rules",
+		},
+	}
+
+	for testName, testCase := range testCases {
+		t.Run(testName, func(t *testing.T) {
+			var rules ClaimRules
+			err := json.Unmarshal([]byte(testCase.rawData), &rules)
+			if testCase.error != "" {
+				assert.ErrorContains(t, err, testCase.error)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.expected, rules)
+		})
+	}
+}
@@ -34,7 +34,7 @@ func NewFromConfig(oidcConfigFile string) (*OidcAuth, error) {
 		provider:  provider,
 		jwtState:  NewStateTokenizer(time.Minute, cfg.SessionSecret),
 		jwtAccess: NewAccessTokenizer(cfg.AccessTokenClaims),
-		jwtOidc:   NewOidcTokenizer(provider.Verifier(&oidc.Config{ClientID: cfg.ClientID})),
+		jwtOidc:   NewOidcTokenizer(provider.Verifier(&oidc.Config{ClientID: cfg.ClientID}), cfg.GroupClaims),
 		jwtUser:   NewUserTokenizer(time.Hour, cfg.SessionSecret),
 		jwtSvcAcct: serviceAccountTokenizer{
 			secret:   []byte(cfg.SessionSecret),
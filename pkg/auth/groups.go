@@ -0,0 +1,78 @@
+package auth
+
+import (
+	"slices"
+	"sort"
+	"strings"
+)
+
+// defaultGroupClaim is the claim holding the groups of a user, unless
+// configured otherwise.
+const defaultGroupClaim = "groups"
+
+// extractGroups collects the groups held by the claims at the given paths of
+// any of the given token claims, sorted and without duplicates. A claim may
+// hold a single group or a list of groups. Nested fields of a path are
+// separated by '.', unless a claim is named by the whole path.
+func extractGroups(paths []string, claimSets ...map[string]any) []string {
+	if len(paths) == 0 {
+		paths = []string{defaultGroupClaim}
+	}
+
+	seen := make(map[string]struct{})
+	for _, claims := range claimSets {
+		for _, path := range paths {
+			for _, group := range lookupClaim(claims, path) {
+				seen[group] = struct{}{}
+			}
+		}
+	}
+
+	groups := make([]string, 0, len(seen))
+	for group := range seen {
+		groups = append(groups, group)
+	}
+	sort.Strings(groups)
+
+	return groups
+}
+
+// lookupClaim returns the non-empty strings held by the claim at the given
+// path. Namespaced claims, such as "https://example.com/roles", contain dots
+// themselves, so a claim named by the whole path is preferred over nested
+// fields.
+func lookupClaim(claims map[string]any, path string) []string {
+	value, found := claims[path]
+	if !found {
+		value = lookupNestedClaim(claims, path)
+	}
+
+	var values []string
+	switch value := value.(type) {
+	case string:
+		values = append(values, value)
+	case []any:
+		for _, item := range value {
+			if item, ok := item.(string); ok {
+				values = append(values, item)
+			}
+		}
+	}
+
+	return slices.DeleteFunc(values, func(value string) bool { return value == "" })
+}
+
+// lookupNestedClaim returns the value of the nested field at the given path,
+// whose fields are separated by '.'.
+func lookupNestedClaim(claims map[string]any, path string) any {
+	var value any = claims
+	for _, field := range strings.Split(path, ".") {
+		fields, ok := value.(map[string]any)
+		if !ok {
+			return nil
+		}
+		value = fields[field]
+	}
+
+	return value
+}
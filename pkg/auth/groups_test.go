@@ -0,0 +1,58 @@
+package auth
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExtractGroups(t *testing.T) {
+	idClaims := map[string]any{
+		"email":  "user@redhat.com",
+		"groups": []any{"infra-maintainers", "sre", ""},
+	}
+	accessClaims := map[string]any{
+		"realm_access": map[string]any{
+			"roles": []any{"flavor-admin", "sre", 42},
+		},
+		"department":                "engineering",
+		"https://example.com/roles": []any{"auditor"},
+	}
+
+	tests := []struct {
+		name     string
+		paths    []string
+		expected []string
+	}{
+		{
+			name:     "default claim",
+			expected: []string{"infra-maintainers", "sre"},
+		},
+		{
+			name:     "nested claim",
+			paths:    []string{"realm_access.roles"},
+			expected: []string{"flavor-admin", "sre"},
+		},
+		{
+			name:     "several claims",
+			paths:    []string{"groups", "realm_access.roles", "department"},
+			expected: []string{"engineering", "flavor-admin", "infra-maintainers", "sre"},
+		},
+		{
+			name:     "namespaced claim",
+			paths:    []string{"https://example.com/roles"},
+			expected: []string{"auditor"},
+		},
+		{
+			name:     "missing claims",
+			paths:    []string{"realm_access.groups", "email.domain"},
+			expected: []string{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, extractGroups(test.paths, idClaims, accessClaims))
+		})
+	}
+}
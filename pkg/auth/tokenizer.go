@@ -14,6 +14,7 @@ import (
 	"github.com/stackrox/infra/pkg/auth/claimrule"
 	"github.com/stackrox/infra/pkg/logging"
 	"golang.org/x/oauth2"
+	"k8s.io/apimachinery/pkg/util/uuid"
 )
 
 const (
@@ -31,6 +32,7 @@ func createHumanUser(profile oidcClaims) *v1.User {
 		Name:    profile.Name,
 		Email:   profile.Email,
 		Picture: profile.PictureURL,
+		Groups:  profile.Groups,
 		Expiry:  &timestamp.Timestamp{Seconds: int64(*profile.Expiry)},
 	}
 }
@@ -86,14 +88,16 @@ func (t stateTokenizer) Validate(token string) error {
 // The token contains an expiration date and user profile data provided by the
 // OIDC provider.
 type oidcTokenizer struct {
-	verifier *oidc.IDTokenVerifier
+	verifier    *oidc.IDTokenVerifier
+	groupClaims []string
 }
 
 // NewOidcTokenizer creates a new tokenizer that can verify OIDC provider
-// generated ID Token.
-func NewOidcTokenizer(verifier *oidc.IDTokenVerifier) *oidcTokenizer {
+// generated ID Token, and extracts the groups of users from the given claims.
+func NewOidcTokenizer(verifier *oidc.IDTokenVerifier, groupClaims []string) *oidcTokenizer {
 	return &oidcTokenizer{
-		verifier: verifier,
+		verifier:    verifier,
+		groupClaims: groupClaims,
 	}
 }
 
@@ -101,13 +105,14 @@ func NewOidcTokenizer(verifier *oidc.IDTokenVerifier) *oidcTokenizer {
 // profile data.
 type oidcClaims struct {
 	jwt.Claims
-	FamilyName    string `json:"family_name"`
-	GivenName     string `json:"given_name"`
-	Name          string `json:"name"`
-	Nickname      string `json:"nickname"`
-	PictureURL    string `json:"picture"`
-	Email         string `json:"email"`
-	EmailVerified bool   `json:"email_verified"`
+	FamilyName    string   `json:"family_name"`
+	GivenName     string   `json:"given_name"`
+	Name          string   `json:"name"`
+	Nickname      string   `json:"nickname"`
+	PictureURL    string   `json:"picture"`
+	Email         string   `json:"email"`
+	EmailVerified bool     `json:"email_verified"`
+	Groups        []string `json:"groups"`
 }
 
 // Valid imposes additional validity constraints on OIDC user profile data.
@@ -150,11 +155,26 @@ func (t oidcTokenizer) Validate(ctx context.Context, rawToken *oauth2.Token) (*v
 		return nil, err
 	}
 
-	if rawAccessToken := rawToken.Extra("access_token"); idToken.AccessTokenHash != "" && rawAccessToken != nil {
-		if err := idToken.VerifyAccessToken(rawAccessToken.(string)); err != nil {
-			return nil, err
+	// Groups may be held by claims of either the ID or the access token.
+	var idClaims map[string]any
+	if err := idToken.Claims(&idClaims); err != nil {
+		return nil, err
+	}
+	claimSets := []map[string]any{idClaims}
+
+	if rawAccessToken := rawToken.Extra("access_token"); rawAccessToken != nil {
+		if idToken.AccessTokenHash != "" {
+			if err := idToken.VerifyAccessToken(rawAccessToken.(string)); err != nil {
+				return nil, err
+			}
+		}
+
+		// Access tokens are not necessarily JWTs.
+		if accessClaims, err := claimrule.DecodeClaims(rawAccessToken.(string)); err == nil {
+			claimSets = append(claimSets, accessClaims)
 		}
 	}
+	claims.Groups = extractGroups(t.groupClaims, claimSets...)
 
 	return createHumanUser(claims), nil
 }
@@ -255,11 +275,13 @@ type serviceAccountTokenizer struct {
 
 // Generate generates a service account JWT containing a v1.ServiceAccount.
 func (t serviceAccountTokenizer) Generate(svcacct *v1.ServiceAccount) (string, error) {
-	// Set issuing and expiration times on new ServiceAccount.
+	// Set issuing and expiration times on new ServiceAccount. The unique ID
+	// allows revoking the token before it expires.
 	now := time.Now()
 	svcacct.ExpiresAt = now.Add(t.lifetime).Unix()
 	svcacct.NotBefore = now.Unix()
 	svcacct.IssuedAt = now.Unix()
+	svcacct.Jti = string(uuid.NewUUID())
 
 	svc := serviceAccountValidator{svcacct}
 
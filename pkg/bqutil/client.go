@@ -21,6 +21,7 @@ const (
 type BigQueryClient interface {
 	InsertClusterCreationRecord(ctx context.Context, clusterID, workflowName, flavor, actor string) error
 	InsertClusterDeletionRecord(ctx context.Context, clusterID, workflowName string) error
+	InsertClusterRetryRecord(ctx context.Context, clusterID, workflowName, actor string) error
 }
 
 var (
@@ -34,6 +35,7 @@ type enabledClient struct {
 	environment      string
 	creationInserter *bigquery.Inserter
 	deletionInserter *bigquery.Inserter
+	retryInserter    *bigquery.Inserter
 }
 
 type disabledClient struct{}
@@ -46,6 +48,10 @@ func (*disabledClient) InsertClusterDeletionRecord(_ context.Context, _, _ strin
 	return nil
 }
 
+func (*disabledClient) InsertClusterRetryRecord(_ context.Context, _, _, _ string) error {
+	return nil
+}
+
 type clusterCreationRecord struct {
 	Environment       string
 	ClusterID         string
@@ -62,6 +68,14 @@ type clusterDeletionRecord struct {
 	DeletionTimestamp time.Time
 }
 
+type clusterRetryRecord struct {
+	Environment    string
+	ClusterID      string
+	WorkflowName   string
+	Actor          string
+	RetryTimestamp time.Time
+}
+
 // NewClient returns a new BigQuery client
 func NewClient(cfg *config.BigQueryConfig) (BigQueryClient, error) {
 	if os.Getenv("TEST_MODE") == "true" {
@@ -93,6 +107,12 @@ func NewClient(cfg *config.BigQueryConfig) (BigQueryClient, error) {
 		deletionInserter: deletionInserter,
 	}
 
+	// The retry table was introduced later, so retries are only recorded
+	// when it is configured.
+	if cfg.RetryTable != "" {
+		bigQueryClient.retryInserter = client.Dataset(cfg.Dataset).Table(cfg.RetryTable).Inserter()
+	}
+
 	log.Log(logging.INFO, "enabled BigQuery integration")
 
 	return bigQueryClient, nil
@@ -129,3 +149,23 @@ func (c *enabledClient) InsertClusterDeletionRecord(ctx context.Context, cluster
 
 	return c.deletionInserter.Put(subCtx, clusterDeletionRecord)
 }
+
+// InsertClusterRetryRecord inserts a new cluster retry record into BigQuery.
+func (c *enabledClient) InsertClusterRetryRecord(ctx context.Context, clusterID, workflowName, actor string) error {
+	if c.retryInserter == nil {
+		return nil
+	}
+
+	subCtx, cancel := context.WithTimeout(ctx, bigqueryInsertTimeout)
+	defer cancel()
+
+	clusterRetryRecord := &clusterRetryRecord{
+		Environment:    c.environment,
+		ClusterID:      clusterID,
+		WorkflowName:   workflowName,
+		Actor:          actor,
+		RetryTimestamp: time.Now(),
+	}
+
+	return c.retryInserter.Put(subCtx, clusterRetryRecord)
+}
@@ -22,6 +22,57 @@ type Config struct {
 
 	// Slack notification configuration.
 	Slack *SlackConfig `json:"slack"`
+
+	// Quota limits the number of concurrent clusters.
+	Quota *QuotaConfig `json:"quota"`
+
+	// RBAC configures the roles granted to users and service accounts.
+	RBAC *RBACConfig `json:"rbac"`
+
+	// ArtifactStore configures the object store holding workflow artifacts.
+	ArtifactStore *ArtifactStoreConfig `json:"artifactStore"`
+
+	// Notifications configures additional sinks for cluster lifecycle
+	// notifications.
+	Notifications *NotificationsConfig `json:"notifications"`
+
+	// LeaderElection configures the election of the single replica that runs
+	// the background reconcilers. When missing, every replica runs them.
+	LeaderElection *LeaderElectionConfig `json:"leaderElection"`
+
+	// Audit configures where audit events are persisted. When missing, they
+	// are kept in a ConfigMap.
+	Audit *AuditConfig `json:"audit"`
+
+	// Tokens configures the ConfigMap that records the issued service account
+	// tokens. Defaults are used when missing.
+	Tokens *TokenStoreConfig `json:"tokens"`
+
+	// Schedules configures the scheduled cluster creation. Defaults are used
+	// when missing.
+	Schedules *ScheduleConfig `json:"schedules"`
+}
+
+// ScheduleConfig represents the configuration for scheduled cluster creation.
+type ScheduleConfig struct {
+	// Namespace is the namespace of the ConfigMap that persists schedules.
+	// Defaults to the namespace of the server pod.
+	Namespace string `json:"namespace"`
+
+	// CheckInterval is how often schedules that are due are checked for.
+	// Defaults to 1m.
+	CheckInterval JSONDuration `json:"checkInterval"`
+}
+
+// TokenStoreConfig represents the configuration for recording the issued
+// service account tokens in ConfigMaps, one per token.
+type TokenStoreConfig struct {
+	// Namespace is the namespace of the ConfigMaps. Defaults to "infra".
+	Namespace string `json:"namespace"`
+
+	// Name prefixes the names of the ConfigMaps, and labels them. Defaults
+	// to "service-account-tokens".
+	Name string `json:"name"`
 }
 
 // BigQueryConfig represents the configuration for integrating with Google BigQuery
@@ -33,6 +84,10 @@ type BigQueryConfig struct {
 	Dataset         string `json:"dataset"`
 	CreationTable   string `json:"creationTable"`
 	DeletionTable   string `json:"deletionTable"`
+
+	// RetryTable is the optional table recording the retries of failed
+	// clusters.
+	RetryTable string `json:"retryTable"`
 }
 
 // AuthOidcConfig represents the configuration for integrating with OIDC provider.
@@ -58,9 +113,15 @@ type AuthOidcConfig struct {
 
 	// AccessTokenClaims are the list of defined claim rules used to validate
 	// access token claims provided by the OIDC Provider.
-	// All claims have to be fulfilled.
+	// All claims have to be fulfilled, alternatives are grouped with anyOf.
 	AccessTokenClaims *claimrule.ClaimRules `json:"accessTokenClaims"`
 
+	// GroupClaims are the paths of the ID or access token claims that hold
+	// the groups or roles of a user, such as "realm_access.roles". Nested
+	// fields are separated by '.', unless a claim is named by the whole path,
+	// such as "https://example.com/roles". Defaults to "groups".
+	GroupClaims []string `json:"groupClaims"`
+
 	// TokenLifeTime is the duration for which generated service account tokens
 	// shall be valid.
 	TokenLifetime JSONDuration `json:"tokenLifetime"`
@@ -87,6 +148,226 @@ type SlackConfig struct {
 	Channel string `json:"channel"`
 }
 
+// NotificationsConfig represents the configuration for the sinks that cluster
+// lifecycle notifications are delivered to, in addition to Slack.
+type NotificationsConfig struct {
+	// Webhooks is the list of HTTP webhooks to deliver notifications to.
+	Webhooks []WebhookConfig `json:"webhooks"`
+}
+
+// WebhookConfig represents the configuration for delivering cluster lifecycle
+// notifications to a single HTTP webhook.
+type WebhookConfig struct {
+	// Name uniquely identifies the webhook. It must be a lowercase
+	// alphanumeric string which may contain dashes.
+	Name string `json:"name"`
+
+	// URL is the endpoint that notifications are POSTed to.
+	URL string `json:"url"`
+
+	// Secret is used to sign the payloads with HMAC-SHA256. The signature is
+	// sent in the X-Infra-Signature header. Payloads are not signed when
+	// empty.
+	Secret string `json:"secret"`
+
+	// Format is the payload format. One of "json" or "cloudevents". Defaults
+	// to "json".
+	Format string `json:"format"`
+
+	// Statuses is the list of lifecycle stages to deliver notifications for.
+	// One or more of "creating", "ready", "nearing_expiry", "failed", or
+	// "destroyed". Defaults to all of them.
+	Statuses []string `json:"statuses"`
+
+	// Retries is the number of times a failed delivery is retried before
+	// giving up until the next check. Defaults to 3.
+	Retries *int `json:"retries"`
+}
+
+// LeaderElectionConfig represents the configuration for electing a leader
+// among the infra-server replicas using a Kubernetes Lease.
+type LeaderElectionConfig struct {
+	// Namespace is the namespace of the Lease. Defaults to "infra".
+	Namespace string `json:"namespace"`
+
+	// LeaseName is the name of the Lease. Defaults to "infra-server".
+	LeaseName string `json:"leaseName"`
+
+	// LeaseDuration is how long non-leaders wait before trying to acquire
+	// leadership after the last renewal. Defaults to 15s.
+	LeaseDuration JSONDuration `json:"leaseDuration"`
+
+	// RenewDeadline is how long the leader keeps retrying to renew the Lease
+	// before giving up leadership. Defaults to 10s.
+	RenewDeadline JSONDuration `json:"renewDeadline"`
+
+	// RetryPeriod is how long to wait between attempts to acquire or renew
+	// the Lease. Defaults to 2s.
+	RetryPeriod JSONDuration `json:"retryPeriod"`
+}
+
+// AuditConfig represents the configuration of the sink that persists audit
+// events.
+type AuditConfig struct {
+	// Type is the kind of sink. One of "configmap", "file", or "bigquery".
+	// Defaults to "configmap".
+	Type string `json:"type"`
+
+	// ConfigMap is the configuration for a ConfigMap ring buffer sink.
+	ConfigMap *AuditConfigMapConfig `json:"configMap"`
+
+	// File is the configuration for a local JSON lines file sink.
+	File *AuditFileConfig `json:"file"`
+
+	// BigQuery is the configuration for a Google BigQuery table sink.
+	BigQuery *AuditBigQueryConfig `json:"bigQuery"`
+}
+
+// AuditConfigMapConfig represents the configuration for keeping the most
+// recent audit events in a ConfigMap.
+type AuditConfigMapConfig struct {
+	// Namespace is the namespace of the ConfigMap. Defaults to "infra".
+	Namespace string `json:"namespace"`
+
+	// Name is the name of the ConfigMap. Defaults to "audit".
+	Name string `json:"name"`
+
+	// MaxEvents is the number of most recent events that are kept. Defaults
+	// to 1000.
+	MaxEvents int `json:"maxEvents"`
+}
+
+// AuditFileConfig represents the configuration for appending audit events
+// to a local JSON lines file.
+type AuditFileConfig struct {
+	// Path is the path of the file.
+	Path string `json:"path"`
+}
+
+// AuditBigQueryConfig represents the configuration for inserting audit
+// events into a Google BigQuery table.
+type AuditBigQueryConfig struct {
+	CredentialsFile string `json:"credentialsFile"`
+	Project         string `json:"project"`
+	Dataset         string `json:"dataset"`
+	Table           string `json:"table"`
+}
+
+// QuotaConfig represents the limits on the number of concurrent (creating or
+// ready) clusters. A missing or zero limit means that there is no limit.
+type QuotaConfig struct {
+	// PerOwner is the maximum number of concurrent clusters for any single
+	// owner.
+	PerOwner int `json:"perOwner"`
+
+	// PerFlavor maps flavor IDs to the maximum number of concurrent clusters
+	// of that flavor, across all owners.
+	PerFlavor map[string]int `json:"perFlavor"`
+
+	// PerOwnerPerFlavor maps flavor IDs to the maximum number of concurrent
+	// clusters of that flavor for any single owner.
+	PerOwnerPerFlavor map[string]int `json:"perOwnerPerFlavor"`
+
+	// PerTeam maps team names to the maximum number of concurrent clusters
+	// created by the members of that team.
+	PerTeam map[string]int `json:"perTeam"`
+
+	// Exempt is the list of (service account) emails that are not subject to
+	// any quota.
+	Exempt []string `json:"exempt"`
+}
+
+// RBACConfig represents the role based access control configuration. Roles
+// are one of "viewer", "user", "flavor-admin", or "infra-admin", where every
+// role also grants the permissions of the roles before it.
+type RBACConfig struct {
+	// DefaultRole is the role granted to every authenticated user or service
+	// account. Defaults to "user" when empty.
+	DefaultRole string `json:"defaultRole"`
+
+	// Bindings is the list of additional roles granted to specific emails or
+	// OIDC groups.
+	Bindings []RoleBinding `json:"bindings"`
+
+	// Teams is the list of teams that specific emails or OIDC groups are
+	// members of.
+	Teams []TeamBinding `json:"teams"`
+}
+
+// TeamBinding makes a set of emails and OIDC groups members of a team.
+type TeamBinding struct {
+	// Name is the name of the team. The clusters of the team are labeled with
+	// it, so it must be a valid label name.
+	Name string `json:"name"`
+
+	// Emails is the list of user or service account emails that are members
+	// of the team.
+	Emails []string `json:"emails"`
+
+	// Groups is the list of OIDC groups whose members are members of the
+	// team.
+	Groups []string `json:"groups"`
+}
+
+// RoleBinding grants a role to a set of emails and OIDC groups.
+type RoleBinding struct {
+	// Role is the name of the granted role.
+	Role string `json:"role"`
+
+	// Emails is the list of user or service account emails that are granted
+	// the role.
+	Emails []string `json:"emails"`
+
+	// Groups is the list of OIDC groups whose members are granted the role.
+	Groups []string `json:"groups"`
+}
+
+// ArtifactStoreConfig represents the configuration of the object store that
+// holds the artifacts produced by workflows.
+type ArtifactStoreConfig struct {
+	// Type is the kind of object store. One of "gcs", "s3", or "local".
+	// Defaults to "gcs", which is configured from the
+	// GOOGLE_APPLICATION_CREDENTIALS environment variable.
+	Type string `json:"type"`
+
+	// S3 is the configuration for an S3-compatible object store.
+	S3 *S3StoreConfig `json:"s3"`
+
+	// Local is the configuration for a local filesystem object store.
+	Local *LocalStoreConfig `json:"local"`
+}
+
+// S3StoreConfig represents the configuration for an S3-compatible object
+// store, such as AWS S3 or MinIO.
+type S3StoreConfig struct {
+	// Endpoint is the hostname with optional port of the object store.
+	// Defaults to "s3.amazonaws.com".
+	Endpoint string `json:"endpoint"`
+
+	// Region is the region of the buckets.
+	Region string `json:"region"`
+
+	// Insecure disables TLS when connecting to the object store.
+	Insecure bool `json:"insecure"`
+
+	// AccessKey and SecretKey are static credentials for the object store.
+	// When empty, credentials are taken from the AWS_ACCESS_KEY_ID and
+	// AWS_SECRET_ACCESS_KEY environment variables, or from the IAM role.
+	AccessKey string `json:"accessKey"`
+	SecretKey string `json:"secretKey"`
+}
+
+// LocalStoreConfig represents the configuration for an object store on the
+// local filesystem, where objects are stored as <directory>/<bucket>/<key>.
+type LocalStoreConfig struct {
+	// Directory is the root directory of the object store.
+	Directory string `json:"directory"`
+
+	// URL is the base URL under which the root directory is served. When
+	// empty, file:// URLs are generated.
+	URL string `json:"url"`
+}
+
 // FlavorConfig represents the configuration for a single automation flavor.
 type FlavorConfig struct {
 	// ID is the unique, human type-able, ID for the flavor.
@@ -114,6 +395,10 @@ type FlavorConfig struct {
 
 	// Aliases are alternative IDs of the flavor.
 	Aliases []string `json:"aliases"`
+
+	// Teams restricts the flavor to the members of the given teams. Everyone
+	// may use the flavor when empty.
+	Teams []string `json:"teams"`
 }
 
 // Parameter represents a single Parameter that is needed to launch a flavor.
@@ -143,6 +428,23 @@ type Parameter struct {
 	// Indicates that the value for this parameter can be provided from the
 	// contents of a file.
 	FromFile bool `json:"fromFile"`
+
+	// Type is the type of value that the parameter accepts. One of "string",
+	// "enum", "int", "bool", "duration", or "image". Defaults to "string".
+	Type parameterType `json:"type"`
+
+	// AllowedValues is the list of values accepted by an enum parameter.
+	AllowedValues []string `json:"allowedValues"`
+
+	// Min is the smallest value accepted by an int parameter.
+	Min *int64 `json:"min"`
+
+	// Max is the largest value accepted by an int parameter.
+	Max *int64 `json:"max"`
+
+	// Pattern is a regular expression that values must match. Only string
+	// parameters can have a pattern.
+	Pattern string `json:"pattern"`
 }
 
 // Artifact represents a single Artifact that is produced by this flavor.
@@ -0,0 +1,62 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+const (
+	// ParameterString indicates that the parameter accepts any string,
+	// optionally restricted by a pattern.
+	ParameterString parameterType = iota
+
+	// ParameterEnum indicates that the parameter accepts one of a list of
+	// allowed values.
+	ParameterEnum
+
+	// ParameterInt indicates that the parameter accepts an integer,
+	// optionally restricted by a minimum and maximum.
+	ParameterInt
+
+	// ParameterBool indicates that the parameter accepts "true" or "false".
+	ParameterBool
+
+	// ParameterDuration indicates that the parameter accepts a duration, such
+	// as "3h".
+	ParameterDuration
+
+	// ParameterImage indicates that the parameter accepts a container image
+	// reference.
+	ParameterImage
+)
+
+var _ json.Unmarshaler = (*parameterType)(nil)
+
+type parameterType int
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (p *parameterType) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+
+	switch s {
+	case "string", "":
+		*p = ParameterString
+	case "enum":
+		*p = ParameterEnum
+	case "int":
+		*p = ParameterInt
+	case "bool":
+		*p = ParameterBool
+	case "duration":
+		*p = ParameterDuration
+	case "image":
+		*p = ParameterImage
+	default:
+		return fmt.Errorf("unknown parameter value type %q", s)
+	}
+
+	return nil
+}
@@ -0,0 +1,113 @@
+package flavor
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	v1 "github.com/stackrox/infra/generated/api/v1"
+	"github.com/stackrox/infra/pkg/config"
+)
+
+// imageReferencePattern matches container image references such as
+// "quay.io/org/image:tag" or "image@sha256:<digest>".
+var imageReferencePattern = regexp.MustCompile(
+	`^(?:[a-zA-Z0-9](?:[a-zA-Z0-9-]*[a-zA-Z0-9])?(?:\.[a-zA-Z0-9](?:[a-zA-Z0-9-]*[a-zA-Z0-9])?)*(?::[0-9]+)?/)?` +
+		`[a-z0-9]+(?:(?:[._]|__|-+)[a-z0-9]+)*(?:/[a-z0-9]+(?:(?:[._]|__|-+)[a-z0-9]+)*)*` +
+		`(?::[\w][\w.-]{0,127})?(?:@sha256:[a-f0-9]{64})?$`,
+)
+
+// patterns holds the compiled patterns of string parameters, keyed by their
+// expression, so that they are compiled once when the flavors are loaded.
+var patterns sync.Map
+
+// compilePattern returns the compiled regular expression for the given
+// pattern of a string parameter.
+func compilePattern(expr string) (*regexp.Regexp, error) {
+	if pattern, found := patterns.Load(expr); found {
+		return pattern.(*regexp.Regexp), nil
+	}
+
+	pattern, err := regexp.Compile(expr)
+	if err != nil {
+		return nil, err
+	}
+	patterns.Store(expr, pattern)
+	return pattern, nil
+}
+
+// apiParameterType returns the API type of the given configured parameter.
+func apiParameterType(parameter config.Parameter) (v1.ParameterType, error) {
+	switch parameter.Type {
+	case config.ParameterString:
+		return v1.ParameterType_STRING, nil
+	case config.ParameterEnum:
+		return v1.ParameterType_ENUM, nil
+	case config.ParameterInt:
+		return v1.ParameterType_INT, nil
+	case config.ParameterBool:
+		return v1.ParameterType_BOOL, nil
+	case config.ParameterDuration:
+		return v1.ParameterType_DURATION, nil
+	case config.ParameterImage:
+		return v1.ParameterType_IMAGE, nil
+	}
+
+	return v1.ParameterType_STRING, fmt.Errorf("parameter %s has an unknown type %d", parameter.Name, parameter.Type)
+}
+
+// ValidateParameterValue checks that the given value is acceptable for the
+// type of the given parameter.
+func ValidateParameterValue(param *v1.Parameter, value string) error {
+	switch param.GetType() {
+	case v1.ParameterType_STRING:
+		if param.GetPattern() == "" {
+			break
+		}
+		pattern, err := compilePattern(param.GetPattern())
+		if err != nil {
+			return fmt.Errorf("invalid pattern %q: %w", param.GetPattern(), err)
+		}
+		if !pattern.MatchString(value) {
+			return fmt.Errorf("value %q does not match the pattern %q", value, param.GetPattern())
+		}
+
+	case v1.ParameterType_ENUM:
+		if !slices.Contains(param.GetAllowedValues(), value) {
+			return fmt.Errorf("value %q is not one of %s", value, strings.Join(param.GetAllowedValues(), ", "))
+		}
+
+	case v1.ParameterType_INT:
+		number, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return fmt.Errorf("value %q is not an integer", value)
+		}
+		if param.GetMin() != nil && number < param.GetMin().GetValue() {
+			return fmt.Errorf("value %d is less than the minimum of %d", number, param.GetMin().GetValue())
+		}
+		if param.GetMax() != nil && number > param.GetMax().GetValue() {
+			return fmt.Errorf("value %d is greater than the maximum of %d", number, param.GetMax().GetValue())
+		}
+
+	case v1.ParameterType_BOOL:
+		if value != "true" && value != "false" {
+			return fmt.Errorf("value %q is not true or false", value)
+		}
+
+	case v1.ParameterType_DURATION:
+		if _, err := time.ParseDuration(value); err != nil {
+			return fmt.Errorf("value %q is not a duration", value)
+		}
+
+	case v1.ParameterType_IMAGE:
+		if !imageReferencePattern.MatchString(value) {
+			return fmt.Errorf("value %q is not an image reference", value)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,118 @@
+package flavor
+
+import (
+	"testing"
+
+	v1 "github.com/stackrox/infra/generated/api/v1"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestValidateParameterValue(t *testing.T) {
+	tests := []struct {
+		name  string
+		param *v1.Parameter
+		value string
+		valid bool
+	}{
+		{
+			name:  "string",
+			param: &v1.Parameter{},
+			value: "anything goes",
+			valid: true,
+		},
+		{
+			name:  "string matching pattern",
+			param: &v1.Parameter{Pattern: `^[a-z]+-[a-z]+[0-9]-[a-z]$`},
+			value: "us-central1-b",
+			valid: true,
+		},
+		{
+			name:  "string not matching pattern",
+			param: &v1.Parameter{Pattern: `^[a-z]+-[a-z]+[0-9]-[a-z]$`},
+			value: "us-central-b",
+		},
+		{
+			name:  "allowed enum",
+			param: &v1.Parameter{Type: v1.ParameterType_ENUM, AllowedValues: []string{"us-central1-a", "us-central1-b"}},
+			value: "us-central1-b",
+			valid: true,
+		},
+		{
+			name:  "disallowed enum",
+			param: &v1.Parameter{Type: v1.ParameterType_ENUM, AllowedValues: []string{"us-central1-a", "us-central1-b"}},
+			value: "us-centrl1-b",
+		},
+		{
+			name:  "int in range",
+			param: &v1.Parameter{Type: v1.ParameterType_INT, Min: wrapperspb.Int64(1), Max: wrapperspb.Int64(10)},
+			value: "10",
+			valid: true,
+		},
+		{
+			name:  "int below minimum",
+			param: &v1.Parameter{Type: v1.ParameterType_INT, Min: wrapperspb.Int64(1)},
+			value: "0",
+		},
+		{
+			name:  "int above maximum",
+			param: &v1.Parameter{Type: v1.ParameterType_INT, Max: wrapperspb.Int64(10)},
+			value: "11",
+		},
+		{
+			name:  "not an int",
+			param: &v1.Parameter{Type: v1.ParameterType_INT},
+			value: "three",
+		},
+		{
+			name:  "bool",
+			param: &v1.Parameter{Type: v1.ParameterType_BOOL},
+			value: "false",
+			valid: true,
+		},
+		{
+			name:  "not a bool",
+			param: &v1.Parameter{Type: v1.ParameterType_BOOL},
+			value: "yes",
+		},
+		{
+			name:  "duration",
+			param: &v1.Parameter{Type: v1.ParameterType_DURATION},
+			value: "1h30m",
+			valid: true,
+		},
+		{
+			name:  "not a duration",
+			param: &v1.Parameter{Type: v1.ParameterType_DURATION},
+			value: "90",
+		},
+		{
+			name:  "image with tag",
+			param: &v1.Parameter{Type: v1.ParameterType_IMAGE},
+			value: "quay.io/stackrox-io/main:4.5.0",
+			valid: true,
+		},
+		{
+			name:  "image with registry port and digest",
+			param: &v1.Parameter{Type: v1.ParameterType_IMAGE},
+			value: "localhost:5000/main@sha256:" + "0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef",
+			valid: true,
+		},
+		{
+			name:  "not an image",
+			param: &v1.Parameter{Type: v1.ParameterType_IMAGE},
+			value: "quay.io/stackrox-io/Main:4.5.0 ",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := ValidateParameterValue(test.param, test.value)
+			if test.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
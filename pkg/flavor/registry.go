@@ -4,9 +4,15 @@ package flavor
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	"hash"
 	"os"
+	"slices"
 	"sort"
+	"sync"
+	"time"
 
 	"github.com/argoproj/argo-workflows/v4/pkg/apis/workflow/v1alpha1"
 	"github.com/ghodss/yaml"
@@ -16,6 +22,7 @@ import (
 	"github.com/stackrox/infra/pkg/config"
 	"github.com/stackrox/infra/pkg/logging"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
 var log = logging.CreateProductionLogger()
@@ -32,12 +39,42 @@ type Registry struct {
 	defaultFlavor string
 	argoClientCtx context.Context
 	aliasRegistry map[string]string
+
+	// filename is the flavor config file that the registry was loaded from.
+	filename string
+
+	// lock guards the flavors and reload status, which are replaced when the
+	// config is reloaded.
+	lock        sync.RWMutex
+	configHash  string
+	loadedOn    time.Time
+	lastAttempt time.Time
+	lastErr     error
+}
+
+// ReloadStatus represents the outcome of loading the flavor config.
+type ReloadStatus struct {
+	// ConfigHash is the hash of the flavor config and workflows in use.
+	ConfigHash string
+
+	// LoadedOn is the time at which the config in use was loaded.
+	LoadedOn time.Time
+
+	// LastAttempt is the time at which the config was last reloaded.
+	LastAttempt time.Time
+
+	// LastError is the error from the last reload, if it failed. The
+	// previously loaded config remains in use.
+	LastError error
 }
 
 // Flavors returns a sorted list of all registered flavors.
 // Returns deep copies of the flavors to prevent external modifications from
 // affecting the registry's internal state.
 func (r *Registry) Flavors() []*v1.Flavor {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+
 	results := make([]*v1.Flavor, 0, len(r.flavors))
 	for _, pair := range r.flavors {
 		results = append(results, proto.Clone(pair.flavor).(*v1.Flavor))
@@ -93,6 +130,9 @@ func (r *Registry) add(flavor *v1.Flavor, workflow v1alpha1.Workflow) error {
 
 // Default returns the default flavor
 func (r *Registry) Default() string {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+
 	return r.defaultFlavor
 }
 
@@ -100,6 +140,9 @@ func (r *Registry) Default() string {
 // Returns a deep copy of the flavor to prevent external modifications from
 // affecting the registry's internal state.
 func (r *Registry) Get(id string) (*v1.Flavor, v1alpha1.Workflow, bool) {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+
 	if pair, found := r.flavors[id]; found {
 		return proto.Clone(pair.flavor).(*v1.Flavor), pair.workflow, true
 	}
@@ -130,11 +173,81 @@ func (r *Registry) check() (*Registry, error) {
 // NewFromConfig parses the given flavor config file, along with all referenced
 // Argo workflows, and returns a registry containing all flavors.
 func NewFromConfig(filename string) (*Registry, error) {
+	registry, err := load(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	registry.loadedOn = time.Now()
+	registry.lastAttempt = registry.loadedOn
+	return registry, nil
+}
+
+// Reload parses the flavor config file again, and replaces the registered
+// flavors if the config has changed and is valid. The previously registered
+// flavors are kept if the config is invalid.
+func (r *Registry) Reload() error {
+	registry, err := load(r.filename)
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.lastAttempt = time.Now()
+	r.lastErr = err
+	if err != nil {
+		log.Log(logging.ERROR, "failed to reload flavor config", "filename", r.filename, "error", err)
+		return err
+	}
+
+	if registry.configHash == r.configHash {
+		return nil
+	}
+
+	r.flavors = registry.flavors
+	r.defaultFlavor = registry.defaultFlavor
+	r.aliasRegistry = registry.aliasRegistry
+	r.configHash = registry.configHash
+	r.loadedOn = r.lastAttempt
+	log.Log(logging.INFO, "reloaded flavor config", "filename", r.filename, "config-hash", r.configHash)
+
+	return nil
+}
+
+// Watch periodically reloads the flavor config, so that changes take effect
+// without a restart.
+func (r *Registry) Watch(interval time.Duration) {
+	for {
+		time.Sleep(interval)
+		_ = r.Reload()
+	}
+}
+
+// Status returns the outcome of loading the flavor config.
+func (r *Registry) Status() ReloadStatus {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+
+	return ReloadStatus{
+		ConfigHash:  r.configHash,
+		LoadedOn:    r.loadedOn,
+		LastAttempt: r.lastAttempt,
+		LastError:   r.lastErr,
+	}
+}
+
+// load parses the given flavor config file, along with all referenced Argo
+// workflows, and returns a new registry containing all flavors.
+func load(filename string) (*Registry, error) {
 	data, err := os.ReadFile(filename)
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to read flavorCfg config file %q", filename)
 	}
 
+	// The hash covers the flavor config and every referenced workflow, so
+	// that a change to either is detected.
+	configHash := sha256.New()
+	writeHash(configHash, data)
+
 	var flavorsCfg []config.FlavorConfig
 	if err := yaml.Unmarshal(data, &flavorsCfg); err != nil {
 		return nil, err
@@ -143,6 +256,7 @@ func NewFromConfig(filename string) (*Registry, error) {
 	registry := &Registry{
 		flavors:       make(map[string]pair),
 		aliasRegistry: make(map[string]string),
+		filename:      filename,
 	}
 
 	for _, flavorCfg := range flavorsCfg {
@@ -161,13 +275,26 @@ func NewFromConfig(filename string) (*Registry, error) {
 			if err := validateParameter(parameter); err != nil {
 				return nil, errors.Wrapf(err, "failed to validate parameters for flavor %s", flavorCfg.ID)
 			}
+			parameterType, err := apiParameterType(parameter)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to validate parameters for flavor %s", flavorCfg.ID)
+			}
 			param := &v1.Parameter{
-				Name:        parameter.Name,
-				Description: parameter.Description,
-				Value:       parameter.Value,
-				Help:        parameter.Help,
-				FromFile:    parameter.FromFile,
-				Order:       int32(order) + 1,
+				Name:          parameter.Name,
+				Description:   parameter.Description,
+				Value:         parameter.Value,
+				Help:          parameter.Help,
+				FromFile:      parameter.FromFile,
+				Order:         int32(order) + 1,
+				Type:          parameterType,
+				AllowedValues: parameter.AllowedValues,
+				Pattern:       parameter.Pattern,
+			}
+			if parameter.Min != nil {
+				param.Min = wrapperspb.Int64(*parameter.Min)
+			}
+			if parameter.Max != nil {
+				param.Max = wrapperspb.Int64(*parameter.Max)
 			}
 
 			switch parameter.Kind {
@@ -182,6 +309,15 @@ func NewFromConfig(filename string) (*Registry, error) {
 				param.Optional = true
 			}
 
+			// Hardcoded values and defaults are validated once here, so
+			// that requests only need their own values validated. An empty
+			// value leaves the choice to the workflow.
+			if param.Optional && param.Value != "" {
+				if err := ValidateParameterValue(param, param.Value); err != nil {
+					return nil, errors.Wrapf(err, "failed to validate parameter %s for flavor %s", parameter.Name, flavorCfg.ID)
+				}
+			}
+
 			parameters[parameter.Name] = param
 		}
 
@@ -211,6 +347,7 @@ func NewFromConfig(filename string) (*Registry, error) {
 			Parameters:   parameters,
 			Artifacts:    artifacts,
 			Aliases:      flavorCfg.Aliases,
+			Teams:        flavorCfg.Teams,
 		}
 
 		// Parse the referenced Argo workflow file.
@@ -218,6 +355,7 @@ func NewFromConfig(filename string) (*Registry, error) {
 		if err != nil {
 			return nil, err
 		}
+		writeHash(configHash, data)
 
 		var workflow v1alpha1.Workflow
 		if err := yaml.Unmarshal(data, &workflow); err != nil {
@@ -230,9 +368,18 @@ func NewFromConfig(filename string) (*Registry, error) {
 		}
 	}
 
+	registry.configHash = hex.EncodeToString(configHash.Sum(nil))
+
 	return registry.check()
 }
 
+// writeHash adds the given data to the hash, prefixed by its length so that
+// the boundaries between files are unambiguous.
+func writeHash(h hash.Hash, data []byte) {
+	_, _ = fmt.Fprintf(h, "%d:", len(data))
+	_, _ = h.Write(data)
+}
+
 // CheckWorkflowEquivalence verifies that the given flavor parameters and
 // workflow parameters are equivalent sets.
 //
@@ -271,3 +418,16 @@ func CheckWorkflowEquivalence(flavor *v1.Flavor, workflow v1alpha1.Workflow) err
 	// Sets are equivalent!
 	return nil
 }
+
+// IsAvailableTo determines if the given flavor may be used by the members of
+// the given teams. Flavors that are not restricted to any team are available
+// to everyone.
+func IsAvailableTo(flavor *v1.Flavor, teams []string) bool {
+	if len(flavor.GetTeams()) == 0 {
+		return true
+	}
+
+	return slices.ContainsFunc(teams, func(team string) bool {
+		return slices.Contains(flavor.GetTeams(), team)
+	})
+}
@@ -0,0 +1,152 @@
+package flavor
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	v1 "github.com/stackrox/infra/generated/api/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testWorkflow = `apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+spec:
+  arguments:
+    parameters:
+      - name: name
+`
+
+func writeTestConfig(t *testing.T, dir string, flavors string) string {
+	workflowFile := filepath.Join(dir, "workflow.yaml")
+	require.NoError(t, os.WriteFile(workflowFile, []byte(testWorkflow), 0o600))
+
+	flavorsFile := filepath.Join(dir, "flavors.yaml")
+	require.NoError(t, os.WriteFile(flavorsFile, []byte(fmt.Sprintf(flavors, workflowFile)), 0o600))
+
+	return flavorsFile
+}
+
+const testFlavors = `- id: test
+  name: Test
+  description: Test flavor
+  availability: default
+  workflow: %s
+  parameters:
+    - name: name
+      description: cluster name
+      kind: required
+`
+
+func TestRegistryReload(t *testing.T) {
+	dir := t.TempDir()
+	registry, err := NewFromConfig(writeTestConfig(t, dir, testFlavors))
+	require.NoError(t, err)
+
+	initial := registry.Status()
+	assert.NotEmpty(t, initial.ConfigHash)
+	assert.NoError(t, initial.LastError)
+
+	// Reloading an unchanged config keeps the hash.
+	require.NoError(t, registry.Reload())
+	assert.Equal(t, initial.ConfigHash, registry.Status().ConfigHash)
+
+	// An invalid config keeps the previous flavors around.
+	writeTestConfig(t, dir, `- id: other
+  name: Other
+  description: Flavor without a default
+  availability: stable
+  workflow: %s
+  parameters:
+    - name: name
+      description: cluster name
+      kind: required
+`)
+	assert.Error(t, registry.Reload())
+	status := registry.Status()
+	assert.Equal(t, initial.ConfigHash, status.ConfigHash)
+	assert.Error(t, status.LastError)
+	_, _, found := registry.Get("test")
+	assert.True(t, found)
+
+	// A valid config is swapped in.
+	writeTestConfig(t, dir, testFlavors+`- id: other
+  name: Other
+  description: Another flavor
+  availability: stable
+  workflow: %[1]s
+  parameters:
+    - name: name
+      description: cluster name
+      kind: required
+`)
+	require.NoError(t, registry.Reload())
+	status = registry.Status()
+	assert.NotEqual(t, initial.ConfigHash, status.ConfigHash)
+	assert.NoError(t, status.LastError)
+	_, _, found = registry.Get("other")
+	assert.True(t, found)
+	assert.Equal(t, "test", registry.Default())
+}
+
+func TestRegistryParameters(t *testing.T) {
+	dir := t.TempDir()
+	registry, err := NewFromConfig(writeTestConfig(t, dir, `- id: test
+  name: Test
+  description: Test flavor
+  availability: default
+  workflow: %s
+  parameters:
+    - name: name
+      description: cluster name
+      kind: required
+      type: image
+`))
+	require.NoError(t, err)
+
+	flavor, _, found := registry.Get("test")
+	require.True(t, found)
+	assert.Equal(t, v1.ParameterType_IMAGE, flavor.GetParameters()["name"].GetType())
+
+	// Hardcoded values are validated when the flavor is loaded.
+	_, err = NewFromConfig(writeTestConfig(t, dir, `- id: test
+  name: Test
+  description: Test flavor
+  availability: default
+  workflow: %s
+  parameters:
+    - name: name
+      description: cluster name
+      kind: hardcoded
+      type: int
+      value: not-a-number
+`))
+	assert.ErrorContains(t, err, "not an integer")
+
+	// Patterns only apply to string parameters.
+	_, err = NewFromConfig(writeTestConfig(t, dir, `- id: test
+  name: Test
+  description: Test flavor
+  availability: default
+  workflow: %s
+  parameters:
+    - name: nodes
+      description: number of nodes
+      kind: optional
+      type: int
+      pattern: ^[0-9]$
+`))
+	assert.ErrorContains(t, err, "only string parameters can have one")
+}
+
+func TestIsAvailableTo(t *testing.T) {
+	unrestricted := &v1.Flavor{ID: "gke-default"}
+	restricted := &v1.Flavor{ID: "openshift-4", Teams: []string{"qa", "sre"}}
+
+	assert.True(t, IsAvailableTo(unrestricted, nil))
+	assert.True(t, IsAvailableTo(restricted, []string{"dev", "sre"}))
+	assert.False(t, IsAvailableTo(restricted, []string{"dev"}))
+	assert.False(t, IsAvailableTo(restricted, nil))
+}
@@ -16,6 +16,20 @@ func validateParameter(parameter config.Parameter) error {
 	if parameter.Name == "" {
 		return errors.New("parameter name is missing")
 	}
+	if parameter.Type == config.ParameterEnum && len(parameter.AllowedValues) == 0 {
+		return errors.Errorf("enum parameter %s has no allowed values", parameter.Name)
+	}
+	if parameter.Min != nil && parameter.Max != nil && *parameter.Min > *parameter.Max {
+		return errors.Errorf("parameter %s has a minimum greater than its maximum", parameter.Name)
+	}
+	if parameter.Pattern != "" {
+		if parameter.Type != config.ParameterString {
+			return errors.Errorf("parameter %s has a pattern, but only string parameters can have one", parameter.Name)
+		}
+		if _, err := compilePattern(parameter.Pattern); err != nil {
+			return errors.Wrapf(err, "parameter %s has an invalid pattern", parameter.Name)
+		}
+	}
 	return nil
 }
 
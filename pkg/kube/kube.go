@@ -4,10 +4,12 @@ package kube
 import (
 	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/argoproj/argo-workflows/v4/pkg/client/clientset/versioned"
 	workflowv1 "github.com/argoproj/argo-workflows/v4/pkg/client/clientset/versioned/typed/workflow/v1alpha1"
 	"k8s.io/client-go/kubernetes"
+	coordinationv1 "k8s.io/client-go/kubernetes/typed/coordination/v1"
 	k8sv1 "k8s.io/client-go/kubernetes/typed/core/v1"
 
 	// Load GCP auth plugin for k8s requests
@@ -16,6 +18,22 @@ import (
 	"k8s.io/client-go/tools/clientcmd"
 )
 
+// podNamespaceFile holds the namespace of the pod that the process runs in.
+const podNamespaceFile = "/var/run/secrets/kubernetes.io/serviceaccount/namespace"
+
+// PodNamespace returns the namespace of the pod that the process runs in, or
+// the given fallback when not running in a pod.
+func PodNamespace(fallback string) string {
+	data, err := os.ReadFile(podNamespaceFile)
+	if err != nil {
+		return fallback
+	}
+	if namespace := strings.TrimSpace(string(data)); namespace != "" {
+		return namespace
+	}
+	return fallback
+}
+
 // GetK8sWorkflowsClient provides access to argo workflows
 func GetK8sWorkflowsClient(workflowNamespace string) (workflowv1.WorkflowInterface, error) {
 	config, err := restConfig()
@@ -49,6 +67,15 @@ func GetK8sConfigMapClient(namespace string) (k8sv1.ConfigMapInterface, error) {
 	return client.CoreV1().ConfigMaps(namespace), nil
 }
 
+// GetK8sCoordinationClient provides access to leases
+func GetK8sCoordinationClient() (coordinationv1.CoordinationV1Interface, error) {
+	client, err := getGenericK8sClient()
+	if err != nil {
+		return nil, err
+	}
+	return client.CoordinationV1(), nil
+}
+
 func getGenericK8sClient() (*kubernetes.Clientset, error) {
 	config, err := restConfig()
 	if err != nil {
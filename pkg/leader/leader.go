@@ -0,0 +1,196 @@
+// Package leader elects the single infra-server replica that runs the
+// background reconcilers, while every replica keeps serving the APIs.
+package leader
+
+import (
+	"context"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stackrox/infra/pkg/config"
+	"github.com/stackrox/infra/pkg/kube"
+	"github.com/stackrox/infra/pkg/logging"
+	"github.com/stackrox/infra/pkg/service/metrics"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/uuid"
+	"k8s.io/client-go/tools/leaderelection"
+	"k8s.io/client-go/tools/leaderelection/resourcelock"
+)
+
+const (
+	defaultNamespace     = "infra"
+	defaultLeaseName     = "infra-server"
+	defaultLeaseDuration = 15 * time.Second
+	defaultRenewDeadline = 10 * time.Second
+	defaultRetryPeriod   = 2 * time.Second
+)
+
+var log = logging.CreateProductionLogger()
+
+// Status represents the leadership state of this replica.
+type Status struct {
+	// Enabled is false when leader election is not configured, in which case
+	// this replica always leads.
+	Enabled bool `json:"enabled"`
+
+	// Identity is the identity of this replica.
+	Identity string `json:"identity"`
+
+	// Leader is true if this replica currently leads.
+	Leader bool `json:"leader"`
+
+	// CurrentLeader is the identity of the replica that currently leads, if
+	// known.
+	CurrentLeader string `json:"currentLeader,omitempty"`
+}
+
+// Elector tracks whether this replica is the leader.
+type Elector struct {
+	cfg      *leaderelection.LeaderElectionConfig
+	identity string
+
+	lock          sync.RWMutex
+	leading       bool
+	currentLeader string
+	onElected     []func()
+}
+
+// New creates an Elector from the given configuration. When no configuration
+// is given, leader election is disabled and this replica always leads.
+func New(cfg *config.LeaderElectionConfig) (*Elector, error) {
+	identity, err := os.Hostname()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to determine leader election identity")
+	}
+	identity = identity + "_" + string(uuid.NewUUID())
+
+	if cfg == nil {
+		log.Log(logging.INFO, "leader election is disabled, running all background reconcilers")
+		metrics.LeaderGauge.Set(1)
+		return &Elector{identity: identity, leading: true, currentLeader: identity}, nil
+	}
+
+	client, err := kube.GetK8sCoordinationClient()
+	if err != nil {
+		return nil, err
+	}
+
+	e := &Elector{identity: identity}
+	e.cfg = &leaderelection.LeaderElectionConfig{
+		Lock: &resourcelock.LeaseLock{
+			LeaseMeta: metav1.ObjectMeta{
+				Namespace: withDefault(cfg.Namespace, defaultNamespace),
+				Name:      withDefault(cfg.LeaseName, defaultLeaseName),
+			},
+			Client: client,
+			LockConfig: resourcelock.ResourceLockConfig{
+				Identity: identity,
+			},
+		},
+		LeaseDuration:   durationWithDefault(cfg.LeaseDuration, defaultLeaseDuration),
+		RenewDeadline:   durationWithDefault(cfg.RenewDeadline, defaultRenewDeadline),
+		RetryPeriod:     durationWithDefault(cfg.RetryPeriod, defaultRetryPeriod),
+		ReleaseOnCancel: true,
+		Name:            withDefault(cfg.LeaseName, defaultLeaseName),
+		Callbacks: leaderelection.LeaderCallbacks{
+			OnStartedLeading: func(_ context.Context) {
+				e.setLeading(true)
+			},
+			OnStoppedLeading: func() {
+				e.setLeading(false)
+			},
+			OnNewLeader: func(identity string) {
+				e.lock.Lock()
+				e.currentLeader = identity
+				e.lock.Unlock()
+				log.Log(logging.INFO, "observed new leader", "leader", identity)
+			},
+		},
+	}
+
+	// Validate the configuration up front, rather than when running.
+	if _, err := leaderelection.NewLeaderElector(*e.cfg); err != nil {
+		return nil, errors.Wrap(err, "invalid leader election configuration")
+	}
+
+	return e, nil
+}
+
+func withDefault(value string, fallback string) string {
+	if value == "" {
+		return fallback
+	}
+	return value
+}
+
+func durationWithDefault(value config.JSONDuration, fallback time.Duration) time.Duration {
+	if value <= 0 {
+		return fallback
+	}
+	return value.Duration()
+}
+
+// Run campaigns for leadership until the given context is done. Leadership
+// that is lost is campaigned for again.
+func (e *Elector) Run(ctx context.Context) {
+	if e.cfg == nil {
+		return
+	}
+
+	for ctx.Err() == nil {
+		leaderelection.RunOrDie(ctx, *e.cfg)
+	}
+}
+
+// IsLeader reports whether this replica currently leads.
+func (e *Elector) IsLeader() bool {
+	e.lock.RLock()
+	defer e.lock.RUnlock()
+	return e.leading
+}
+
+// OnElected registers a function that is called every time this replica
+// becomes the leader. It is called immediately if this replica already leads.
+func (e *Elector) OnElected(fn func()) {
+	e.lock.Lock()
+	e.onElected = append(e.onElected, fn)
+	leading := e.leading
+	e.lock.Unlock()
+
+	if leading {
+		go fn()
+	}
+}
+
+// Status returns the leadership state of this replica.
+func (e *Elector) Status() Status {
+	e.lock.RLock()
+	defer e.lock.RUnlock()
+	return Status{
+		Enabled:       e.cfg != nil,
+		Identity:      e.identity,
+		Leader:        e.leading,
+		CurrentLeader: e.currentLeader,
+	}
+}
+
+func (e *Elector) setLeading(leading bool) {
+	e.lock.Lock()
+	e.leading = leading
+	callbacks := e.onElected
+	e.lock.Unlock()
+
+	if leading {
+		log.Log(logging.INFO, "started leading, running background reconcilers", "identity", e.identity)
+		metrics.LeaderGauge.Set(1)
+		for _, fn := range callbacks {
+			go fn()
+		}
+		return
+	}
+
+	log.Log(logging.INFO, "stopped leading, pausing background reconcilers", "identity", e.identity)
+	metrics.LeaderGauge.Set(0)
+}
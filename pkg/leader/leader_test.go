@@ -0,0 +1,45 @@
+package leader
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDisabledElectorAlwaysLeads(t *testing.T) {
+	elector, err := New(nil)
+	require.NoError(t, err)
+
+	assert.True(t, elector.IsLeader())
+
+	status := elector.Status()
+	assert.False(t, status.Enabled)
+	assert.True(t, status.Leader)
+	assert.Equal(t, status.Identity, status.CurrentLeader)
+
+	elected := make(chan struct{})
+	elector.OnElected(func() { close(elected) })
+	select {
+	case <-elected:
+	case <-time.After(time.Second):
+		t.Fatal("OnElected was not called for the leader")
+	}
+}
+
+func TestSetLeading(t *testing.T) {
+	elector := &Elector{identity: "replica"}
+	assert.False(t, elector.IsLeader())
+
+	elected := make(chan struct{}, 1)
+	elector.OnElected(func() { elected <- struct{}{} })
+
+	elector.setLeading(true)
+	assert.True(t, elector.IsLeader())
+	<-elected
+
+	elector.setLeading(false)
+	assert.False(t, elector.IsLeader())
+	assert.False(t, elector.Status().Leader)
+}
@@ -3,6 +3,7 @@ package logging
 
 import (
 	"log"
+	"sync"
 
 	"go.uber.org/zap"
 )
@@ -88,8 +89,31 @@ func (l *Logger) Log(logLevel LogLevel, msg string, keysAndValues ...interface{}
 	method(msg, keysAndValues...)
 }
 
+// AuditRecorder receives every audit event in addition to the log.
+type AuditRecorder func(phase string, msg string, keysAndValues ...interface{})
+
+var (
+	auditRecorderLock sync.RWMutex
+	auditRecorder     AuditRecorder
+)
+
+// SetAuditRecorder sets the recorder that receives every audit event logged
+// by any Logger.
+func SetAuditRecorder(recorder AuditRecorder) {
+	auditRecorderLock.Lock()
+	defer auditRecorderLock.Unlock()
+	auditRecorder = recorder
+}
+
 // AuditLog is a prepared wrapper to harmonize the audit logging format.
 func (l *Logger) AuditLog(logLevel LogLevel, phase string, msg string, keysAndValues ...interface{}) {
+	auditRecorderLock.RLock()
+	recorder := auditRecorder
+	auditRecorderLock.RUnlock()
+	if recorder != nil {
+		recorder(phase, msg, keysAndValues...)
+	}
+
 	keysAndValues = append(keysAndValues, "log-type", "audit", "phase", phase)
 	l.Log(logLevel, msg, keysAndValues...)
 }
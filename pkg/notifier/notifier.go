@@ -0,0 +1,101 @@
+// Package notifier handles delivering cluster lifecycle notifications to
+// sinks such as Slack or HTTP webhooks.
+package notifier
+
+import (
+	v1 "github.com/stackrox/infra/generated/api/v1"
+	"github.com/stackrox/infra/pkg/logging"
+)
+
+// Status represents which lifecycle stage a cluster has most recently sent a
+// notification for.
+type Status string
+
+const (
+	// StatusSkip is for when cluster should not result in notifications.
+	StatusSkip Status = "skip"
+
+	// StatusFailed is for when a cluster has failed.
+	StatusFailed Status = "failed"
+
+	// StatusDestroyed is for when a cluster is being deleted.
+	StatusDestroyed Status = "destroyed"
+
+	// StatusReady is for when a cluster is ready.
+	StatusReady Status = "ready"
+
+	// StatusNearingExpiry is for when a cluster is close to expiry.
+	StatusNearingExpiry Status = "nearing_expiry"
+
+	// StatusCreating is for when a cluster is being created.
+	StatusCreating Status = "creating"
+)
+
+var log = logging.CreateProductionLogger()
+
+// Event represents a single cluster lifecycle notification.
+type Event struct {
+	// Status is the lifecycle stage that the cluster has transitioned to.
+	Status Status
+
+	// Cluster is the cluster that the notification is about.
+	Cluster *v1.Cluster
+
+	// Scheduled is true if the cluster was created by a scheduled event.
+	Scheduled bool
+
+	// SlackDM is true if the owner asked for Slack direct messages instead
+	// of channel messages.
+	SlackDM bool
+
+	// FailureDetails describes why the cluster failed, if it did.
+	FailureDetails string
+}
+
+// Notifier represents a sink that cluster lifecycle notifications are
+// delivered to.
+type Notifier interface {
+	// Name uniquely identifies the sink. It is used to track the delivery
+	// state of the sink for each cluster.
+	Name() string
+
+	// Notify delivers the given event to the sink.
+	Notify(event Event) error
+}
+
+// Transition determines the lifecycle stage that a sink should be in given
+// the current cluster state, and whether a notification should be delivered
+// for reaching it.
+func Transition(wfStatus v1.Status, clusterIsNearingExpiry bool, status Status) (Status, bool) {
+	switch {
+	case status == StatusSkip:
+		return StatusSkip, false
+
+	case wfStatus == v1.Status_FAILED && status != StatusFailed:
+		return StatusFailed, true
+
+	case (wfStatus == v1.Status_DESTROYING || wfStatus == v1.Status_FINISHED) && status != StatusDestroyed:
+		return StatusDestroyed, true
+
+	case wfStatus == v1.Status_READY && status != StatusReady && status != StatusNearingExpiry:
+		return StatusReady, true
+
+	case wfStatus == v1.Status_READY && status == StatusReady && clusterIsNearingExpiry:
+		return StatusNearingExpiry, true
+
+	case wfStatus == v1.Status_READY && status == StatusNearingExpiry && !clusterIsNearingExpiry:
+		return StatusReady, false
+
+	case wfStatus == v1.Status_CREATING && status != StatusCreating:
+		return StatusCreating, true
+
+	default:
+		return status, false
+	}
+}
+
+// IsComplete once a sink has reached any of these states it will require no
+// more notifications.
+func IsComplete(status Status) bool {
+	return status == StatusSkip || status == StatusFailed || status == StatusDestroyed
+}
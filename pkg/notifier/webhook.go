@@ -0,0 +1,375 @@
+package notifier
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"slices"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stackrox/infra/pkg/config"
+	"github.com/stackrox/infra/pkg/logging"
+)
+
+const (
+	// formatJSON sends the payload as plain JSON.
+	formatJSON = "json"
+
+	// formatCloudEvents sends the payload as a CloudEvents 1.0 structured
+	// mode JSON event.
+	formatCloudEvents = "cloudevents"
+
+	// cloudEventsSource is the source of every CloudEvents event.
+	cloudEventsSource = "infra.stackrox.com"
+
+	// cloudEventsTypePrefix is prepended to the lifecycle stage to form the
+	// CloudEvents event type.
+	cloudEventsTypePrefix = "com.stackrox.infra.cluster."
+
+	// signatureHeader is the header containing the HMAC-SHA256 signature of
+	// the payload.
+	signatureHeader = "X-Infra-Signature"
+
+	// defaultWebhookRetries is the number of retries when none are
+	// configured.
+	defaultWebhookRetries = 3
+
+	// webhookTimeout is how long a single delivery attempt may take.
+	webhookTimeout = 10 * time.Second
+
+	// webhookRetryBackoff is how long to wait before the first retry. The
+	// wait doubles for every subsequent retry.
+	webhookRetryBackoff = time.Second
+
+	// webhookQueueSize is how many notifications may wait for delivery to a
+	// single webhook. Further notifications are rejected until the queue
+	// drains.
+	webhookQueueSize = 100
+
+	// reservedName is the name of the Slack sink configured from the
+	// top-level slack configuration.
+	reservedName = "slack"
+)
+
+var (
+	webhookNameRegex = regexp.MustCompile(`^[a-z0-9]([-a-z0-9]{0,38}[a-z0-9])?$`)
+
+	webhookStatuses = []Status{StatusCreating, StatusReady, StatusNearingExpiry, StatusFailed, StatusDestroyed} //nolint:gochecknoglobals
+)
+
+// Webhook is a Notifier that POSTs signed JSON or CloudEvents payloads to an
+// HTTP endpoint.
+type Webhook struct {
+	name     string
+	url      string
+	secret   []byte
+	format   string
+	statuses []Status
+	retries  int
+	backoff  time.Duration
+	client   *http.Client
+
+	// queue holds the notifications waiting for delivery, so that retries
+	// do not block the caller.
+	queue chan delivery
+}
+
+// delivery is a notification waiting for delivery to a webhook.
+type delivery struct {
+	status      Status
+	clusterID   string
+	body        []byte
+	contentType string
+}
+
+var _ Notifier = (*Webhook)(nil)
+
+// NewWebhooks constructs a Webhook for every webhook in the given
+// configuration.
+func NewWebhooks(cfg *config.NotificationsConfig) ([]Notifier, error) {
+	if cfg == nil {
+		return nil, nil
+	}
+
+	notifiers := make([]Notifier, 0, len(cfg.Webhooks))
+	names := make(map[string]struct{}, len(cfg.Webhooks))
+	for _, webhookCfg := range cfg.Webhooks {
+		if _, found := names[webhookCfg.Name]; found {
+			return nil, fmt.Errorf("duplicate webhook name %q", webhookCfg.Name)
+		}
+		names[webhookCfg.Name] = struct{}{}
+
+		webhook, err := NewWebhook(webhookCfg)
+		if err != nil {
+			return nil, err
+		}
+		notifiers = append(notifiers, webhook)
+	}
+
+	return notifiers, nil
+}
+
+// NewWebhook constructs a Webhook from the given configuration, which
+// delivers notifications in the background.
+func NewWebhook(cfg config.WebhookConfig) (*Webhook, error) {
+	webhook, err := newWebhook(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	go webhook.run()
+
+	return webhook, nil
+}
+
+func newWebhook(cfg config.WebhookConfig) (*Webhook, error) {
+	if !webhookNameRegex.MatchString(cfg.Name) || cfg.Name == reservedName {
+		return nil, fmt.Errorf("invalid webhook name %q", cfg.Name)
+	}
+
+	u, err := url.Parse(cfg.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, fmt.Errorf("webhook %q has invalid url %q", cfg.Name, cfg.URL)
+	}
+
+	format := cfg.Format
+	switch format {
+	case "":
+		format = formatJSON
+	case formatJSON, formatCloudEvents:
+	default:
+		return nil, fmt.Errorf("webhook %q has unknown format %q", cfg.Name, cfg.Format)
+	}
+
+	statuses := webhookStatuses
+	if len(cfg.Statuses) > 0 {
+		statuses = make([]Status, 0, len(cfg.Statuses))
+		for _, status := range cfg.Statuses {
+			if !slices.Contains(webhookStatuses, Status(status)) {
+				return nil, fmt.Errorf("webhook %q has unknown status %q", cfg.Name, status)
+			}
+			statuses = append(statuses, Status(status))
+		}
+	}
+
+	retries := defaultWebhookRetries
+	if cfg.Retries != nil {
+		if *cfg.Retries < 0 {
+			return nil, fmt.Errorf("webhook %q has negative retries", cfg.Name)
+		}
+		retries = *cfg.Retries
+	}
+
+	log.Log(logging.INFO, "enabled webhook notifications", "webhook-name", cfg.Name, "format", format)
+
+	return &Webhook{
+		name:     cfg.Name,
+		url:      cfg.URL,
+		secret:   []byte(cfg.Secret),
+		format:   format,
+		statuses: statuses,
+		retries:  retries,
+		backoff:  webhookRetryBackoff,
+		client:   &http.Client{Timeout: webhookTimeout},
+		queue:    make(chan delivery, webhookQueueSize),
+	}, nil
+}
+
+// Name implements Notifier.Name.
+func (w *Webhook) Name() string {
+	return w.name
+}
+
+// Notify implements Notifier.Notify. Events for lifecycle stages that the
+// webhook is not subscribed to are dropped. Other events are queued for
+// delivery, and an error is only returned if the queue is full.
+func (w *Webhook) Notify(event Event) error {
+	if !slices.Contains(w.statuses, event.Status) {
+		return nil
+	}
+
+	body, contentType, err := w.payload(event, time.Now())
+	if err != nil {
+		return err
+	}
+
+	select {
+	case w.queue <- delivery{
+		status:      event.Status,
+		clusterID:   event.Cluster.GetID(),
+		body:        body,
+		contentType: contentType,
+	}:
+		return nil
+	default:
+		return fmt.Errorf("failed to queue %s notification for webhook %q: too many pending notifications", event.Status, w.name)
+	}
+}
+
+// run delivers the queued notifications one at a time.
+func (w *Webhook) run() {
+	for d := range w.queue {
+		if err := w.send(d); err != nil {
+			log.Log(logging.ERROR, "failed to send webhook notification",
+				"webhook-name", w.name,
+				"cluster-id", d.clusterID,
+				"error", err,
+			)
+		}
+	}
+}
+
+// send delivers the given notification, and retries with an exponential
+// backoff for as long as the failures may be retried.
+func (w *Webhook) send(d delivery) error {
+	backoff := w.backoff
+	for attempt := 0; ; attempt++ {
+		retry, err := w.deliver(d.body, d.contentType)
+		if err == nil {
+			return nil
+		}
+		if !retry || attempt >= w.retries {
+			return errors.Wrapf(err, "failed to deliver %s notification to webhook %q", d.status, w.name)
+		}
+
+		log.Log(logging.WARN, "retrying webhook notification",
+			"webhook-name", w.name,
+			"cluster-id", d.clusterID,
+			"attempt", attempt+1,
+			"error", err,
+		)
+		time.Sleep(backoff)
+		backoff *= 2
+	}
+}
+
+// deliver makes a single delivery attempt, and reports if a failed attempt
+// may be retried.
+func (w *Webhook) deliver(body []byte, contentType string) (bool, error) {
+	req, err := http.NewRequest(http.MethodPost, w.url, bytes.NewReader(body))
+	if err != nil {
+		return false, err
+	}
+	req.Header.Set("Content-Type", contentType)
+	if len(w.secret) > 0 {
+		req.Header.Set(signatureHeader, Sign(w.secret, body))
+	}
+
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return true, err
+	}
+	defer resp.Body.Close() //nolint:errcheck
+
+	switch {
+	case resp.StatusCode >= 200 && resp.StatusCode < 300:
+		return false, nil
+	case resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500:
+		return true, fmt.Errorf("webhook responded with %s", resp.Status)
+	default:
+		return false, fmt.Errorf("webhook responded with %s", resp.Status)
+	}
+}
+
+// Sign returns the value of the X-Infra-Signature header for the given
+// payload, in the form "sha256=<hex encoded HMAC-SHA256>".
+func Sign(secret []byte, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(body) //nolint:errcheck
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// clusterPayload is the cluster representation sent to webhooks.
+type clusterPayload struct {
+	ID             string    `json:"id"`
+	Flavor         string    `json:"flavor"`
+	Owner          string    `json:"owner"`
+	Collaborators  []string  `json:"collaborators,omitempty"`
+	Description    string    `json:"description,omitempty"`
+	Status         string    `json:"status"`
+	URL            string    `json:"url,omitempty"`
+	CreatedOn      time.Time `json:"createdOn"`
+	ExpiresOn      time.Time `json:"expiresOn"`
+	Scheduled      bool      `json:"scheduled"`
+	FailureDetails string    `json:"failureDetails,omitempty"`
+}
+
+// jsonPayload is the payload sent to webhooks using the json format.
+type jsonPayload struct {
+	ID      string         `json:"id"`
+	Type    Status         `json:"type"`
+	Time    time.Time      `json:"time"`
+	Cluster clusterPayload `json:"cluster"`
+}
+
+// cloudEventPayload is the payload sent to webhooks using the cloudevents
+// format. See https://github.com/cloudevents/spec/blob/v1.0.2/cloudevents/spec.md.
+type cloudEventPayload struct {
+	SpecVersion     string         `json:"specversion"`
+	ID              string         `json:"id"`
+	Source          string         `json:"source"`
+	Type            string         `json:"type"`
+	Subject         string         `json:"subject"`
+	Time            time.Time      `json:"time"`
+	DataContentType string         `json:"datacontenttype"`
+	Data            clusterPayload `json:"data"`
+}
+
+// payload returns the body and content type to send for the given event.
+func (w *Webhook) payload(event Event, now time.Time) ([]byte, string, error) {
+	cluster := event.Cluster
+	data := clusterPayload{
+		ID:             cluster.GetID(),
+		Flavor:         cluster.GetFlavor(),
+		Owner:          cluster.GetOwner(),
+		Collaborators:  cluster.GetCollaborators(),
+		Description:    cluster.GetDescription(),
+		Status:         cluster.GetStatus().String(),
+		URL:            cluster.GetURL(),
+		CreatedOn:      cluster.GetCreatedOn().AsTime(),
+		ExpiresOn:      cluster.GetCreatedOn().AsTime().Add(cluster.GetLifespan().AsDuration()),
+		Scheduled:      event.Scheduled,
+		FailureDetails: event.FailureDetails,
+	}
+	id := fmt.Sprintf("%s-%s-%d", cluster.GetID(), event.Status, now.UnixNano())
+
+	var (
+		body        []byte
+		contentType string
+		err         error
+	)
+	switch w.format {
+	case formatCloudEvents:
+		contentType = "application/cloudevents+json"
+		body, err = json.Marshal(cloudEventPayload{
+			SpecVersion:     "1.0",
+			ID:              id,
+			Source:          cloudEventsSource,
+			Type:            cloudEventsTypePrefix + string(event.Status),
+			Subject:         cluster.GetID(),
+			Time:            now.UTC(),
+			DataContentType: "application/json",
+			Data:            data,
+		})
+	default:
+		contentType = "application/json"
+		body, err = json.Marshal(jsonPayload{
+			ID:      id,
+			Type:    event.Status,
+			Time:    now.UTC(),
+			Cluster: data,
+		})
+	}
+	if err != nil {
+		return nil, "", errors.Wrap(err, "failed to marshal webhook payload")
+	}
+
+	return body, contentType, nil
+}
@@ -0,0 +1,204 @@
+package notifier
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	v1 "github.com/stackrox/infra/generated/api/v1"
+	"github.com/stackrox/infra/pkg/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func intPtr(i int) *int {
+	return &i
+}
+
+func testEvent() Event {
+	return Event{
+		Status: StatusReady,
+		Cluster: &v1.Cluster{
+			ID:     "example-s3maj",
+			Flavor: "gke-default",
+			Owner:  "owner@example.com",
+			Status: v1.Status_READY,
+		},
+	}
+}
+
+func TestNewWebhook(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     config.WebhookConfig
+		wantErr string
+	}{
+		{
+			name: "valid",
+			cfg:  config.WebhookConfig{Name: "ci", URL: "https://example.com/hook", Format: "cloudevents", Statuses: []string{"ready"}},
+		},
+		{
+			name:    "invalid name",
+			cfg:     config.WebhookConfig{Name: "CI Hook", URL: "https://example.com/hook"},
+			wantErr: `invalid webhook name "CI Hook"`,
+		},
+		{
+			name:    "reserved name",
+			cfg:     config.WebhookConfig{Name: "slack", URL: "https://example.com/hook"},
+			wantErr: `invalid webhook name "slack"`,
+		},
+		{
+			name:    "invalid url",
+			cfg:     config.WebhookConfig{Name: "ci", URL: "example.com/hook"},
+			wantErr: `webhook "ci" has invalid url`,
+		},
+		{
+			name:    "unknown format",
+			cfg:     config.WebhookConfig{Name: "ci", URL: "https://example.com/hook", Format: "xml"},
+			wantErr: `webhook "ci" has unknown format "xml"`,
+		},
+		{
+			name:    "unknown status",
+			cfg:     config.WebhookConfig{Name: "ci", URL: "https://example.com/hook", Statuses: []string{"skip"}},
+			wantErr: `webhook "ci" has unknown status "skip"`,
+		},
+		{
+			name:    "negative retries",
+			cfg:     config.WebhookConfig{Name: "ci", URL: "https://example.com/hook", Retries: intPtr(-1)},
+			wantErr: `webhook "ci" has negative retries`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewWebhook(tt.cfg)
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestNewWebhooksDuplicateName(t *testing.T) {
+	_, err := NewWebhooks(&config.NotificationsConfig{Webhooks: []config.WebhookConfig{
+		{Name: "ci", URL: "https://example.com/one"},
+		{Name: "ci", URL: "https://example.com/two"},
+	}})
+	assert.ErrorContains(t, err, `duplicate webhook name "ci"`)
+}
+
+func TestWebhookNotify(t *testing.T) {
+	tests := []struct {
+		name          string
+		cfg           config.WebhookConfig
+		responses     []int
+		expectedCalls int32
+		wantErr       bool
+	}{
+		{
+			name:          "delivered",
+			cfg:           config.WebhookConfig{Secret: "secret"},
+			responses:     []int{http.StatusOK},
+			expectedCalls: 1,
+		},
+		{
+			name:          "delivered as cloudevent",
+			cfg:           config.WebhookConfig{Format: "cloudevents"},
+			responses:     []int{http.StatusAccepted},
+			expectedCalls: 1,
+		},
+		{
+			name:          "retried after server error",
+			responses:     []int{http.StatusInternalServerError, http.StatusTooManyRequests, http.StatusOK},
+			expectedCalls: 3,
+		},
+		{
+			name:          "gives up after retries",
+			cfg:           config.WebhookConfig{Retries: intPtr(1)},
+			responses:     []int{http.StatusBadGateway, http.StatusBadGateway, http.StatusOK},
+			expectedCalls: 2,
+			wantErr:       true,
+		},
+		{
+			name:          "client error is not retried",
+			responses:     []int{http.StatusBadRequest, http.StatusOK},
+			expectedCalls: 1,
+			wantErr:       true,
+		},
+		{
+			name:          "unsubscribed status is dropped",
+			cfg:           config.WebhookConfig{Statuses: []string{"destroyed"}},
+			expectedCalls: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var calls atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				call := calls.Add(1)
+				body, err := io.ReadAll(r.Body)
+				require.NoError(t, err)
+
+				if tt.cfg.Secret != "" {
+					assert.Equal(t, Sign([]byte(tt.cfg.Secret), body), r.Header.Get(signatureHeader))
+				} else {
+					assert.Empty(t, r.Header.Get(signatureHeader))
+				}
+
+				var payload map[string]any
+				require.NoError(t, json.Unmarshal(body, &payload))
+				if tt.cfg.Format == formatCloudEvents {
+					assert.Equal(t, "application/cloudevents+json", r.Header.Get("Content-Type"))
+					assert.Equal(t, "1.0", payload["specversion"])
+					assert.Equal(t, "com.stackrox.infra.cluster.ready", payload["type"])
+					assert.Equal(t, "example-s3maj", payload["subject"])
+				} else {
+					assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+					assert.Equal(t, "ready", payload["type"])
+					assert.Equal(t, "example-s3maj", payload["cluster"].(map[string]any)["id"])
+				}
+
+				w.WriteHeader(tt.responses[call-1])
+			}))
+			defer server.Close()
+
+			cfg := tt.cfg
+			cfg.Name = "test"
+			cfg.URL = server.URL
+			webhook, err := newWebhook(cfg)
+			require.NoError(t, err)
+			webhook.backoff = 0
+
+			require.NoError(t, webhook.Notify(testEvent()))
+			if tt.expectedCalls == 0 {
+				assert.Empty(t, webhook.queue)
+				return
+			}
+
+			err = webhook.send(<-webhook.queue)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.expectedCalls, calls.Load())
+		})
+	}
+}
+
+func TestWebhookNotifyQueueFull(t *testing.T) {
+	webhook, err := newWebhook(config.WebhookConfig{Name: "test", URL: "https://example.com"})
+	require.NoError(t, err)
+
+	// Nothing drains the queue of a webhook that is not running.
+	for range webhookQueueSize {
+		require.NoError(t, webhook.Notify(testEvent()))
+	}
+	assert.ErrorContains(t, webhook.Notify(testEvent()), "too many pending notifications")
+}
@@ -5,6 +5,7 @@ import (
 	"context"
 	"crypto/tls"
 	"crypto/x509"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"os"
@@ -19,8 +20,10 @@ import (
 	"github.com/pkg/errors"
 	"github.com/stackrox/infra/pkg/auth"
 	"github.com/stackrox/infra/pkg/config"
+	"github.com/stackrox/infra/pkg/leader"
 	"github.com/stackrox/infra/pkg/logging"
 	"github.com/stackrox/infra/pkg/service/middleware"
+	"github.com/stackrox/infra/pkg/tokenstore"
 	"golang.org/x/net/http2"
 	"golang.org/x/net/http2/h2c"
 	"google.golang.org/grpc"
@@ -35,14 +38,18 @@ type server struct {
 	services []middleware.APIService
 	cfg      config.Config
 	oidc     auth.OidcAuth
+	elector  *leader.Elector
+	tokens   *tokenstore.Store
 }
 
 // New creates a new server that is ready to be launched.
-func New(serverCfg config.Config, oidc auth.OidcAuth, services ...middleware.APIService) *server {
+func New(serverCfg config.Config, oidc auth.OidcAuth, elector *leader.Elector, tokens *tokenstore.Store, services ...middleware.APIService) *server {
 	return &server{
 		services: services,
 		cfg:      serverCfg,
 		oidc:     oidc,
+		elector:  elector,
+		tokens:   tokens,
 	}
 }
 
@@ -75,19 +82,33 @@ func (s *server) RunServer() (<-chan error, error) {
 			// Extract user from JWT token stored in HTTP cookie.
 			middleware.ContextInterceptor(middleware.UserEnricher(s.oidc)),
 			// Extract service-account from token stored in Authorization header.
-			middleware.ContextInterceptor(middleware.ServiceAccountEnricher(s.oidc.ValidateServiceAccountToken)),
+			middleware.ContextInterceptor(middleware.ServiceAccountEnricher(s.oidc.ValidateServiceAccountToken, s.tokens.IsRevoked)),
 
 			middleware.ContextInterceptor(middleware.AdminEnricher(s.cfg.Password)),
+			// Resolve the roles granted to the authenticated principal.
+			middleware.ContextInterceptor(middleware.RoleEnricher(s.cfg.RBAC)),
 			// Enforce authenticated user access on resources that declare it.
 			middleware.ContextInterceptor(middleware.EnforceAccess),
 
 			// Collect and expose Prometheus metrics
 			grpc_prometheus.UnaryServerInterceptor,
 		)),
-		grpc.StreamInterceptor(
+		// Streams are authenticated and authorized like unary calls.
+		grpc.StreamInterceptor(grpc_middleware.ChainStreamServer(
+			// Extract user from JWT token stored in HTTP cookie.
+			middleware.ContextStreamInterceptor(middleware.UserEnricher(s.oidc)),
+			// Extract service-account from token stored in Authorization header.
+			middleware.ContextStreamInterceptor(middleware.ServiceAccountEnricher(s.oidc.ValidateServiceAccountToken, s.tokens.IsRevoked)),
+
+			middleware.ContextStreamInterceptor(middleware.AdminEnricher(s.cfg.Password)),
+			// Resolve the roles granted to the authenticated principal.
+			middleware.ContextStreamInterceptor(middleware.RoleEnricher(s.cfg.RBAC)),
+			// Enforce authenticated user access on resources that declare it.
+			middleware.ContextStreamInterceptor(middleware.EnforceAccess),
+
 			// Collect and expose Prometheus metrics
 			grpc_prometheus.StreamServerInterceptor,
-		),
+		)),
 	)
 
 	// Register the gRPC API service.
@@ -173,8 +194,14 @@ func (s *server) RunServer() (<-chan error, error) {
 
 	// Dedicated health endpoint for Kubernetes readiness probes.
 	// Bypasses authentication and redirects to prevent probe timeouts.
+	// Every replica is healthy, whether or not it leads.
 	mux.HandleFunc("/healthz", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
 		w.WriteHeader(http.StatusOK)
+		_ = json.NewEncoder(w).Encode(healthDetail{
+			Status: "ok",
+			Leader: s.elector.Status(),
+		})
 	})
 
 	mux.Handle("/",
@@ -189,6 +216,12 @@ func (s *server) RunServer() (<-chan error, error) {
 	return errCh, nil
 }
 
+// healthDetail is the body of the /healthz response.
+type healthDetail struct {
+	Status string        `json:"status"`
+	Leader leader.Status `json:"leader"`
+}
+
 // serveApplicationResources handles requests for SPA endpoints as well as
 // regular resources.
 func serveApplicationResources(dir string, oidc auth.OidcAuth) http.Handler {
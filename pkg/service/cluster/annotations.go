@@ -1,9 +1,12 @@
 package cluster
 
 import (
+	"strings"
 	"time"
 
 	"github.com/golang/protobuf/ptypes/duration"
+	"github.com/stackrox/infra/pkg/notifier"
+	"github.com/stackrox/infra/pkg/slack"
 	"google.golang.org/protobuf/types/known/durationpb"
 )
 
@@ -31,6 +34,15 @@ const (
 
 	// use slack direct messages instead of a channel
 	annotationSlackDMKey = "infra.stackrox.com/slackdm"
+
+	// annotationNotificationPrefix is the prefix of the k8s annotations that
+	// contain the notification phase of each notification sink other than
+	// Slack.
+	annotationNotificationPrefix = "infra.stackrox.com/notification-"
+
+	// annotationCollaboratorsKey is the k8s annotation that contains the
+	// comma separated collaborator email addresses.
+	annotationCollaboratorsKey = "infra.stackrox.com/collaborators"
 )
 
 // Annotated represents a type that has annotations.
@@ -83,3 +95,28 @@ func GetSlack(a Annotated) string {
 func GetSlackDM(a Annotated) bool {
 	return a.GetAnnotations()[annotationSlackDMKey] == "yes"
 }
+
+// notificationAnnotationKey returns the k8s annotation that contains the
+// notification phase of the named notification sink. Slack keeps using its
+// original annotation.
+func notificationAnnotationKey(sink string) string {
+	if sink == slack.NotifierName {
+		return annotationSlackKey
+	}
+	return annotationNotificationPrefix + sink
+}
+
+// GetNotificationStatus returns the notification phase of the named
+// notification sink if it exists.
+func GetNotificationStatus(a Annotated, sink string) notifier.Status {
+	return notifier.Status(a.GetAnnotations()[notificationAnnotationKey(sink)])
+}
+
+// GetCollaborators returns the collaborator email addresses if they exist.
+func GetCollaborators(a Annotated) []string {
+	value := a.GetAnnotations()[annotationCollaboratorsKey]
+	if value == "" {
+		return nil
+	}
+	return strings.Split(value, ",")
+}
@@ -0,0 +1,152 @@
+package cluster
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	v1 "github.com/stackrox/infra/generated/api/v1"
+	"github.com/stackrox/infra/pkg/audit"
+	"github.com/stackrox/infra/pkg/service/middleware"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+const (
+	// defaultAuditListLimit is the number of events returned when no limit
+	// is requested.
+	defaultAuditListLimit = 100
+
+	// maxAuditListLimit is the maximum number of events returned at once.
+	maxAuditListLimit = 1000
+)
+
+type auditImpl struct {
+	v1.UnimplementedAuditServiceServer
+	cluster *clusterImpl
+	sink    audit.Sink
+}
+
+var (
+	_ middleware.APIService = (*auditImpl)(nil)
+	_ v1.AuditServiceServer = (*auditImpl)(nil)
+)
+
+// NewAuditService creates a new AuditService, which lists the events
+// persisted in the given sink. The ClusterService determines which clusters
+// are owned by the caller.
+func NewAuditService(clusterService middleware.APIService, sink audit.Sink) (middleware.APIService, error) {
+	cluster, ok := clusterService.(*clusterImpl)
+	if !ok {
+		return nil, errors.New("audit service requires a cluster service")
+	}
+
+	return &auditImpl{
+		cluster: cluster,
+		sink:    sink,
+	}, nil
+}
+
+// List implements AuditService.List.
+func (s *auditImpl) List(ctx context.Context, req *v1.AuditListRequest) (*v1.AuditListResponse, error) {
+	if req.GetLimit() < 0 {
+		return nil, status.Error(codes.InvalidArgument, "limit must not be negative")
+	}
+
+	limit := int(req.GetLimit())
+	switch {
+	case limit == 0:
+		limit = defaultAuditListLimit
+	case limit > maxAuditListLimit:
+		limit = maxAuditListLimit
+	}
+
+	filter := audit.Filter{
+		Actor:     req.GetActor(),
+		ClusterID: req.GetClusterID(),
+		Phase:     req.GetPhase(),
+		Limit:     limit,
+	}
+	if req.GetSince() != nil {
+		filter.Since = req.GetSince().AsTime()
+	}
+	if req.GetUntil() != nil {
+		filter.Until = req.GetUntil().AsTime()
+	}
+
+	visible, err := s.visibility(ctx)
+	if err != nil {
+		return nil, err
+	}
+	filter.VisibleTo = visible
+
+	events, err := s.sink.List(ctx, filter)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list audit events: %v", err)
+	}
+
+	resp := &v1.AuditListResponse{}
+	for _, event := range events {
+		resp.Events = append(resp.Events, &v1.AuditEvent{
+			Time:      timestamppb.New(event.Time),
+			Actor:     event.Actor,
+			Phase:     event.Phase,
+			ClusterID: event.ClusterID,
+			Message:   event.Message,
+			Details:   event.Details,
+		})
+	}
+
+	return resp, nil
+}
+
+// visibility returns the events visible to the caller, or nil when every
+// event is. Admins see every event, while others see their own operations
+// and the operations on clusters they own.
+func (s *auditImpl) visibility(ctx context.Context) (*audit.Visibility, error) {
+	if middleware.HasAccess(ctx, middleware.Admin) {
+		return nil, nil
+	}
+
+	owner, err := middleware.GetOwnerFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	selector := labels.SelectorFromSet(labels.Set{labelOwner: emailToLabelValue(owner)})
+	workflows, err := s.cluster.listWorkflows(selector)
+	if err != nil {
+		return nil, err
+	}
+
+	visible := &audit.Visibility{Actor: owner}
+	for i := range workflows {
+		clusterID := getClusterIDFromWorkflow(&workflows[i])
+		if !slices.Contains(visible.ClusterIDs, clusterID) {
+			visible.ClusterIDs = append(visible.ClusterIDs, clusterID)
+		}
+	}
+
+	return visible, nil
+}
+
+// Access configures access for this service.
+func (s *auditImpl) Access() map[string]middleware.Access {
+	return map[string]middleware.Access{
+		"/v1.AuditService/List": middleware.Authenticated,
+	}
+}
+
+// RegisterServiceServer registers this service with the given gRPC Server.
+func (s *auditImpl) RegisterServiceServer(server *grpc.Server) {
+	v1.RegisterAuditServiceServer(server, s)
+}
+
+// RegisterServiceHandler registers this service with the given gRPC Gateway endpoint.
+func (s *auditImpl) RegisterServiceHandler(ctx context.Context, mux *runtime.ServeMux, conn *grpc.ClientConn) error {
+	return v1.RegisterAuditServiceHandler(ctx, mux, conn)
+}
@@ -0,0 +1,195 @@
+package cluster
+
+import (
+	"context"
+	"sync"
+
+	v1 "github.com/stackrox/infra/generated/api/v1"
+	"github.com/stackrox/infra/pkg/logging"
+	"github.com/stackrox/infra/pkg/service/middleware"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// bulkConcurrency is the number of clusters that a bulk operation
+	// processes at once.
+	bulkConcurrency = 8
+
+	// maxBulkClusters is the maximum number of clusters of a single bulk
+	// operation.
+	maxBulkClusters = 200
+)
+
+// BulkDelete implements ClusterService.BulkDelete.
+func (s *clusterImpl) BulkDelete(ctx context.Context, req *v1.BulkDeleteRequest) (*v1.BulkResponse, error) {
+	owner, err := middleware.GetOwnerFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	clusterIDs, err := s.bulkClusterIDs(ctx, req.GetIds(), req.GetFilter())
+	if err != nil {
+		return nil, err
+	}
+	log.AuditLog(logging.INFO, "cluster-bulk-delete", "received a bulk delete request for infra clusters",
+		"actor", owner,
+		"cluster-ids", clusterIDs,
+		"dry-run", req.GetDryRun(),
+		"force", req.GetForce(),
+		"reason", req.GetReason(),
+	)
+
+	// Every cluster is deleted through Delete, which audit logs it.
+	results := runBulk(clusterIDs, func(clusterID string) *v1.BulkResult {
+		if req.GetDryRun() {
+			return bulkResult(clusterID, s.checkBulkCluster(ctx, clusterID, req.GetForce(), req.GetReason()))
+		}
+
+		_, err := s.Delete(ctx, &v1.DeleteClusterRequest{
+			Id:     clusterID,
+			Force:  req.GetForce(),
+			Reason: req.GetReason(),
+		})
+		return bulkResult(clusterID, err)
+	})
+
+	return &v1.BulkResponse{Results: results, DryRun: req.GetDryRun()}, nil
+}
+
+// BulkLifespan implements ClusterService.BulkLifespan.
+func (s *clusterImpl) BulkLifespan(ctx context.Context, req *v1.BulkLifespanRequest) (*v1.BulkResponse, error) {
+	owner, err := middleware.GetOwnerFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.GetLifespan() == nil {
+		return nil, status.Error(codes.InvalidArgument, "no lifespan given")
+	}
+
+	clusterIDs, err := s.bulkClusterIDs(ctx, req.GetIds(), req.GetFilter())
+	if err != nil {
+		return nil, err
+	}
+	log.AuditLog(logging.INFO, "cluster-bulk-lifespan", "received a bulk lifespan update request for infra clusters",
+		"actor", owner,
+		"cluster-ids", clusterIDs,
+		"lifespan-update-method", req.GetMethod().String(),
+		"lifespan", req.GetLifespan().String(),
+		"dry-run", req.GetDryRun(),
+		"force", req.GetForce(),
+		"reason", req.GetReason(),
+	)
+
+	// Every lifespan is updated through Lifespan, which audit logs it.
+	results := runBulk(clusterIDs, func(clusterID string) *v1.BulkResult {
+		if req.GetDryRun() {
+			return bulkResult(clusterID, s.checkBulkCluster(ctx, clusterID, req.GetForce(), req.GetReason()))
+		}
+
+		updated, err := s.Lifespan(ctx, &v1.LifespanRequest{
+			Id:       clusterID,
+			Lifespan: req.GetLifespan(),
+			Method:   req.GetMethod(),
+			Force:    req.GetForce(),
+			Reason:   req.GetReason(),
+		})
+		result := bulkResult(clusterID, err)
+		result.Lifespan = updated
+		return result
+	})
+
+	return &v1.BulkResponse{Results: results, DryRun: req.GetDryRun()}, nil
+}
+
+// bulkClusterIDs returns the IDs of the clusters of a bulk operation, which
+// are either given explicitly, or selected by a filter.
+func (s *clusterImpl) bulkClusterIDs(ctx context.Context, ids []string, filter *v1.ClusterListRequest) ([]string, error) {
+	switch {
+	case len(ids) > 0 && filter != nil:
+		return nil, status.Error(codes.InvalidArgument, "either cluster IDs or a filter must be given, not both")
+	case len(ids) == 0 && filter == nil:
+		return nil, status.Error(codes.InvalidArgument, "no cluster IDs or filter given")
+	}
+
+	if filter != nil {
+		resp, err := s.List(ctx, filter)
+		if err != nil {
+			return nil, err
+		}
+		for _, cluster := range resp.GetClusters() {
+			ids = append(ids, cluster.GetID())
+		}
+	}
+
+	return uniqueBulkClusterIDs(ids)
+}
+
+// uniqueBulkClusterIDs removes duplicates from the given cluster IDs, and
+// returns a codes.InvalidArgument error if there are too many.
+func uniqueBulkClusterIDs(ids []string) ([]string, error) {
+	seen := make(map[string]struct{}, len(ids))
+	var unique []string
+	for _, id := range ids {
+		if id == "" {
+			return nil, status.Error(codes.InvalidArgument, "empty cluster ID given")
+		}
+		if _, found := seen[id]; found {
+			continue
+		}
+		seen[id] = struct{}{}
+		unique = append(unique, id)
+	}
+
+	if len(unique) > maxBulkClusters {
+		return nil, status.Errorf(codes.InvalidArgument, "%d clusters selected, at most %d are allowed at once", len(unique), maxBulkClusters)
+	}
+
+	return unique, nil
+}
+
+// checkBulkCluster returns the error that an operation on the given cluster
+// would fail with, for dry runs.
+func (s *clusterImpl) checkBulkCluster(ctx context.Context, clusterID string, force bool, reason string) error {
+	workflow, err := s.getMostRecentArgoWorkflowFromClusterID(clusterID)
+	if err != nil {
+		return err
+	}
+
+	_, err = checkOwnerOrForce(ctx, workflow, force, reason)
+	return err
+}
+
+// runBulk calls fn for every cluster ID, with at most bulkConcurrency calls
+// at once, and returns the results in the order of the IDs.
+func runBulk(clusterIDs []string, fn func(clusterID string) *v1.BulkResult) []*v1.BulkResult {
+	results := make([]*v1.BulkResult, len(clusterIDs))
+	slots := make(chan struct{}, bulkConcurrency)
+
+	var wg sync.WaitGroup
+	for i, clusterID := range clusterIDs {
+		slots <- struct{}{}
+		wg.Add(1)
+		go func() {
+			defer func() {
+				<-slots
+				wg.Done()
+			}()
+			results[i] = fn(clusterID)
+		}()
+	}
+	wg.Wait()
+
+	return results
+}
+
+// bulkResult returns the result of an operation on the given cluster that
+// failed with the given error, if any.
+func bulkResult(clusterID string, err error) *v1.BulkResult {
+	result := &v1.BulkResult{Id: clusterID}
+	if err != nil {
+		result.Error = status.Convert(err).Message()
+	}
+	return result
+}
@@ -0,0 +1,94 @@
+package cluster
+
+import (
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	v1 "github.com/stackrox/infra/generated/api/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestUniqueBulkClusterIDs(t *testing.T) {
+	tooMany := make([]string, maxBulkClusters+1)
+	for i := range tooMany {
+		tooMany[i] = fmt.Sprintf("cluster-%d", i)
+	}
+
+	tests := []struct {
+		name     string
+		ids      []string
+		expected []string
+		code     codes.Code
+	}{
+		{
+			name:     "unique",
+			ids:      []string{"a", "b", "c"},
+			expected: []string{"a", "b", "c"},
+		},
+		{
+			name:     "duplicates",
+			ids:      []string{"a", "b", "a"},
+			expected: []string{"a", "b"},
+		},
+		{
+			name: "empty ID",
+			ids:  []string{"a", ""},
+			code: codes.InvalidArgument,
+		},
+		{
+			name: "too many",
+			ids:  tooMany,
+			code: codes.InvalidArgument,
+		},
+		{
+			name:     "as many as allowed",
+			ids:      tooMany[:maxBulkClusters],
+			expected: tooMany[:maxBulkClusters],
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			actual, err := uniqueBulkClusterIDs(test.ids)
+			if test.code != codes.OK {
+				assert.Equal(t, test.code, status.Code(err))
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, actual)
+		})
+	}
+}
+
+func TestRunBulk(t *testing.T) {
+	ids := make([]string, 3*bulkConcurrency)
+	for i := range ids {
+		ids[i] = fmt.Sprintf("cluster-%d", i)
+	}
+
+	var running, maxRunning atomic.Int32
+	results := runBulk(ids, func(clusterID string) *v1.BulkResult {
+		current := running.Add(1)
+		defer running.Add(-1)
+		for {
+			seen := maxRunning.Load()
+			if current <= seen || maxRunning.CompareAndSwap(seen, current) {
+				break
+			}
+		}
+		time.Sleep(time.Millisecond)
+		return bulkResult(clusterID, status.Error(codes.NotFound, "not found"))
+	})
+
+	require.Len(t, results, len(ids))
+	for i, result := range results {
+		assert.Equal(t, ids[i], result.GetId())
+		assert.Equal(t, "not found", result.GetError())
+	}
+	assert.LessOrEqual(t, maxRunning.Load(), int32(bulkConcurrency))
+}
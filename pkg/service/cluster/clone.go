@@ -0,0 +1,116 @@
+package cluster
+
+import (
+	"context"
+	"sort"
+
+	"github.com/argoproj/argo-workflows/v4/pkg/apis/workflow/v1alpha1"
+	v1 "github.com/stackrox/infra/generated/api/v1"
+	"github.com/stackrox/infra/pkg/logging"
+	"github.com/stackrox/infra/pkg/service/middleware"
+	"github.com/stackrox/infra/pkg/slack"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Clone implements ClusterService.Clone.
+func (s *clusterImpl) Clone(ctx context.Context, req *v1.CloneClusterRequest) (*v1.CloneClusterResponse, error) {
+	owner, err := middleware.GetOwnerFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	log.AuditLog(logging.INFO, "cluster-clone", "received a clone request for infra cluster",
+		"actor", owner,
+		"cluster-id", req.GetId(),
+		"generation", req.GetGeneration(),
+		"name", req.GetName(),
+	)
+
+	workflow, err := s.getClusterWorkflow(req.GetId(), req.GetGeneration())
+	if err != nil {
+		return nil, err
+	}
+	if err := checkVisible(ctx, workflow); err != nil {
+		return nil, err
+	}
+
+	flavorID := GetFlavor(workflow)
+	flav, _, found := s.registry.Get(flavorID)
+	if !found {
+		return nil, status.Errorf(codes.FailedPrecondition, "flavor %q of cluster %q no longer exists", flavorID, req.GetId())
+	}
+
+	createReq, dropped := cloneRequest(*workflow, flav, req)
+	if len(dropped) > 0 {
+		log.Log(logging.INFO, "dropped parameters that are no longer accepted by the flavor",
+			"cluster-id", req.GetId(),
+			"flavor-id", flavorID,
+			"parameters", dropped,
+		)
+	}
+
+	if err := s.checkFlavorAvailable(ctx, createReq.GetID()); err != nil {
+		return nil, err
+	}
+
+	clusterID, err := s.create(createReq, owner, middleware.TeamsFromContext(ctx), middleware.ScopeFromContext(ctx), "")
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.CloneClusterResponse{
+		ID:                clusterID.GetId(),
+		DroppedParameters: dropped,
+	}, nil
+}
+
+// cloneRequest rebuilds the request that creates a copy of the cluster of the
+// given workflow, including its labels, with the overrides of the clone
+// request applied. The flavor may have changed since the workflow was
+// created, so only the parameters that it still accepts from users are passed
+// on. The names of the others are returned.
+func cloneRequest(workflow v1alpha1.Workflow, flav *v1.Flavor, req *v1.CloneClusterRequest) (*v1.CreateClusterRequest, []string) {
+	createReq := &v1.CreateClusterRequest{
+		ID:          flav.GetID(),
+		Parameters:  make(map[string]string),
+		Lifespan:    GetLifespan(&workflow),
+		Description: GetDescription(&workflow),
+		NoSlack:     GetSlack(&workflow) == string(slack.StatusSkip),
+		SlackDM:     GetSlackDM(&workflow),
+		Labels:      GetUserLabels(&workflow),
+	}
+
+	var dropped []string
+	for _, parameter := range workflow.Spec.Arguments.Parameters {
+		flavorParam, found := flav.GetParameters()[parameter.Name]
+		switch {
+		case found && flavorParam.GetInternal():
+			// Internal parameters are hardcoded by the current flavor.
+			continue
+		case !found:
+			dropped = append(dropped, parameter.Name)
+			continue
+		}
+		createReq.Parameters[parameter.Name] = parameter.GetValue()
+	}
+	sort.Strings(dropped)
+
+	// New required parameters are left for the overrides, and are otherwise
+	// reported as missing when the cluster is created.
+	for name, value := range req.GetParameters() {
+		createReq.Parameters[name] = value
+	}
+
+	createReq.Parameters["name"] = getClusterIDFromWorkflow(&workflow)
+	if req.GetName() != "" {
+		createReq.Parameters["name"] = req.GetName()
+	}
+	if req.GetLifespan() != nil {
+		createReq.Lifespan = req.GetLifespan()
+	}
+	if req.GetDescription() != "" {
+		createReq.Description = req.GetDescription()
+	}
+
+	return createReq, dropped
+}
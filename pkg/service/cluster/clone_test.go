@@ -0,0 +1,103 @@
+package cluster
+
+import (
+	"testing"
+	"time"
+
+	"github.com/argoproj/argo-workflows/v4/pkg/apis/workflow/v1alpha1"
+	v1 "github.com/stackrox/infra/generated/api/v1"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/durationpb"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestCloneRequest(t *testing.T) {
+	workflow := v1alpha1.Workflow{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   "source-abcde",
+			Labels: map[string]string{labelClusterID: "source", labelUserPrefix + "team": "sre"},
+			Annotations: map[string]string{
+				annotationFlavorKey:      "example",
+				annotationLifespanKey:    "8h0m0s",
+				annotationDescriptionKey: "the source",
+				annotationSlackKey:       "skip",
+				annotationSlackDMKey:     "yes",
+			},
+		},
+		Spec: v1alpha1.WorkflowSpec{
+			Arguments: v1alpha1.Arguments{
+				Parameters: []v1alpha1.Parameter{
+					{Name: "name", Value: v1alpha1.AnyStringPtr("source")},
+					{Name: "nodes", Value: v1alpha1.AnyStringPtr("3")},
+					{Name: "machine-type", Value: v1alpha1.AnyStringPtr("large")},
+					{Name: "image", Value: v1alpha1.AnyStringPtr("internal")},
+				},
+			},
+		},
+	}
+
+	flavor := &v1.Flavor{
+		ID: "example",
+		Parameters: map[string]*v1.Parameter{
+			"name":  {Name: "name"},
+			"nodes": {Name: "nodes"},
+			"image": {Name: "image", Internal: true},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		req      *v1.CloneClusterRequest
+		expected *v1.CreateClusterRequest
+		dropped  []string
+	}{
+		{
+			name: "copies the source",
+			req:  &v1.CloneClusterRequest{Id: "source"},
+			expected: &v1.CreateClusterRequest{
+				ID:          "example",
+				Parameters:  map[string]string{"name": "source", "nodes": "3"},
+				Lifespan:    durationpb.New(8 * time.Hour),
+				Description: "the source",
+				NoSlack:     true,
+				SlackDM:     true,
+				Labels:      map[string]string{"team": "sre"},
+			},
+			dropped: []string{"machine-type"},
+		},
+		{
+			name: "applies overrides",
+			req: &v1.CloneClusterRequest{
+				Id:          "source",
+				Name:        "copy",
+				Parameters:  map[string]string{"nodes": "5", "zone": "us-east1"},
+				Lifespan:    durationpb.New(time.Hour),
+				Description: "the copy",
+			},
+			expected: &v1.CreateClusterRequest{
+				ID:          "example",
+				Parameters:  map[string]string{"name": "copy", "nodes": "5", "zone": "us-east1"},
+				Lifespan:    durationpb.New(time.Hour),
+				Description: "the copy",
+				NoSlack:     true,
+				SlackDM:     true,
+				Labels:      map[string]string{"team": "sre"},
+			},
+			dropped: []string{"machine-type"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			actual, dropped := cloneRequest(workflow, flavor, test.req)
+			assert.Equal(t, test.expected.GetID(), actual.GetID())
+			assert.Equal(t, test.expected.GetParameters(), actual.GetParameters())
+			assert.Equal(t, test.expected.GetLifespan().AsDuration(), actual.GetLifespan().AsDuration())
+			assert.Equal(t, test.expected.GetDescription(), actual.GetDescription())
+			assert.Equal(t, test.expected.GetNoSlack(), actual.GetNoSlack())
+			assert.Equal(t, test.expected.GetSlackDM(), actual.GetSlackDM())
+			assert.Equal(t, test.expected.GetLabels(), actual.GetLabels())
+			assert.Equal(t, test.dropped, dropped)
+		})
+	}
+}
@@ -20,12 +20,14 @@ import (
 	"github.com/golang/protobuf/ptypes/duration"
 	"github.com/golang/protobuf/ptypes/empty"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
-	"github.com/stackrox/infra/cmd/infractl/common"
 	v1 "github.com/stackrox/infra/generated/api/v1"
 	"github.com/stackrox/infra/pkg/bqutil"
+	"github.com/stackrox/infra/pkg/config"
 	"github.com/stackrox/infra/pkg/flavor"
 	"github.com/stackrox/infra/pkg/kube"
+	"github.com/stackrox/infra/pkg/leader"
 	"github.com/stackrox/infra/pkg/logging"
+	"github.com/stackrox/infra/pkg/notifier"
 	"github.com/stackrox/infra/pkg/service/metrics"
 	"github.com/stackrox/infra/pkg/service/middleware"
 	"github.com/stackrox/infra/pkg/signer"
@@ -44,15 +46,15 @@ import (
 )
 
 var (
-	// resumeExpiredClusterInterval is how often to periodically check for
-	// expired workflows.
+	// resumeExpiredClusterInterval is how often to periodically recheck every
+	// workflow for expiry, in addition to workflow changes.
 	resumeExpiredClusterInterval = 1 * time.Minute
 )
 
 const (
-	// slackCheckInterval is how often to periodically check for workflow
-	// updates to send Slack messages.
-	slackCheckInterval = 1 * time.Minute
+	// notificationCheckInterval is how often to periodically recheck every
+	// workflow for notifications to send, in addition to workflow changes.
+	notificationCheckInterval = 1 * time.Minute
 
 	// when is a cluster considered near expiration
 	nearExpiry = 30 * time.Minute
@@ -74,14 +76,27 @@ type clusterImpl struct {
 	k8sWorkflowsClient  workflowv1.WorkflowInterface
 	k8sPodsClient       k8sv1.PodInterface
 	registry            *flavor.Registry
-	signer              *signer.Signer
+	artifactStore       signer.ArtifactStore
 	slackClient         slack.Slacker
+	notifiers           []notifier.Notifier
+	workflows           *workflowCache
+	leader              *leader.Elector
 	argoClient          apiclient.Client
 	argoWorkflowsClient workflowpkg.WorkflowServiceClient
 	argoClientCtx       context.Context
 	workflowNamespace   string
 	bqClient            bqutil.BigQueryClient
 	artifactCache       *artifactCache
+	quota               *config.QuotaConfig
+
+	// quotaLock serializes the quota checks of concurrent creations. Limits
+	// per flavor span owners, so a single lock is used.
+	quotaLock sync.Mutex
+
+	// pendingClusters are the clusters that were counted against the quotas
+	// before their workflows reached the workflow cache, keyed by cluster ID.
+	pendingLock     sync.Mutex
+	pendingClusters map[string]pendingCluster
 }
 
 var (
@@ -92,7 +107,7 @@ var (
 )
 
 // NewClusterService creates a new ClusterService.
-func NewClusterService(registry *flavor.Registry, signer *signer.Signer, slackClient slack.Slacker, bqClient bqutil.BigQueryClient) (middleware.APIService, error) {
+func NewClusterService(registry *flavor.Registry, artifactStore signer.ArtifactStore, slackClient slack.Slacker, notifiers []notifier.Notifier, bqClient bqutil.BigQueryClient, quota *config.QuotaConfig, elector *leader.Elector) (middleware.APIService, error) {
 	workflowNamespace := "default"
 
 	k8sWorkflowsClient, err := kube.GetK8sWorkflowsClient(workflowNamespace)
@@ -127,28 +142,45 @@ func NewClusterService(registry *flavor.Registry, signer *signer.Signer, slackCl
 		k8sWorkflowsClient:  k8sWorkflowsClient,
 		k8sPodsClient:       k8sPodsClient,
 		registry:            registry,
-		signer:              signer,
+		artifactStore:       artifactStore,
 		slackClient:         slackClient,
+		notifiers:           notifiers,
 		argoClient:          argoClient,
 		argoWorkflowsClient: argoWorkflowsClient,
 		argoClientCtx:       ctx,
 		workflowNamespace:   workflowNamespace,
 		bqClient:            bqClient,
 		artifactCache:       cache,
+		quota:               quota,
+		leader:              elector,
 	}
 
-	go impl.startSlackCheck()
-	go impl.cleanupExpiredClusters()
+	// Expired clusters and notifications are reconciled whenever a workflow
+	// changes, and periodically since both also depend on the passage of time.
+	// Only the leader reconciles, and catches up on every workflow when it is
+	// elected.
+	impl.workflows = newWorkflowCache(k8sWorkflowsClient, min(resumeExpiredClusterInterval, notificationCheckInterval))
+	if err := impl.workflows.addReconciler("expiry", resumeExpiredClusterInterval, impl.leaderOnly(impl.reconcileExpiry)); err != nil {
+		return nil, err
+	}
+	if err := impl.workflows.addReconciler("notification", notificationCheckInterval, impl.leaderOnly(impl.notifyWorkflow)); err != nil {
+		return nil, err
+	}
+	elector.OnElected(impl.workflows.resync)
+	go impl.workflows.run(context.Background())
 
 	return impl, nil
 }
 
 // Info implements ClusterService.Info.
-func (s *clusterImpl) Info(_ context.Context, clusterID *v1.ResourceByID) (*v1.Cluster, error) {
-	workflow, err := s.getMostRecentArgoWorkflowFromClusterID(clusterID.GetId())
+func (s *clusterImpl) Info(ctx context.Context, req *v1.ClusterRequest) (*v1.Cluster, error) {
+	workflow, err := s.getClusterWorkflow(req.GetId(), req.GetGeneration())
 	if err != nil {
 		return nil, err
 	}
+	if err := checkVisible(ctx, workflow); err != nil {
+		return nil, err
+	}
 
 	metacluster, err := s.metaClusterFromWorkflow(*workflow)
 	if err != nil {
@@ -175,25 +207,53 @@ func (s *clusterImpl) List(ctx context.Context, request *v1.ClusterListRequest)
 		return nil, err
 	}
 
-	listOpts := &metav1.ListOptions{}
-	if selectorStr := selector.String(); selectorStr != "" {
-		listOpts.LabelSelector = selectorStr
-	}
-
-	workflowList, err := s.argoWorkflowsClient.ListWorkflows(s.argoClientCtx, &workflowpkg.WorkflowListRequest{
-		Namespace:   s.workflowNamespace,
-		ListOptions: listOpts,
-	})
+	workflows, err := s.listWorkflows(selector)
 	if err != nil {
 		return nil, err
 	}
 
-	clusters := make([]*v1.Cluster, 0, len(workflowList.Items))
+	// Also include the clusters where the current principal is a collaborator,
+	// and the clusters of its teams, as label selectors can't express "owner,
+	// collaborator or team member".
+	if !request.All {
+		sharedSelectors, err := buildSharedLabelSelectors(request, email, middleware.TeamsFromContext(ctx))
+		if err != nil {
+			return nil, err
+		}
+
+		listed := make(map[string]struct{}, len(workflows))
+		for _, workflow := range workflows {
+			listed[workflow.GetName()] = struct{}{}
+		}
+
+		for _, sharedSelector := range sharedSelectors {
+			sharedWorkflows, err := s.listWorkflows(sharedSelector)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, workflow := range sharedWorkflows {
+				if _, found := listed[workflow.GetName()]; !found {
+					listed[workflow.GetName()] = struct{}{}
+					workflows = append(workflows, workflow)
+				}
+			}
+		}
+	}
+
+	clusters := make([]*v1.Cluster, 0, len(workflows))
 
 	// Loop over workflows and apply client-side filters for fields that can't be
-	// filtered server-side (time-based expiration, prefix matching, workflow status).
-	// Server-side filtering (via label selectors) handles: owner, flavor, deleted status.
-	for _, workflow := range workflowList.Items {
+	// filtered server-side (time-based expiration, prefix matching, workflow status,
+	// team visibility). Server-side filtering (via label selectors) handles: owner,
+	// collaborator, team, flavor, deleted status.
+	for _, workflow := range workflows {
+		// This cluster belongs to teams that the current principal is not a
+		// member of.
+		if !isVisible(ctx, &workflow) {
+			continue
+		}
+
 		// This cluster is expired, and we did not request to include expired
 		// clusters.
 		if !request.Expired && isWorkflowExpired(workflow) {
@@ -266,6 +326,8 @@ func (s *clusterImpl) Lifespan(ctx context.Context, req *v1.LifespanRequest) (*d
 		"cluster-id", req.GetId(),
 		"lifespan-update-method", req.GetMethod().String(),
 		"lifespan", req.GetLifespan().String(),
+		"force", req.GetForce(),
+		"reason", req.GetReason(),
 	)
 
 	workflow, err := s.getMostRecentArgoWorkflowFromClusterID(req.GetId())
@@ -273,7 +335,21 @@ func (s *clusterImpl) Lifespan(ctx context.Context, req *v1.LifespanRequest) (*d
 		return nil, err
 	}
 
-	return s.lifespan(ctx, req, workflow)
+	forced, err := checkOwnerOrForce(ctx, workflow, req.GetForce(), req.GetReason())
+	if err != nil {
+		return nil, err
+	}
+
+	updated, err := s.lifespan(ctx, req, workflow)
+	if err != nil {
+		return nil, err
+	}
+
+	if forced {
+		s.notifyForced(owner, workflow, "given a lifespan of "+updated.AsDuration().String(), req.GetReason())
+	}
+
+	return updated, nil
 }
 
 func (s *clusterImpl) lifespan(ctx context.Context, req *v1.LifespanRequest, workflow *v1alpha1.Workflow) (*duration.Duration, error) {
@@ -309,6 +385,10 @@ func (s *clusterImpl) lifespan(ctx context.Context, req *v1.LifespanRequest, wor
 		lifespanUpdated = 0
 	}
 
+	if err := middleware.CheckLifespanScope(ctx, lifespanUpdated); err != nil {
+		return nil, err
+	}
+
 	// Construct our replacement patch
 	payloadBytes, err := formatAnnotationPatch(annotationLifespanKey, fmt.Sprint(lifespanUpdated))
 	if err != nil {
@@ -336,17 +416,31 @@ func (s *clusterImpl) Create(ctx context.Context, req *v1.CreateClusterRequest)
 
 	log.AuditLog(logging.INFO, "cluster-create", "received a create request for flavor",
 		"actor", owner,
+		"cluster-id", req.GetParameters()["name"],
 		"flavor-id", req.GetID(),
 	)
-	return s.create(req, owner, "")
+
+	if err := s.checkFlavorAvailable(ctx, req.GetID()); err != nil {
+		return nil, err
+	}
+
+	return s.create(req, owner, middleware.TeamsFromContext(ctx), middleware.ScopeFromContext(ctx), "")
 }
 
-func (s *clusterImpl) create(req *v1.CreateClusterRequest, owner, eventID string) (*v1.ResourceByID, error) {
+// create creates a cluster for the given owner, which belongs to the given
+// teams, within the given token scope, if any.
+func (s *clusterImpl) create(req *v1.CreateClusterRequest, owner string, teams []string, scope *v1.TokenScope, eventID string) (*v1.ResourceByID, error) {
 	flav, workflow, found := s.registry.Get(req.ID)
 	if !found {
 		return nil, status.Errorf(codes.NotFound, "flavor %q not found", req.ID)
 	}
 
+	// Scopes are checked against the flavor, not the alias it was requested
+	// by.
+	if err := checkScope(scope, flav.GetID(), req.GetLifespan()); err != nil {
+		return nil, err
+	}
+
 	// Combine any hardcoded or default workflow parameters with the user
 	// provided parameters. Or return an error if the user provided
 	// insufficient or superfluous parameters.
@@ -366,9 +460,27 @@ func (s *clusterImpl) create(req *v1.CreateClusterRequest, owner, eventID string
 	if err := validateClusterID(clusterID); err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid cluster ID: %v", err)
 	}
+	if err := validateUserLabels(req.GetLabels()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid labels: %v", err)
+	}
 
 	workflow.GenerateName = clusterID + "-"
 
+	// Reject the request if the owner already has as many clusters running
+	// as their quota allows, or if a cluster with the same ID is being
+	// created concurrently. Otherwise, the cluster counts against the quota
+	// right away, unless it is not created after all.
+	releaseQuota, err := s.reserveNewCluster(owner, teams, scope, flav.GetID(), clusterID)
+	if err != nil {
+		return nil, err
+	}
+	submitted := false
+	defer func() {
+		if !submitted {
+			releaseQuota()
+		}
+	}()
+
 	// Make sure there is no running argo workflow for infra cluster with the same ID
 	existingWorkflow, _ := s.getMostRecentArgoWorkflowFromClusterID(clusterID)
 	if existingWorkflow != nil {
@@ -423,11 +535,18 @@ func (s *clusterImpl) create(req *v1.CreateClusterRequest, owner, eventID string
 		annotationSlackDMKey:     slackDM,
 	})
 
-	workflow.SetLabels(map[string]string{
+	workflowLabels := map[string]string{
 		labelClusterID: clusterID,
 		labelOwner:     emailToLabelValue(owner),
 		labelFlavor:    flav.GetID(),
-	})
+	}
+	for _, team := range teams {
+		workflowLabels[teamLabel(team)] = "true"
+	}
+	for name, value := range req.GetLabels() {
+		workflowLabels[userLabel(name)] = value
+	}
+	workflow.SetLabels(workflowLabels)
 
 	log.Log(logging.INFO, "will create an infra cluster",
 		"flavor-id", flav.GetID(),
@@ -443,6 +562,7 @@ func (s *clusterImpl) create(req *v1.CreateClusterRequest, owner, eventID string
 		log.Log(logging.WARN, "creating argo workflow for a new cluster failed", "error", err)
 		return nil, err
 	}
+	submitted = true
 
 	log.Log(logging.INFO, "created an argo workflow for a new infra cluster",
 		"workflow-name", created.GetName(),
@@ -460,11 +580,14 @@ func (s *clusterImpl) create(req *v1.CreateClusterRequest, owner, eventID string
 }
 
 // Artifacts implements ClusterService.Artifacts.
-func (s *clusterImpl) Artifacts(_ context.Context, clusterID *v1.ResourceByID) (*v1.ClusterArtifacts, error) {
-	workflow, err := s.getMostRecentArgoWorkflowFromClusterID(clusterID.GetId())
+func (s *clusterImpl) Artifacts(ctx context.Context, req *v1.ClusterRequest) (*v1.ClusterArtifacts, error) {
+	workflow, err := s.getClusterWorkflow(req.GetId(), req.GetGeneration())
 	if err != nil {
 		return nil, err
 	}
+	if err := checkVisible(ctx, workflow); err != nil {
+		return nil, err
+	}
 
 	flavorMetadata := make(map[string]*v1.FlavorArtifact)
 	flavorName := GetFlavor(workflow)
@@ -478,7 +601,7 @@ func (s *clusterImpl) Artifacts(_ context.Context, clusterID *v1.ResourceByID) (
 	for _, nodeStatus := range workflow.Status.Nodes {
 		if nodeStatus.Outputs != nil {
 			for _, artifact := range nodeStatus.Outputs.Artifacts {
-				if artifact.GCS == nil {
+				if !artifact.HasKey() {
 					continue
 				}
 
@@ -493,12 +616,12 @@ func (s *clusterImpl) Artifacts(_ context.Context, clusterID *v1.ResourceByID) (
 					description = meta.Description
 				}
 
-				bucket, key := handleArtifactMigration(*workflow, artifact)
+				bucket, key := artifactLocation(*workflow, artifact)
 				if bucket == "" || key == "" {
 					continue
 				}
 
-				url, err := s.signer.Generate(bucket, key)
+				url, err := s.artifactStore.SignedURL(bucket, key)
 				if err != nil {
 					return nil, err
 				}
@@ -524,13 +647,22 @@ func (s *clusterImpl) Artifacts(_ context.Context, clusterID *v1.ResourceByID) (
 // Access configures access for this service.
 func (s *clusterImpl) Access() map[string]middleware.Access {
 	return map[string]middleware.Access{
-		"/v1.ClusterService/Info":      middleware.Authenticated,
-		"/v1.ClusterService/List":      middleware.Authenticated,
-		"/v1.ClusterService/Lifespan":  middleware.Authenticated,
-		"/v1.ClusterService/Create":    middleware.Authenticated,
-		"/v1.ClusterService/Artifacts": middleware.Authenticated,
-		"/v1.ClusterService/Delete":    middleware.Authenticated,
-		"/v1.ClusterService/Logs":      middleware.Authenticated,
+		"/v1.ClusterService/Info":            middleware.Viewer,
+		"/v1.ClusterService/History":         middleware.Viewer,
+		"/v1.ClusterService/List":            middleware.Viewer,
+		"/v1.ClusterService/Lifespan":        middleware.Authenticated,
+		"/v1.ClusterService/Create":          middleware.Authenticated,
+		"/v1.ClusterService/Clone":           middleware.Authenticated,
+		"/v1.ClusterService/Retry":           middleware.Authenticated,
+		"/v1.ClusterService/UpdateLabels":    middleware.Authenticated,
+		"/v1.ClusterService/UpdateOwnership": middleware.Authenticated,
+		"/v1.ClusterService/Artifacts":       middleware.Viewer,
+		"/v1.ClusterService/Delete":          middleware.Authenticated,
+		"/v1.ClusterService/BulkDelete":      middleware.Authenticated,
+		"/v1.ClusterService/BulkLifespan":    middleware.Authenticated,
+		"/v1.ClusterService/Logs":            middleware.Viewer,
+		"/v1.ClusterService/Watch":           middleware.Viewer,
+		"/v1.ClusterService/StreamLogs":      middleware.Viewer,
 	}
 }
 
@@ -560,7 +692,7 @@ func (s *clusterImpl) setDeletedLabel(ctx context.Context, workflowName string)
 	return nil
 }
 
-func (s *clusterImpl) Delete(ctx context.Context, req *v1.ResourceByID) (*empty.Empty, error) {
+func (s *clusterImpl) Delete(ctx context.Context, req *v1.DeleteClusterRequest) (*empty.Empty, error) {
 	owner, err := middleware.GetOwnerFromContext(ctx)
 	if err != nil {
 		return nil, err
@@ -568,6 +700,8 @@ func (s *clusterImpl) Delete(ctx context.Context, req *v1.ResourceByID) (*empty.
 	log.AuditLog(logging.INFO, "cluster-delete", "received a delete request for infra cluster",
 		"actor", owner,
 		"cluster-id", req.GetId(),
+		"force", req.GetForce(),
+		"reason", req.GetReason(),
 	)
 
 	workflow, err := s.getMostRecentArgoWorkflowFromClusterID(req.GetId())
@@ -575,6 +709,10 @@ func (s *clusterImpl) Delete(ctx context.Context, req *v1.ResourceByID) (*empty.
 		return &empty.Empty{}, err
 	}
 
+	forced, err := checkOwnerOrForce(ctx, workflow, req.GetForce(), req.GetReason())
+	if err != nil {
+		return nil, err
+	}
 	// Set lifespan to zero so the workflow is examined in cleanupExpiredClusters().
 	lifespanReq := &v1.LifespanRequest{
 		Id:       req.Id,
@@ -606,6 +744,11 @@ func (s *clusterImpl) Delete(ctx context.Context, req *v1.ResourceByID) (*empty.
 		)
 	}
 
+	// Only notify the owner once the deletion has been set in motion.
+	if forced {
+		s.notifyForced(owner, workflow, "deleted", req.GetReason())
+	}
+
 	err = s.bqClient.InsertClusterDeletionRecord(context.Background(), req.GetId(), workflow.GetName())
 	if err != nil {
 		log.Log(logging.WARN, "failed to record cluster deletion", "cluster-id", req.GetId(), "error", err)
@@ -614,11 +757,14 @@ func (s *clusterImpl) Delete(ctx context.Context, req *v1.ResourceByID) (*empty.
 	return &empty.Empty{}, nil
 }
 
-func (s *clusterImpl) Logs(ctx context.Context, clusterID *v1.ResourceByID) (*v1.LogsResponse, error) {
-	workflow, err := s.getMostRecentArgoWorkflowFromClusterID(clusterID.GetId())
+func (s *clusterImpl) Logs(ctx context.Context, req *v1.ClusterRequest) (*v1.LogsResponse, error) {
+	workflow, err := s.getClusterWorkflow(req.GetId(), req.GetGeneration())
 	if err != nil {
 		return nil, err
 	}
+	if err := checkVisible(ctx, workflow); err != nil {
+		return nil, err
+	}
 
 	var podNodes []v1alpha1.NodeStatus
 	for _, node := range workflow.Status.Nodes {
@@ -670,7 +816,12 @@ func (s *clusterImpl) RegisterServiceHandler(ctx context.Context, mux *runtime.S
 }
 
 func (s *clusterImpl) getMostRecentArgoWorkflowFromClusterID(clusterID string) (*v1alpha1.Workflow, error) {
-	listOpts := &metav1.ListOptions{}
+	return s.getClusterWorkflow(clusterID, "")
+}
+
+// getClusterWorkflows returns the workflows of every generation of the
+// cluster with the given ID, most recently created first.
+func (s *clusterImpl) getClusterWorkflows(clusterID string) ([]v1alpha1.Workflow, error) {
 	labelSelector := labels.NewSelector()
 	clusterIDRequirement, err := labels.NewRequirement(labelClusterID, selection.Equals, []string{clusterID})
 	if err != nil {
@@ -678,88 +829,134 @@ func (s *clusterImpl) getMostRecentArgoWorkflowFromClusterID(clusterID string) (
 		return nil, err
 	}
 	labelSelector = labelSelector.Add(*clusterIDRequirement)
-	listOpts.LabelSelector = labelSelector.String()
 
-	workflowList, err := s.argoWorkflowsClient.ListWorkflows(s.argoClientCtx, &workflowpkg.WorkflowListRequest{
-		Namespace:   s.workflowNamespace,
-		ListOptions: listOpts,
-	})
+	workflows, err := s.listWorkflows(labelSelector)
 	if err != nil {
-		log.Log(logging.ERROR, "failed to list workflows", "error", err)
 		return nil, err
 	}
-	if len(workflowList.Items) >= 1 {
+	if len(workflows) == 0 && s.workflows.synced() {
+		// The workflow may have been created too recently to be cached.
+		workflows, err = s.listWorkflowsFromAPI(labelSelector)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if len(workflows) >= 1 {
 		// Current behaviour - the cluster ID exists as a workflow label
-		return &workflowList.Items[0], nil
+		return workflows, nil
 	}
 
 	log.Log(logging.INFO, "could not find an argo workflow to match infra cluster by label", "cluster-id", clusterID)
 
 	// TODO: is this path ever executed?
 	// Prior behaviour - Try to find using the cluster ID mapped to the workflow name
-	return s.argoWorkflowsClient.GetWorkflow(s.argoClientCtx, &workflowpkg.WorkflowGetRequest{
+	workflow, err := s.argoWorkflowsClient.GetWorkflow(s.argoClientCtx, &workflowpkg.WorkflowGetRequest{
 		Name:      clusterID,
 		Namespace: s.workflowNamespace,
 	})
+	if err != nil {
+		return nil, err
+	}
+	return []v1alpha1.Workflow{*workflow}, nil
 }
 
-func (s *clusterImpl) cleanupExpiredClusters() {
-	for ; ; time.Sleep(resumeExpiredClusterInterval) {
-		start := time.Now()
-
-		// Use label selector to filter out already-deleted workflows server-side
-		labelSelector := fmt.Sprintf("%s!=%s", labelDeleted, "true")
+// listWorkflows returns the workflows that match the given selector, most
+// recently created first. The workflow cache is used once it has been filled.
+func (s *clusterImpl) listWorkflows(selector labels.Selector) ([]v1alpha1.Workflow, error) {
+	if !s.workflows.synced() {
+		return s.listWorkflowsFromAPI(selector)
+	}
 
-		workflowList, err := s.argoWorkflowsClient.ListWorkflows(s.argoClientCtx, &workflowpkg.WorkflowListRequest{
-			Namespace: s.workflowNamespace,
-			ListOptions: &metav1.ListOptions{
-				LabelSelector: labelSelector,
-			},
-		})
-		if err != nil {
-			log.Log(logging.ERROR, "failed to list workflows", "error", err)
-			continue
-		}
+	workflows := s.workflows.list(selector)
+	for i := range workflows {
+		workflows[i] = s.hydrateWorkflow(workflows[i])
+	}
+	return workflows, nil
+}
 
-		for _, workflow := range workflowList.Items {
-			status := workflowStatus(workflow.Status)
-			if status == v1.Status_FINISHED {
-				if err := s.setDeletedLabel(s.argoClientCtx, workflow.GetName()); err != nil {
-					log.Log(logging.ERROR, "error occurred setting deleted label", "workflow-name", workflow.GetName(), "error", err)
-				}
-				continue
-			}
+// hydrateWorkflow returns the given workflow from the Argo API if argo has
+// offloaded its node status, which is then missing from the cached workflow.
+func (s *clusterImpl) hydrateWorkflow(workflow v1alpha1.Workflow) v1alpha1.Workflow {
+	if !workflow.Status.IsOffloadNodeStatus() {
+		return workflow
+	}
 
-			if status != v1.Status_READY {
-				continue
-			}
+	hydrated, err := s.argoWorkflowsClient.GetWorkflow(s.argoClientCtx, &workflowpkg.WorkflowGetRequest{
+		Name:      workflow.GetName(),
+		Namespace: s.workflowNamespace,
+	})
+	if err != nil {
+		log.Log(logging.WARN, "failed to get offloaded argo workflow", "workflow-name", workflow.GetName(), "error", err)
+		return workflow
+	}
+	return *hydrated
+}
 
-			if !isWorkflowExpired(workflow) {
-				continue
-			}
+// listWorkflowsFromAPI returns the workflows that match the given selector,
+// as listed by the Argo API.
+func (s *clusterImpl) listWorkflowsFromAPI(selector labels.Selector) ([]v1alpha1.Workflow, error) {
+	workflowList, err := s.argoWorkflowsClient.ListWorkflows(s.argoClientCtx, &workflowpkg.WorkflowListRequest{
+		Namespace: s.workflowNamespace,
+		ListOptions: &metav1.ListOptions{
+			LabelSelector: selector.String(),
+		},
+	})
+	if err != nil {
+		log.Log(logging.ERROR, "failed to list workflows", "error", err)
+		return nil, err
+	}
 
-			log.Log(logging.INFO, "resuming an argo workflow that has expired", "workflow-name", workflow.GetName())
+	return workflowList.Items, nil
+}
 
-			_, err = s.argoWorkflowsClient.ResumeWorkflow(s.argoClientCtx, &workflowpkg.WorkflowResumeRequest{
-				Name:      workflow.GetName(),
-				Namespace: s.workflowNamespace,
-			})
-			if err != nil {
-				log.Log(logging.WARN, "failed to resume argo workflow", "workflow-name", workflow.GetName(), "error", err)
-			}
+// leaderOnly wraps the given reconciler so that it only acts while this
+// replica is the leader.
+func (s *clusterImpl) leaderOnly(reconcile func(workflow v1alpha1.Workflow) bool) func(workflow v1alpha1.Workflow) bool {
+	return func(workflow v1alpha1.Workflow) bool {
+		return s.leader.IsLeader() && reconcile(workflow)
+	}
+}
 
-			clusterID := strings.TrimSuffix(workflow.GenerateName, "-")
-			err = s.bqClient.InsertClusterDeletionRecord(context.Background(), clusterID, workflow.GetName())
-			if err != nil {
-				log.Log(logging.WARN, "failed to record cluster deletion", "workflow-name", workflow.GetName(), "error", err)
-			}
+// reconcileExpiry marks finished workflows as deleted, and resumes the
+// workflows of expired clusters so that they are destroyed. It reports
+// whether the workflow was changed.
+func (s *clusterImpl) reconcileExpiry(workflow v1alpha1.Workflow) bool {
+	status := workflowStatus(workflow.Status)
+	if status == v1.Status_FINISHED {
+		if err := s.setDeletedLabel(s.argoClientCtx, workflow.GetName()); err != nil {
+			log.Log(logging.ERROR, "error occurred setting deleted label", "workflow-name", workflow.GetName(), "error", err)
+			return false
 		}
+		return true
+	}
 
-		// Log the duration of the loop if above the warning threshold to be aware of performance issues.
-		if time.Since(start) > loopDurationWarning {
-			log.Log(logging.WARN, fmt.Sprintf("expire loop took %s", time.Since(start).String()))
-		}
+	if status != v1.Status_READY {
+		return false
+	}
+
+	if !isWorkflowExpired(workflow) {
+		return false
+	}
+
+	log.Log(logging.INFO, "resuming an argo workflow that has expired", "workflow-name", workflow.GetName())
+
+	_, err := s.argoWorkflowsClient.ResumeWorkflow(s.argoClientCtx, &workflowpkg.WorkflowResumeRequest{
+		Name:      workflow.GetName(),
+		Namespace: s.workflowNamespace,
+	})
+	if err != nil {
+		// Resuming is retried on the next resync.
+		log.Log(logging.WARN, "failed to resume argo workflow", "workflow-name", workflow.GetName(), "error", err)
+		return false
 	}
+
+	clusterID := strings.TrimSuffix(workflow.GenerateName, "-")
+	err = s.bqClient.InsertClusterDeletionRecord(context.Background(), clusterID, workflow.GetName())
+	if err != nil {
+		log.Log(logging.WARN, "failed to record cluster deletion", "workflow-name", workflow.GetName(), "error", err)
+	}
+
+	return true
 }
 
 func (s *clusterImpl) getLogs(ctx context.Context, node v1alpha1.NodeStatus) *v1.Log {
@@ -807,126 +1004,138 @@ func determinePodName(node v1alpha1.NodeStatus) string {
 	return fmt.Sprintf("%s-%s-%s", baseName, templateName, randomNumber)
 }
 
-func (s *clusterImpl) startSlackCheck() {
-	for ; ; time.Sleep(slackCheckInterval) {
-		start := time.Now()
-
-		// Use label selector to filter out deleted workflows server-side
-		labelSelector := fmt.Sprintf("%s!=%s", labelDeleted, "true")
+// notifyForced records an operation that was forced on a cluster by someone
+// other than its owner, and tells the owner about it on Slack.
+func (s *clusterImpl) notifyForced(actor string, workflow *v1alpha1.Workflow, operation string, reason string) {
+	clusterID := getClusterIDFromWorkflow(workflow)
+	owner := GetOwner(workflow)
+	log.AuditLog(logging.WARN, "cluster-force", "forced an operation on an infra cluster owned by someone else",
+		"actor", actor,
+		"cluster-id", clusterID,
+		"owner", owner,
+		"operation", operation,
+		"reason", reason,
+	)
 
-		workflowList, err := s.argoWorkflowsClient.ListWorkflows(s.argoClientCtx, &workflowpkg.WorkflowListRequest{
-			Namespace: s.workflowNamespace,
-			ListOptions: &metav1.ListOptions{
-				LabelSelector: labelSelector,
-			},
-		})
-		if err != nil {
-			log.Log(logging.ERROR, "failed to list workflows", "error", err)
-			continue
-		}
+	data := slack.TemplateData{
+		Description: GetDescription(workflow),
+		ID:          clusterID,
+		OwnerEmail:  owner,
+		Actor:       actor,
+		Operation:   operation,
+		Reason:      reason,
+	}
 
-		for _, workflow := range workflowList.Items {
-			s.slackCheckWorkflow(workflow)
+	user, found := s.slackClient.LookupUser(owner)
+	if !found {
+		if err := s.slackClient.PostMessage(slack.FormatForcedMessage(data)...); err != nil {
+			log.Log(logging.ERROR, "failed to send Slack message about a forced operation", "cluster-id", clusterID, "error", err)
 		}
+		return
+	}
 
-		// Log the duration of the loop if above the warning threshold to be aware of performance issues.
-		if time.Since(start) > loopDurationWarning {
-			log.Log(logging.WARN, fmt.Sprintf("slack loop took %s", time.Since(start).String()))
-		}
+	data.OwnerID = user.ID
+	if err := s.slackClient.PostMessageToUser(user, slack.FormatForcedMessage(data)...); err != nil {
+		log.Log(logging.ERROR, "failed to send Slack message about a forced operation directly to owner", "user-email", owner, "error", err)
 	}
 }
 
-func (s *clusterImpl) slackCheckWorkflow(workflow v1alpha1.Workflow) {
-	if slack.IsSlackComplete(slack.Status(GetSlack(&workflow))) {
-		return
+// notifyWorkflow delivers a notification to every sink that has not yet been
+// notified of the current cluster state, and records the new notification
+// phase of each sink in the workflow annotations. It reports whether the
+// workflow was changed.
+func (s *clusterImpl) notifyWorkflow(workflow v1alpha1.Workflow) bool {
+	var pending []notifier.Notifier
+	for _, sink := range s.notifiers {
+		if !notifier.IsComplete(GetNotificationStatus(&workflow, sink.Name())) {
+			pending = append(pending, sink)
+		}
+	}
+	if len(pending) == 0 {
+		return false
 	}
 
+	workflow = s.hydrateWorkflow(workflow)
 	metacluster, err := s.metaClusterFromWorkflow(workflow)
 	if err != nil {
 		log.Log(logging.ERROR, "failed to convert workflow to meta-cluster", "workflow-name", workflow.Name, "error", err)
-		return
+		return false
 	}
 
-	// Generate a Slack message for our current cluster state.
-	failureDetails := workflowFailureDetails(workflow.Status).Error()
-	data := slackTemplateContext(s.slackClient, metacluster, failureDetails)
-	newSlackStatus, message := slack.FormatSlackMessage(metacluster.Status, metacluster.NearingExpiry, metacluster.Slack, data)
+	changed := false
 
-	// Only bother to send a message if there is one to send.
-	if message != nil {
-		sent := false
-		user, found := s.slackClient.LookupUser(metacluster.Owner)
-		if found && metacluster.SlackDM {
-			if err := s.slackClient.PostMessageToUser(user, message...); err != nil {
-				log.Log(logging.ERROR, "failed to send Slack message directly to user", "user-email", user.Profile.Email, "error", err)
-			} else {
-				sent = true
+	event := notifier.Event{
+		Cluster:        metacluster.Cluster,
+		Scheduled:      metacluster.EventID != "",
+		SlackDM:        metacluster.SlackDM,
+		FailureDetails: workflowFailureDetails(workflow.Status).Error(),
+	}
+
+	for _, sink := range pending {
+		currentStatus := GetNotificationStatus(&workflow, sink.Name())
+		newStatus, notify := notifier.Transition(metacluster.Status, metacluster.NearingExpiry, currentStatus)
+
+		// Only bother to send a notification if there is one to send.
+		if notify {
+			event.Status = newStatus
+			if err := sink.Notify(event); err != nil {
+				log.Log(logging.ERROR, "failed to send notification",
+					"notifier", sink.Name(),
+					"cluster-id", metacluster.ID,
+					"error", err,
+				)
+				continue
 			}
-		}
-		if !sent {
-			if err := s.slackClient.PostMessage(message...); err != nil {
-				log.Log(logging.ERROR, "failed to send Slack message", "error", err)
-				return
+
+			// Failed clusters have historically been recorded as deleted
+			// once their Slack message was sent.
+			if newStatus == notifier.StatusFailed && sink.Name() == slack.NotifierName {
+				clusterID := getClusterIDFromWorkflow(&workflow)
+				err = s.bqClient.InsertClusterDeletionRecord(context.Background(), clusterID, workflow.GetName())
+				if err != nil {
+					log.Log(logging.WARN, "failed to record cluster deletion", "cluster-id", clusterID, "error", err)
+				}
 			}
 		}
 
-		if metacluster.Status == v1.Status_FAILED {
-			clusterID := getClusterIDFromWorkflow(&workflow)
-			err = s.bqClient.InsertClusterDeletionRecord(context.Background(), clusterID, workflow.GetName())
-			if err != nil {
-				log.Log(logging.WARN, "failed to record cluster deletion", "cluster-id", clusterID, "error", err)
-			}
+		// Only bother to update workflow annotation if our phase has
+		// transitioned.
+		if newStatus == currentStatus {
+			continue
 		}
-	}
 
-	// Only bother to update workflow annotation if our phase has
-	// transitioned.
-	if newSlackStatus != metacluster.Slack {
 		// Construct our replacement patch
-		payloadBytes, err := formatAnnotationPatch(annotationSlackKey, string(newSlackStatus))
+		payloadBytes, err := formatAnnotationPatch(notificationAnnotationKey(sink.Name()), string(newStatus))
 		if err != nil {
-			log.Log(logging.ERROR, "failed to format Slack annotation patch", "error", err)
-			return
+			log.Log(logging.ERROR, "failed to format notification annotation patch", "error", err)
+			continue
 		}
 
 		// Submit the patch.
 		_, err = s.k8sWorkflowsClient.Patch(context.Background(), workflow.GetName(), types.JSONPatchType, payloadBytes, metav1.PatchOptions{})
 		if err != nil {
-			log.Log(logging.ERROR, "failed to patch Slack annotation",
+			log.Log(logging.ERROR, "failed to patch notification annotation",
+				"notifier", sink.Name(),
 				"cluster-id", metacluster.ID,
 				"workflow-name", workflow.GetName(),
 				"error", err,
 			)
-			return
+			continue
 		}
-	}
-}
-
-func slackTemplateContext(client slack.Slacker, cluster *metaCluster, failureDetails string) slack.TemplateData {
-	createdOn := cluster.CreatedOn.AsTime()
-	lifespan := cluster.Lifespan.AsDuration()
-	remaining := time.Until(createdOn.Add(lifespan))
-
-	data := slack.TemplateData{
-		Description:    cluster.Description,
-		Flavor:         cluster.Flavor,
-		ID:             cluster.ID,
-		OwnerEmail:     cluster.Owner,
-		Remaining:      common.FormatExpiration(remaining),
-		Scheduled:      cluster.EventID != "",
-		URL:            cluster.URL,
-		FailureDetails: failureDetails,
-	}
-
-	if user, found := client.LookupUser(cluster.Owner); found {
-		data.OwnerID = user.ID
+		changed = true
 	}
 
-	return data
+	return changed
 }
 
+// checkAndEnrichParameters combines the given request parameters with any
+// hardcoded or default flavor parameters. Every requested value is validated
+// against the type of its flavor parameter, and all violations are returned
+// at once as a codes.InvalidArgument error. Hardcoded values and defaults are
+// validated when the flavor is loaded by the registry instead.
 func checkAndEnrichParameters(flavorParams map[string]*v1.Parameter, requestParams map[string]string) ([]v1alpha1.Parameter, error) {
 	allParams := make([]v1alpha1.Parameter, 0, len(flavorParams))
+	var violations []string
 
 	for flavorParamName, flavorParam := range flavorParams {
 		requestValue, found := requestParams[flavorParamName]
@@ -937,7 +1146,8 @@ func checkAndEnrichParameters(flavorParams map[string]*v1.Parameter, requestPara
 			// Extra sanity check to reject any internal parameters from the
 			// user.
 			if found {
-				return nil, fmt.Errorf("rejecting an internal parameter: %q", flavorParamName)
+				violations = append(violations, fmt.Sprintf("rejecting an internal parameter: %q", flavorParamName))
+				continue
 			}
 
 			// Parameter is internally hardcoded.
@@ -957,11 +1167,21 @@ func checkAndEnrichParameters(flavorParams map[string]*v1.Parameter, requestPara
 		default:
 			// Parameter is required. The user must provide a value.
 			if !found {
-				return nil, fmt.Errorf("parameter %q was not provided", flavorParamName)
+				violations = append(violations, fmt.Sprintf("parameter %q was not provided", flavorParamName))
+				continue
 			}
 			value = requestValue
 		}
 
+		// Hardcoded values and defaults were validated when the flavor was
+		// registered, and an empty default leaves the choice to the workflow.
+		if found {
+			if err := flavor.ValidateParameterValue(flavorParam, value); err != nil {
+				violations = append(violations, fmt.Sprintf("parameter %q: %v", flavorParamName, err))
+				continue
+			}
+		}
+
 		anyString := v1alpha1.ParseAnyString(value)
 
 		allParams = append(allParams, v1alpha1.Parameter{
@@ -970,12 +1190,17 @@ func checkAndEnrichParameters(flavorParams map[string]*v1.Parameter, requestPara
 		})
 	}
 
+	// Internal parameters provided by the user were already rejected above.
 	for requestParamName := range requestParams {
-		flavorParam, found := flavorParams[requestParamName]
-		if !found || flavorParam.Internal {
-			return nil, fmt.Errorf("passed parameter %q is not defined for this flavor", requestParamName)
+		if _, found := flavorParams[requestParamName]; !found {
+			violations = append(violations, fmt.Sprintf("passed parameter %q is not defined for this flavor", requestParamName))
 		}
 	}
 
+	if len(violations) > 0 {
+		sort.Strings(violations)
+		return nil, status.Errorf(codes.InvalidArgument, "invalid parameters: %s", strings.Join(violations, "; "))
+	}
+
 	return allParams, nil
 }
@@ -1,6 +1,7 @@
 package cluster
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"regexp"
@@ -10,8 +11,12 @@ import (
 
 	"github.com/argoproj/argo-workflows/v4/pkg/apis/workflow/v1alpha1"
 	v1 "github.com/stackrox/infra/generated/api/v1"
+	"github.com/stackrox/infra/pkg/flavor"
 	"github.com/stackrox/infra/pkg/logging"
-	"github.com/stackrox/infra/pkg/slack"
+	"github.com/stackrox/infra/pkg/service/middleware"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/timestamppb"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/selection"
@@ -29,12 +34,15 @@ func getClusterIDFromWorkflow(workflow *v1alpha1.Workflow) string {
 // clusterFromWorkflow converts an Argo workflow into an infra cluster.
 func clusterFromWorkflow(workflow v1alpha1.Workflow) *v1.Cluster {
 	cluster := &v1.Cluster{
-		ID:          getClusterIDFromWorkflow(&workflow),
-		Status:      workflowStatus(workflow.Status),
-		Flavor:      GetFlavor(&workflow),
-		Owner:       GetOwner(&workflow),
-		Lifespan:    GetLifespan(&workflow),
-		Description: GetDescription(&workflow),
+		ID:            getClusterIDFromWorkflow(&workflow),
+		Status:        workflowStatus(workflow.Status),
+		Flavor:        GetFlavor(&workflow),
+		Owner:         GetOwner(&workflow),
+		Lifespan:      GetLifespan(&workflow),
+		Description:   GetDescription(&workflow),
+		Collaborators: GetCollaborators(&workflow),
+		Labels:        GetUserLabels(&workflow),
+		Teams:         GetTeams(&workflow),
 	}
 
 	cluster.CreatedOn = timestamppb.New(workflow.Status.StartedAt.UTC())
@@ -63,13 +71,108 @@ func isClusterOneOfAllowedStatuses(workflow *v1alpha1.Workflow, allowedStatuses
 	return slices.Contains(allowedStatuses, status)
 }
 
+// checkOwnerOrAdmin returns a codes.PermissionDenied error unless the caller is
+// the owner of the cluster backed by the given workflow, or an infra admin.
+func checkOwnerOrAdmin(ctx context.Context, workflow *v1alpha1.Workflow) error {
+	if middleware.HasAccess(ctx, middleware.Admin) {
+		return nil
+	}
+
+	owner, err := middleware.GetOwnerFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	if clusterOwner := GetOwner(workflow); owner != clusterOwner {
+		return status.Errorf(codes.PermissionDenied, "cluster %q is owned by %s",
+			getClusterIDFromWorkflow(workflow), clusterOwner,
+		)
+	}
+
+	return nil
+}
+
+// checkVisible returns a codes.PermissionDenied error unless the caller may
+// see the cluster backed by the given workflow. Clusters that belong to teams
+// are only visible to the members of those teams, their owner, collaborators
+// and infra admins. Other clusters are visible to everyone.
+func checkVisible(ctx context.Context, workflow *v1alpha1.Workflow) error {
+	if isVisible(ctx, workflow) {
+		return nil
+	}
+
+	return status.Errorf(codes.PermissionDenied, "cluster %q is only visible to the teams %s",
+		getClusterIDFromWorkflow(workflow), strings.Join(GetTeams(workflow), ", "),
+	)
+}
+
+// isVisible determines if the caller may see the cluster backed by the given
+// workflow, see checkVisible.
+func isVisible(ctx context.Context, workflow *v1alpha1.Workflow) bool {
+	return isVisibleTo(ctx, GetOwner(workflow), GetCollaborators(workflow), GetTeams(workflow))
+}
+
+// isVisibleTo determines if the caller may see a resource with the given
+// owner, collaborators and teams, by the same rules as clusters.
+func isVisibleTo(ctx context.Context, owner string, collaborators []string, teams []string) bool {
+	if len(teams) == 0 || middleware.HasAccess(ctx, middleware.Admin) {
+		return true
+	}
+
+	if email, err := middleware.GetOwnerFromContext(ctx); err == nil {
+		if email == owner || slices.Contains(collaborators, email) {
+			return true
+		}
+	}
+
+	return slices.ContainsFunc(middleware.TeamsFromContext(ctx), func(team string) bool {
+		return slices.Contains(teams, team)
+	})
+}
+
+// checkFlavorAvailable returns a codes.PermissionDenied error unless the
+// caller may create clusters of the flavor with the given ID. Infra admins may
+// use every flavor. Unknown flavors are left for create to reject.
+func (s *clusterImpl) checkFlavorAvailable(ctx context.Context, flavorID string) error {
+	flav, _, found := s.registry.Get(flavorID)
+	if !found || middleware.HasAccess(ctx, middleware.Admin) || flavor.IsAvailableTo(flav, middleware.TeamsFromContext(ctx)) {
+		return nil
+	}
+
+	return status.Errorf(codes.PermissionDenied, "flavor %q is only available to the teams %s",
+		flav.GetID(), strings.Join(flav.GetTeams(), ", "),
+	)
+}
+
+// checkOwnerOrForce returns a codes.PermissionDenied error unless the caller
+// is the owner of the given workflow, an admin, or forces the operation with a
+// reason. It reports whether the operation is forced on a cluster that the
+// caller would otherwise not be allowed to change.
+func checkOwnerOrForce(ctx context.Context, workflow *v1alpha1.Workflow, force bool, reason string) (bool, error) {
+	err := checkOwnerOrAdmin(ctx, workflow)
+	if err == nil {
+		return false, nil
+	}
+	if status.Code(err) != codes.PermissionDenied {
+		return false, err
+	}
+
+	if !force {
+		return false, status.Errorf(codes.PermissionDenied, "%s, force the operation with a reason to override", status.Convert(err).Message())
+	}
+	if strings.TrimSpace(reason) == "" {
+		return false, status.Error(codes.InvalidArgument, "a reason is required to force an operation on a cluster you do not own")
+	}
+
+	return true, nil
+}
+
 type metaCluster struct {
 	*v1.Cluster
 
 	EventID       string
 	Expired       bool
 	NearingExpiry bool
-	Slack         slack.Status
 	SlackDM       bool
 }
 
@@ -87,7 +190,6 @@ func (s *clusterImpl) metaClusterFromWorkflow(workflow v1alpha1.Workflow) (*meta
 
 	return &metaCluster{
 		Cluster:       cluster,
-		Slack:         slack.Status(GetSlack(&workflow)),
 		SlackDM:       GetSlackDM(&workflow),
 		Expired:       expired,
 		NearingExpiry: nearingExpiry,
@@ -111,7 +213,7 @@ func (s *clusterImpl) getClusterDetailsFromArtifacts(cluster *v1.Cluster, workfl
 		}
 
 		for _, artifact := range nodeStatus.Outputs.Artifacts {
-			if artifact.GCS == nil {
+			if !artifact.HasKey() {
 				continue
 			}
 
@@ -126,17 +228,17 @@ func (s *clusterImpl) getClusterDetailsFromArtifacts(cluster *v1.Cluster, workfl
 					continue
 				}
 
-				bucket, key := handleArtifactMigration(workflow, artifact)
+				bucket, key := artifactLocation(workflow, artifact)
 				if bucket == "" || key == "" {
 					continue
 				}
 
-				// Check cache first before making GCS API call
+				// Check cache first before making an artifact store API call
 				contents, found := s.artifactCache.Get(bucket, key)
 				if !found {
-					// Cache miss - fetch from GCS and cache the result
+					// Cache miss - fetch from the artifact store and cache the result
 					var err error
-					contents, err = s.signer.Contents(bucket, key)
+					contents, err = s.artifactStore.Contents(bucket, key)
 					if err != nil {
 						return nil, err
 					}
@@ -175,22 +277,21 @@ func metaClusterParametersFromWorkflow(workflow v1alpha1.Workflow) []*v1.Paramet
 	return parameters
 }
 
-func handleArtifactMigration(workflow v1alpha1.Workflow, artifact v1alpha1.Artifact) (string, string) {
+// artifactLocation returns the bucket and key of the given artifact. The
+// bucket of the workflow artifact repository takes precedence over that of
+// the artifact itself, in order to handle artifacts that have been migrated.
+// GCS, S3, OSS, and Azure artifact locations are supported.
+func artifactLocation(workflow v1alpha1.Workflow, artifact v1alpha1.Artifact) (string, string) {
 	var bucket string
-	var key string
-
-	if workflow.Status.ArtifactRepositoryRef != nil &&
-		workflow.Status.ArtifactRepositoryRef.ArtifactRepository.GCS != nil &&
-		workflow.Status.ArtifactRepositoryRef.ArtifactRepository.GCS.Bucket != "" {
-		bucket = workflow.Status.ArtifactRepositoryRef.ArtifactRepository.GCS.Bucket
-	} else if artifact.GCS != nil && artifact.GCS.Bucket != "" {
-		bucket = artifact.GCS.Bucket
+	if workflow.Status.ArtifactRepositoryRef != nil {
+		bucket = repositoryBucket(workflow.Status.ArtifactRepositoryRef.ArtifactRepository)
 	}
-
-	if artifact.GCS != nil && artifact.GCS.Key != "" {
-		key = artifact.GCS.Key
+	if bucket == "" {
+		bucket = artifactBucket(artifact)
 	}
 
+	key, _ := artifact.GetKey()
+
 	if bucket == "" || key == "" {
 		log.Log(logging.WARN, "cannot figure out bucket for artifact, possibly an upgrade issue, not fatal",
 			"workflow-name", workflow.Name,
@@ -203,6 +304,38 @@ func handleArtifactMigration(workflow v1alpha1.Workflow, artifact v1alpha1.Artif
 	return bucket, key
 }
 
+func repositoryBucket(repository *v1alpha1.ArtifactRepository) string {
+	switch {
+	case repository == nil:
+		return ""
+	case repository.GCS != nil:
+		return repository.GCS.Bucket
+	case repository.S3 != nil:
+		return repository.S3.Bucket
+	case repository.OSS != nil:
+		return repository.OSS.Bucket
+	case repository.Azure != nil:
+		return repository.Azure.Container
+	default:
+		return ""
+	}
+}
+
+func artifactBucket(artifact v1alpha1.Artifact) string {
+	switch {
+	case artifact.GCS != nil:
+		return artifact.GCS.Bucket
+	case artifact.S3 != nil:
+		return artifact.S3.Bucket
+	case artifact.OSS != nil:
+		return artifact.OSS.Bucket
+	case artifact.Azure != nil:
+		return artifact.Azure.Container
+	default:
+		return ""
+	}
+}
+
 func workflowStatus(workflowStatus v1alpha1.WorkflowStatus) v1.Status {
 	// https://godoc.org/github.com/argoproj/argo-workflows/v4/pkg/apis/workflow/v1alpha1#WorkflowStatus
 	switch workflowStatus.Phase {
@@ -296,6 +429,10 @@ func emailToLabelValue(email string) string {
 	return result
 }
 
+// validLabelValue matches Kubernetes label values, which must start and end
+// with alphanumeric, and can have .-_ in the middle.
+var validLabelValue = regexp.MustCompile(`^[A-Za-z0-9]([A-Za-z0-9._-]*[A-Za-z0-9])?$`)
+
 // validateClusterID validates that a cluster ID meets Kubernetes label value requirements.
 // Kubernetes label values must match ([A-Za-z0-9][-A-Za-z0-9_.]*)?[A-Za-z0-9] and be at most 63 characters.
 func validateClusterID(clusterID string) error {
@@ -307,8 +444,6 @@ func validateClusterID(clusterID string) error {
 		return fmt.Errorf("cluster ID %q exceeds maximum length of 63 characters (got %d)", clusterID, len(clusterID))
 	}
 
-	// Kubernetes label value regex: must start and end with alphanumeric, middle can have .-_
-	validLabelValue := regexp.MustCompile(`^[A-Za-z0-9]([A-Za-z0-9._-]*[A-Za-z0-9])?$`)
 	if !validLabelValue.MatchString(clusterID) {
 		return fmt.Errorf("cluster ID %q contains invalid characters: must start and end with alphanumeric, and contain only alphanumeric, dot, dash, or underscore", clusterID)
 	}
@@ -316,6 +451,22 @@ func validateClusterID(clusterID string) error {
 	return nil
 }
 
+// validateUserLabels validates that the names and values of user-defined
+// labels meet the same requirements as cluster IDs, as they are stored as
+// Kubernetes labels.
+func validateUserLabels(userLabels map[string]string) error {
+	for name, value := range userLabels {
+		if name == "" || len(name) > 63 || !validLabelValue.MatchString(name) {
+			return fmt.Errorf("label name %q is invalid: must be at most 63 characters, start and end with alphanumeric, and contain only alphanumeric, dot, dash, or underscore", name)
+		}
+		if value == "" || len(value) > 63 || !validLabelValue.MatchString(value) {
+			return fmt.Errorf("value %q of label %q is invalid: must be at most 63 characters, start and end with alphanumeric, and contain only alphanumeric, dot, dash, or underscore", value, name)
+		}
+	}
+
+	return nil
+}
+
 // buildLabelSelector constructs a Kubernetes label selector from a ClusterListRequest.
 // This enables server-side filtering to reduce the amount of data transferred and processed.
 func buildLabelSelector(req *v1.ClusterListRequest, email string) (labels.Selector, error) {
@@ -353,5 +504,55 @@ func buildLabelSelector(req *v1.ClusterListRequest, email string) (labels.Select
 		selector = selector.Add(*requirement)
 	}
 
+	// Filter by user-defined labels, which are namespaced in the workflow
+	// labels.
+	if req.GetLabelSelector() != "" {
+		userSelector, err := labels.Parse(req.GetLabelSelector())
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid label selector: %v", err)
+		}
+		requirements, _ := userSelector.Requirements()
+		for _, userRequirement := range requirements {
+			requirement, err := labels.NewRequirement(userLabel(userRequirement.Key()), userRequirement.Operator(), userRequirement.ValuesUnsorted())
+			if err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid label selector: %v", err)
+			}
+			selector = selector.Add(*requirement)
+		}
+	}
+
 	return selector, nil
 }
+
+// buildSharedLabelSelectors constructs Kubernetes label selectors from a
+// ClusterListRequest that match the clusters shared with the given email: one
+// for the clusters where it is a collaborator, and one for the clusters of
+// each of the given teams.
+func buildSharedLabelSelectors(req *v1.ClusterListRequest, email string, teams []string) ([]labels.Selector, error) {
+	if email == "" {
+		return nil, fmt.Errorf("no authenticated user found for shared cluster list request")
+	}
+
+	allReq := proto.Clone(req).(*v1.ClusterListRequest)
+	allReq.All = true
+	selector, err := buildLabelSelector(allReq, email)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := []string{collaboratorLabel(email)}
+	for _, team := range teams {
+		keys = append(keys, teamLabel(team))
+	}
+
+	var selectors []labels.Selector
+	for _, key := range keys {
+		requirement, err := labels.NewRequirement(key, selection.Equals, []string{"true"})
+		if err != nil {
+			return nil, err
+		}
+		selectors = append(selectors, selector.Add(*requirement))
+	}
+
+	return selectors, nil
+}
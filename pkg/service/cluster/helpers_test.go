@@ -1,11 +1,20 @@
 package cluster
 
 import (
+	"context"
 	"regexp"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/argoproj/argo-workflows/v4/pkg/apis/workflow/v1alpha1"
 	v1 "github.com/stackrox/infra/generated/api/v1"
+	"github.com/stackrox/infra/pkg/config"
+	"github.com/stackrox/infra/pkg/service/middleware"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func TestEmailToLabelValue(t *testing.T) {
@@ -175,6 +184,29 @@ func TestBuildLabelSelector_FilterDeletedWorkflows(t *testing.T) {
 			expectedClauses: []string{"infra.stackrox.com/deleted!=true"},
 			expectError:     false,
 		},
+		{
+			name: "with user-defined label selector",
+			request: &v1.ClusterListRequest{
+				All:           true,
+				Expired:       true,
+				LabelSelector: "team=sre,ticket,run notin (1,2)",
+			},
+			email: "user@example.com",
+			expectedClauses: []string{
+				"label.infra.stackrox.com/team=sre",
+				"label.infra.stackrox.com/ticket",
+				"label.infra.stackrox.com/run notin (1,2)",
+			},
+		},
+		{
+			name: "invalid user-defined label selector - should error",
+			request: &v1.ClusterListRequest{
+				All:           true,
+				LabelSelector: "team in (sre",
+			},
+			email:       "user@example.com",
+			expectError: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -308,3 +340,197 @@ func TestValidateClusterID(t *testing.T) {
 		})
 	}
 }
+
+func TestArtifactLocation(t *testing.T) {
+	tests := []struct {
+		name           string
+		repository     *v1alpha1.ArtifactRepository
+		location       v1alpha1.ArtifactLocation
+		expectedBucket string
+		expectedKey    string
+	}{
+		{
+			name: "gcs artifact",
+			location: v1alpha1.ArtifactLocation{
+				GCS: &v1alpha1.GCSArtifact{GCSBucket: v1alpha1.GCSBucket{Bucket: "gcs-bucket"}, Key: "wf/url.tgz"},
+			},
+			expectedBucket: "gcs-bucket",
+			expectedKey:    "wf/url.tgz",
+		},
+		{
+			name: "s3 artifact",
+			location: v1alpha1.ArtifactLocation{
+				S3: &v1alpha1.S3Artifact{S3Bucket: v1alpha1.S3Bucket{Bucket: "s3-bucket"}, Key: "wf/url.tgz"},
+			},
+			expectedBucket: "s3-bucket",
+			expectedKey:    "wf/url.tgz",
+		},
+		{
+			name: "azure artifact",
+			location: v1alpha1.ArtifactLocation{
+				Azure: &v1alpha1.AzureArtifact{AzureBlobContainer: v1alpha1.AzureBlobContainer{Container: "container"}, Blob: "wf/url.tgz"},
+			},
+			expectedBucket: "container",
+			expectedKey:    "wf/url.tgz",
+		},
+		{
+			name: "repository bucket takes precedence",
+			repository: &v1alpha1.ArtifactRepository{
+				S3: &v1alpha1.S3ArtifactRepository{S3Bucket: v1alpha1.S3Bucket{Bucket: "migrated-bucket"}},
+			},
+			location: v1alpha1.ArtifactLocation{
+				S3: &v1alpha1.S3Artifact{S3Bucket: v1alpha1.S3Bucket{Bucket: "s3-bucket"}, Key: "wf/url.tgz"},
+			},
+			expectedBucket: "migrated-bucket",
+			expectedKey:    "wf/url.tgz",
+		},
+		{
+			name: "key only artifact uses repository bucket",
+			repository: &v1alpha1.ArtifactRepository{
+				GCS: &v1alpha1.GCSArtifactRepository{GCSBucket: v1alpha1.GCSBucket{Bucket: "gcs-bucket"}},
+			},
+			location: v1alpha1.ArtifactLocation{
+				GCS: &v1alpha1.GCSArtifact{Key: "wf/url.tgz"},
+			},
+			expectedBucket: "gcs-bucket",
+			expectedKey:    "wf/url.tgz",
+		},
+		{
+			name: "missing bucket",
+			location: v1alpha1.ArtifactLocation{
+				S3: &v1alpha1.S3Artifact{Key: "wf/url.tgz"},
+			},
+		},
+		{
+			name: "unsupported location",
+			location: v1alpha1.ArtifactLocation{
+				HTTP: &v1alpha1.HTTPArtifact{URL: "https://example.com/url"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			workflow := v1alpha1.Workflow{}
+			if tt.repository != nil {
+				workflow.Status.ArtifactRepositoryRef = &v1alpha1.ArtifactRepositoryRefStatus{ArtifactRepository: tt.repository}
+			}
+
+			bucket, key := artifactLocation(workflow, v1alpha1.Artifact{Name: "url", ArtifactLocation: tt.location})
+			if bucket != tt.expectedBucket || key != tt.expectedKey {
+				t.Errorf("artifactLocation() = (%q, %q), expected (%q, %q)", bucket, key, tt.expectedBucket, tt.expectedKey)
+			}
+		})
+	}
+}
+
+func TestValidateUserLabels(t *testing.T) {
+	tests := []struct {
+		name        string
+		labels      map[string]string
+		expectError bool
+	}{
+		{
+			name:   "no labels",
+			labels: nil,
+		},
+		{
+			name:   "valid labels",
+			labels: map[string]string{"team": "sre", "ticket": "ROX-1234", "ci.run_id": "42"},
+		},
+		{
+			name:        "empty name",
+			labels:      map[string]string{"": "sre"},
+			expectError: true,
+		},
+		{
+			name:        "name with slash",
+			labels:      map[string]string{"example.com/team": "sre"},
+			expectError: true,
+		},
+		{
+			name:        "name too long",
+			labels:      map[string]string{strings.Repeat("a", 64): "sre"},
+			expectError: true,
+		},
+		{
+			name:        "empty value",
+			labels:      map[string]string{"team": ""},
+			expectError: true,
+		},
+		{
+			name:        "value with spaces",
+			labels:      map[string]string{"team": "site reliability"},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateUserLabels(tt.labels)
+			if tt.expectError && err == nil {
+				t.Errorf("validateUserLabels(%v) expected error but got none", tt.labels)
+			}
+			if !tt.expectError && err != nil {
+				t.Errorf("validateUserLabels(%v) expected no error but got: %v", tt.labels, err)
+			}
+		})
+	}
+}
+
+// teamContext returns the context of a request by the service account with
+// the given email, enriched with its teams.
+func teamContext(t *testing.T, email string, cfg *config.RBACConfig) context.Context {
+	ctx, err := middleware.RoleEnricher(cfg)(serviceAccountContext(t, &v1.ServiceAccount{Email: email}), nil)
+	require.NoError(t, err)
+	return ctx
+}
+
+func TestTeamVisibility(t *testing.T) {
+	rbac := &config.RBACConfig{
+		Teams: []config.TeamBinding{
+			{Name: "sre", Emails: []string{"owner@example.com", "teammate@example.com"}},
+			{Name: "qa", Emails: []string{"other@example.com"}},
+		},
+	}
+
+	teamCluster := clusterWorkflow("team", "owner@example.com", v1alpha1.WorkflowRunning, time.Now())
+	teamCluster.Labels[teamLabel("sre")] = "true"
+	sharedCluster := clusterWorkflow("shared", "owner@example.com", v1alpha1.WorkflowRunning, time.Now())
+	sharedCluster.Labels[teamLabel("sre")] = "true"
+	sharedCluster.Labels[collaboratorLabel("other@example.com")] = "true"
+	sharedCluster.Annotations[annotationCollaboratorsKey] = "other@example.com"
+	publicCluster := clusterWorkflow("public", "owner@example.com", v1alpha1.WorkflowRunning, time.Now())
+
+	s := newTestClusterService(t, nil, teamCluster, sharedCluster, publicCluster)
+
+	listed := func(ctx context.Context, all bool) []string {
+		resp, err := s.List(ctx, &v1.ClusterListRequest{All: all})
+		require.NoError(t, err)
+		var clusterIDs []string
+		for _, cluster := range resp.GetClusters() {
+			clusterIDs = append(clusterIDs, cluster.GetID())
+		}
+		return clusterIDs
+	}
+
+	// A team member sees the clusters of teammates, without owning them.
+	teammate := teamContext(t, "teammate@example.com", rbac)
+	cluster, err := s.Info(teammate, &v1.ClusterRequest{Id: "team"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"sre"}, cluster.GetTeams())
+	assert.ElementsMatch(t, []string{"team", "shared"}, listed(teammate, false))
+	assert.ElementsMatch(t, []string{"team", "shared", "public"}, listed(teammate, true))
+
+	// Anybody else is denied, unless the cluster is shared with them or does
+	// not belong to a team.
+	other := teamContext(t, "other@example.com", rbac)
+	_, err = s.Info(other, &v1.ClusterRequest{Id: "team"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = s.Info(other, &v1.ClusterRequest{Id: "shared"})
+	assert.NoError(t, err)
+	_, err = s.Info(other, &v1.ClusterRequest{Id: "public"})
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []string{"shared"}, listed(other, false))
+	assert.ElementsMatch(t, []string{"shared", "public"}, listed(other, true))
+}
@@ -0,0 +1,85 @@
+package cluster
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/argoproj/argo-workflows/v4/pkg/apis/workflow/v1alpha1"
+	v1 "github.com/stackrox/infra/generated/api/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// History implements ClusterService.History.
+func (s *clusterImpl) History(ctx context.Context, req *v1.ResourceByID) (*v1.ClusterHistoryResponse, error) {
+	workflows, err := s.getClusterWorkflows(req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	// Generations may belong to other teams or owners, when the cluster ID
+	// was reused, and are left out unless visible.
+	var visibilityErr error
+	resp := &v1.ClusterHistoryResponse{}
+	for i, workflow := range workflows {
+		if err := checkVisible(ctx, &workflow); err != nil {
+			visibilityErr = err
+			continue
+		}
+
+		cluster := clusterFromWorkflow(workflow)
+		cluster.Parameters = metaClusterParametersFromWorkflow(workflow)
+
+		resp.Generations = append(resp.Generations, &v1.ClusterGeneration{
+			Generation:     int32(len(workflows) - i),
+			WorkflowName:   workflow.GetName(),
+			Cluster:        cluster,
+			FailureDetails: workflowFailureDetails(workflow.Status).Error(),
+		})
+	}
+	if len(resp.GetGenerations()) == 0 && visibilityErr != nil {
+		return nil, visibilityErr
+	}
+
+	return resp, nil
+}
+
+// getClusterWorkflow returns the workflow of the given generation of the
+// cluster with the given ID, or of the most recent generation when no
+// generation is given.
+func (s *clusterImpl) getClusterWorkflow(clusterID string, generation string) (*v1alpha1.Workflow, error) {
+	workflows, err := s.getClusterWorkflows(clusterID)
+	if err != nil {
+		return nil, err
+	}
+
+	return selectGeneration(clusterID, workflows, generation)
+}
+
+// selectGeneration returns the workflow of the given generation from the
+// workflows of a cluster, most recently created first. The generation is
+// either the generation number, where 1 is the first, or a workflow name.
+func selectGeneration(clusterID string, workflows []v1alpha1.Workflow, generation string) (*v1alpha1.Workflow, error) {
+	if len(workflows) == 0 {
+		return nil, status.Errorf(codes.NotFound, "cluster %q not found", clusterID)
+	}
+
+	if generation == "" {
+		return &workflows[0], nil
+	}
+
+	if number, err := strconv.Atoi(generation); err == nil {
+		if number < 1 || number > len(workflows) {
+			return nil, status.Errorf(codes.NotFound, "cluster %q has no generation %d, only %d", clusterID, number, len(workflows))
+		}
+		return &workflows[len(workflows)-number], nil
+	}
+
+	for i := range workflows {
+		if workflows[i].GetName() == generation {
+			return &workflows[i], nil
+		}
+	}
+
+	return nil, status.Errorf(codes.NotFound, "cluster %q has no generation with workflow %q", clusterID, generation)
+}
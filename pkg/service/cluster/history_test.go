@@ -0,0 +1,129 @@
+package cluster
+
+import (
+	"testing"
+	"time"
+
+	"github.com/argoproj/argo-workflows/v4/pkg/apis/workflow/v1alpha1"
+	v1 "github.com/stackrox/infra/generated/api/v1"
+	"github.com/stackrox/infra/pkg/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestSelectGeneration(t *testing.T) {
+	// Most recently created first, as returned by listWorkflows.
+	workflows := []v1alpha1.Workflow{
+		{ObjectMeta: metav1.ObjectMeta{Name: "example-ghijk"}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "example-defgh"}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "example-abcde"}},
+	}
+
+	tests := []struct {
+		name       string
+		workflows  []v1alpha1.Workflow
+		generation string
+		expected   string
+		code       codes.Code
+	}{
+		{
+			name:       "most recent",
+			workflows:  workflows,
+			generation: "",
+			expected:   "example-ghijk",
+		},
+		{
+			name:       "first generation",
+			workflows:  workflows,
+			generation: "1",
+			expected:   "example-abcde",
+		},
+		{
+			name:       "last generation",
+			workflows:  workflows,
+			generation: "3",
+			expected:   "example-ghijk",
+		},
+		{
+			name:       "by workflow name",
+			workflows:  workflows,
+			generation: "example-defgh",
+			expected:   "example-defgh",
+		},
+		{
+			name:       "generation out of range",
+			workflows:  workflows,
+			generation: "4",
+			code:       codes.NotFound,
+		},
+		{
+			name:       "generation zero",
+			workflows:  workflows,
+			generation: "0",
+			code:       codes.NotFound,
+		},
+		{
+			name:       "unknown workflow name",
+			workflows:  workflows,
+			generation: "example-zzzzz",
+			code:       codes.NotFound,
+		},
+		{
+			name:       "no workflows",
+			workflows:  nil,
+			generation: "",
+			code:       codes.NotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			workflow, err := selectGeneration("example", tt.workflows, tt.generation)
+			if tt.code != codes.OK {
+				assert.Equal(t, tt.code, status.Code(err))
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, workflow.GetName())
+		})
+	}
+}
+
+func TestHistoryVisibility(t *testing.T) {
+	rbac := &config.RBACConfig{
+		Teams: []config.TeamBinding{
+			{Name: "sre", Emails: []string{"owner@example.com", "teammate@example.com"}},
+		},
+	}
+
+	// The first generation belongs to a team, the reused ID does not.
+	first := clusterWorkflow("example", "owner@example.com", v1alpha1.WorkflowFailed, time.Now().Add(-time.Hour))
+	first.Name = "example-abcde"
+	first.Labels[teamLabel("sre")] = "true"
+	second := clusterWorkflow("example", "other@example.com", v1alpha1.WorkflowRunning, time.Now())
+	second.Name = "example-fghij"
+	teamOnly := clusterWorkflow("team", "owner@example.com", v1alpha1.WorkflowRunning, time.Now())
+	teamOnly.Labels[teamLabel("sre")] = "true"
+
+	s := newTestClusterService(t, nil, first, second, teamOnly)
+
+	generations := func(email string) []int32 {
+		resp, err := s.History(teamContext(t, email, rbac), &v1.ResourceByID{Id: "example"})
+		require.NoError(t, err)
+		var numbers []int32
+		for _, generation := range resp.GetGenerations() {
+			numbers = append(numbers, generation.GetGeneration())
+		}
+		return numbers
+	}
+
+	assert.Equal(t, []int32{2, 1}, generations("teammate@example.com"))
+	assert.Equal(t, []int32{2}, generations("other@example.com"))
+
+	_, err := s.History(teamContext(t, "other@example.com", rbac), &v1.ResourceByID{Id: "team"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
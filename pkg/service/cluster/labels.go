@@ -1,5 +1,22 @@
 package cluster
 
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/argoproj/argo-workflows/v4/pkg/apis/workflow/v1alpha1"
+	v1 "github.com/stackrox/infra/generated/api/v1"
+	"github.com/stackrox/infra/pkg/logging"
+	"github.com/stackrox/infra/pkg/service/middleware"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
 const (
 	// labelClusterId is the label key used to map an infra cluster to
 	// an argo workflow.
@@ -15,6 +32,20 @@ const (
 	// This label is set after a workflow reaches FINISHED so that list queries can
 	// filter it out server-side without hiding in-progress destroy status.
 	labelDeleted = "infra.stackrox.com/deleted"
+
+	// labelCollaboratorPrefix is the label key prefix for cluster
+	// collaborators. Every collaborator has a label with their label-safe
+	// email as the name, so that clusters can be filtered by collaborator.
+	labelCollaboratorPrefix = "collaborator.infra.stackrox.com/"
+
+	// labelTeamPrefix is the label key prefix for the teams that a cluster
+	// belongs to. Every team has a label with its name as the name, so that
+	// clusters can be filtered by team.
+	labelTeamPrefix = "team.infra.stackrox.com/"
+
+	// labelUserPrefix is the label key prefix for user-defined labels, which
+	// keeps them apart from the labels managed by infra.
+	labelUserPrefix = "label.infra.stackrox.com/"
 )
 
 // Labeled represents a type that has labels.
@@ -26,3 +57,164 @@ type Labeled interface {
 func GetClusterID(a Labeled) string {
 	return a.GetLabels()[labelClusterID]
 }
+
+// collaboratorLabel returns the label key for the given collaborator email.
+// The email must have passed validateEmailLabel.
+func collaboratorLabel(email string) string {
+	return labelCollaboratorPrefix + emailToLabelValue(email)
+}
+
+// validateEmailLabel returns an error if the given email cannot be stored in
+// a label as is. Longer emails would be truncated, and could then collide with
+// other emails.
+func validateEmailLabel(email string) error {
+	labelSafe := strings.NewReplacer("@", ".at.", "+", ".plus.").Replace(email)
+	if len(labelSafe) > 63 {
+		return fmt.Errorf("email %q exceeds maximum length of 63 characters when stored as a label (got %d)", email, len(labelSafe))
+	}
+	if !validLabelValue.MatchString(labelSafe) {
+		return fmt.Errorf("email %q contains characters that cannot be stored in a label", email)
+	}
+
+	return nil
+}
+
+// teamLabel returns the label key for the given team.
+func teamLabel(team string) string {
+	return labelTeamPrefix + team
+}
+
+// GetTeams returns the teams that the cluster belongs to, sorted by name.
+func GetTeams(a Labeled) []string {
+	var teams []string
+	for key, value := range a.GetLabels() {
+		if team, found := strings.CutPrefix(key, labelTeamPrefix); found && value == "true" {
+			teams = append(teams, team)
+		}
+	}
+	sort.Strings(teams)
+
+	return teams
+}
+
+// userLabel returns the label key for the given user-defined label name.
+func userLabel(name string) string {
+	return labelUserPrefix + name
+}
+
+// GetUserLabels returns the user-defined labels if they exist.
+func GetUserLabels(a Labeled) map[string]string {
+	var userLabels map[string]string
+	for key, value := range a.GetLabels() {
+		name, found := strings.CutPrefix(key, labelUserPrefix)
+		if !found {
+			continue
+		}
+		if userLabels == nil {
+			userLabels = make(map[string]string)
+		}
+		userLabels[name] = value
+	}
+
+	return userLabels
+}
+
+// UpdateLabels implements ClusterService.UpdateLabels.
+func (s *clusterImpl) UpdateLabels(ctx context.Context, req *v1.UpdateLabelsRequest) (*v1.Cluster, error) {
+	actor, err := middleware.GetOwnerFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	log.AuditLog(logging.INFO, "cluster-labels", "received a labels update request for infra cluster",
+		"actor", actor,
+		"cluster-id", req.GetId(),
+		"set", req.GetSet(),
+		"remove", req.GetRemove(),
+	)
+
+	if err := validateUserLabels(req.GetSet()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid labels: %v", err)
+	}
+	for _, name := range req.GetRemove() {
+		if _, found := req.GetSet()[name]; found {
+			return nil, status.Errorf(codes.InvalidArgument, "label %q is both set and removed", name)
+		}
+	}
+
+	workflow, err := s.getMostRecentArgoWorkflowFromClusterID(req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkOwnerOrAdmin(ctx, workflow); err != nil {
+		return nil, err
+	}
+
+	payloadBytes, err := formatLabelsPatch(workflow, req.GetSet(), req.GetRemove())
+	if err != nil {
+		return nil, err
+	}
+	if payloadBytes == nil {
+		return clusterFromWorkflow(*workflow), nil
+	}
+
+	updated, err := s.k8sWorkflowsClient.Patch(ctx, workflow.GetName(), types.JSONPatchType, payloadBytes, metav1.PatchOptions{})
+	if err != nil {
+		log.Log(logging.ERROR, "error occurred updating the argo workflow", "workflow-name", workflow.GetName(), "error", err)
+		return nil, err
+	}
+
+	return clusterFromWorkflow(*updated), nil
+}
+
+// formatLabelsPatch returns a JSON patch that sets and removes the given
+// user-defined labels of a workflow, or nil if there is nothing to change.
+// Removing a label that does not exist is not an error.
+func formatLabelsPatch(workflow *v1alpha1.Workflow, set map[string]string, remove []string) ([]byte, error) {
+	// JSON Patch path uses ~1 to escape / in label names.
+	escape := func(key string) string {
+		return strings.ReplaceAll(strings.ReplaceAll(key, "~", "~0"), "/", "~1")
+	}
+
+	var payload []map[string]any
+	if workflow.GetLabels() == nil && len(set) > 0 {
+		payload = append(payload, map[string]any{
+			"op":    "add",
+			"path":  "/metadata/labels",
+			"value": map[string]string{},
+		})
+	}
+
+	names := make([]string, 0, len(set))
+	for name := range set {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		payload = append(payload, map[string]any{
+			"op":    "add",
+			"path":  "/metadata/labels/" + escape(userLabel(name)),
+			"value": set[name],
+		})
+	}
+
+	for _, name := range remove {
+		if _, found := workflow.GetLabels()[userLabel(name)]; !found {
+			continue
+		}
+		payload = append(payload, map[string]any{
+			"op":   "remove",
+			"path": "/metadata/labels/" + escape(userLabel(name)),
+		})
+	}
+
+	if len(payload) == 0 {
+		return nil, nil
+	}
+
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal labels patch: %w", err)
+	}
+	return data, nil
+}
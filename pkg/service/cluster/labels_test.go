@@ -0,0 +1,104 @@
+package cluster
+
+import (
+	"testing"
+
+	"github.com/argoproj/argo-workflows/v4/pkg/apis/workflow/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestGetUserLabels(t *testing.T) {
+	workflow := &v1alpha1.Workflow{ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{
+		labelClusterID:                   "example",
+		labelOwner:                       "user.at.example.com",
+		labelUserPrefix + "team":         "sre",
+		labelCollaboratorPrefix + "team": "true",
+	}}}
+
+	assert.Equal(t, map[string]string{"team": "sre"}, GetUserLabels(workflow))
+	assert.Nil(t, GetUserLabels(&v1alpha1.Workflow{}))
+}
+
+func TestFormatLabelsPatch(t *testing.T) {
+	tests := []struct {
+		name     string
+		labels   map[string]string
+		set      map[string]string
+		remove   []string
+		expected string
+	}{
+		{
+			name:   "sets and removes labels",
+			labels: map[string]string{labelUserPrefix + "ticket": "ROX-1"},
+			set:    map[string]string{"team": "sre"},
+			remove: []string{"ticket"},
+			expected: `[{"op":"add","path":"/metadata/labels/label.infra.stackrox.com~1team","value":"sre"},` +
+				`{"op":"remove","path":"/metadata/labels/label.infra.stackrox.com~1ticket"}]`,
+		},
+		{
+			name:   "adds labels to a workflow without any",
+			labels: nil,
+			set:    map[string]string{"team": "sre"},
+			expected: `[{"op":"add","path":"/metadata/labels","value":{}},` +
+				`{"op":"add","path":"/metadata/labels/label.infra.stackrox.com~1team","value":"sre"}]`,
+		},
+		{
+			name:   "ignores missing labels",
+			labels: map[string]string{labelClusterID: "example"},
+			remove: []string{"ticket"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			workflow := &v1alpha1.Workflow{ObjectMeta: metav1.ObjectMeta{Labels: test.labels}}
+			payload, err := formatLabelsPatch(workflow, test.set, test.remove)
+			require.NoError(t, err)
+			if test.expected == "" {
+				assert.Nil(t, payload)
+				return
+			}
+			assert.JSONEq(t, test.expected, string(payload))
+		})
+	}
+}
+
+func TestValidateEmailLabel(t *testing.T) {
+	tests := []struct {
+		name      string
+		email     string
+		expectErr bool
+	}{
+		{
+			name:  "plain",
+			email: "user@example.com",
+		},
+		{
+			name:  "plus addressing",
+			email: "user+infra@example.com",
+		},
+		{
+			name:      "too long once label-safe",
+			email:     "a.very.long.name.that.keeps.on.going@subdomain.of.example.com",
+			expectErr: true,
+		},
+		{
+			name:      "invalid characters",
+			email:     "user!@example.com",
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateEmailLabel(tt.email)
+			if tt.expectErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,110 @@
+package cluster
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"sync"
+
+	"github.com/argoproj/argo-workflows/v4/pkg/apis/workflow/v1alpha1"
+	v1 "github.com/stackrox/infra/generated/api/v1"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// StreamLogs implements ClusterService.StreamLogs.
+func (s *clusterImpl) StreamLogs(req *v1.StreamLogsRequest, stream v1.ClusterService_StreamLogsServer) error {
+	ctx, cancel := context.WithCancel(stream.Context())
+	defer cancel()
+
+	chunks := make(chan *v1.LogChunk)
+	following := make(map[string]struct{})
+	var wg sync.WaitGroup
+	var watchErr error
+
+	// Follow the logs of every pod node as soon as it is started, until the
+	// workflow completes and every pod has finished logging.
+	go func() {
+		watchErr = s.watchClusterWorkflow(ctx, req.GetId(), func(workflow v1alpha1.Workflow) (bool, error) {
+			for _, node := range workflow.Status.Nodes {
+				if !isLoggablePodNode(node, req.GetStep()) {
+					continue
+				}
+				if _, found := following[node.ID]; found {
+					continue
+				}
+				following[node.ID] = struct{}{}
+
+				wg.Add(1)
+				go func(node v1alpha1.NodeStatus) {
+					defer wg.Done()
+					s.followLogs(ctx, node, chunks)
+				}(node)
+			}
+
+			return workflow.Status.Phase.Completed(), nil
+		})
+
+		wg.Wait()
+		close(chunks)
+	}()
+
+	for chunk := range chunks {
+		if err := stream.Send(chunk); err != nil {
+			return err
+		}
+	}
+
+	return watchErr
+}
+
+// isLoggablePodNode determines if the given node is a pod, from the
+// (optionally) requested step, that has started running.
+func isLoggablePodNode(node v1alpha1.NodeStatus, step string) bool {
+	if node.Type != v1alpha1.NodeTypePod {
+		return false
+	}
+
+	if step != "" && node.DisplayName != step {
+		return false
+	}
+
+	return node.Phase == v1alpha1.NodeRunning || node.Fulfilled()
+}
+
+// followLogs streams the logs for the pod backing the given node, line by
+// line, until the pod terminates or the context is done.
+func (s *clusterImpl) followLogs(ctx context.Context, node v1alpha1.NodeStatus, chunks chan<- *v1.LogChunk) {
+	send := func(body []byte) bool {
+		select {
+		case chunks <- &v1.LogChunk{Name: node.DisplayName, Body: body}:
+			return true
+		case <-ctx.Done():
+			return false
+		}
+	}
+
+	stream, err := s.k8sPodsClient.GetLogs(determinePodName(node), &corev1.PodLogOptions{
+		Container:  "main",
+		Follow:     true,
+		Timestamps: true,
+	}).Stream(ctx)
+	if err != nil {
+		send([]byte(err.Error()))
+		return
+	}
+	defer stream.Close() //nolint:errcheck
+
+	reader := bufio.NewReader(stream)
+	for {
+		line, err := reader.ReadBytes('\n')
+		if len(line) > 0 && !send(line) {
+			return
+		}
+		if err != nil {
+			if err != io.EOF && ctx.Err() == nil {
+				send([]byte(err.Error()))
+			}
+			return
+		}
+	}
+}
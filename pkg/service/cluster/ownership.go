@@ -0,0 +1,177 @@
+package cluster
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/mail"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/argoproj/argo-workflows/v4/pkg/apis/workflow/v1alpha1"
+	v1 "github.com/stackrox/infra/generated/api/v1"
+	"github.com/stackrox/infra/pkg/logging"
+	"github.com/stackrox/infra/pkg/service/middleware"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// UpdateOwnership implements ClusterService.UpdateOwnership.
+func (s *clusterImpl) UpdateOwnership(ctx context.Context, req *v1.UpdateOwnershipRequest) (*v1.Cluster, error) {
+	actor, err := middleware.GetOwnerFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	log.AuditLog(logging.INFO, "cluster-ownership", "received an ownership update request for infra cluster",
+		"actor", actor,
+		"cluster-id", req.GetId(),
+		"owner", req.GetOwner(),
+		"add-collaborators", req.GetAddCollaborators(),
+		"remove-collaborators", req.GetRemoveCollaborators(),
+	)
+
+	for _, email := range slices.Concat([]string{req.GetOwner()}, req.GetAddCollaborators(), req.GetRemoveCollaborators()) {
+		if email == "" {
+			continue
+		}
+		if _, err := mail.ParseAddress(email); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid email address %q", email)
+		}
+	}
+
+	// Removed collaborators may predate this check, but new ones are stored
+	// in labels.
+	for _, email := range slices.Concat([]string{req.GetOwner()}, req.GetAddCollaborators()) {
+		if email == "" {
+			continue
+		}
+		if err := validateEmailLabel(email); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid email address: %v", err)
+		}
+	}
+
+	workflow, err := s.getMostRecentArgoWorkflowFromClusterID(req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkOwnerOrAdmin(ctx, workflow); err != nil {
+		return nil, err
+	}
+
+	owner := GetOwner(workflow)
+	patched := false
+	if req.GetOwner() != "" && req.GetOwner() != owner {
+		owner = req.GetOwner()
+
+		// A running cluster counts against the quotas of its new owner, and
+		// is reserved against them until the workflow cache has caught up. It
+		// keeps its teams, so team quotas are skipped, as the cluster already
+		// counts against them.
+		if isClusterOneOfAllowedStatuses(workflow, []v1.Status{v1.Status_CREATING, v1.Status_READY}) {
+			releaseQuota, err := s.reserveQuota(owner, nil, nil, GetFlavor(workflow), req.GetId())
+			if err != nil {
+				return nil, err
+			}
+			defer func() {
+				if !patched {
+					releaseQuota()
+				}
+			}()
+		}
+	}
+	collaborators := updateCollaborators(GetCollaborators(workflow), owner, req.GetAddCollaborators(), req.GetRemoveCollaborators())
+
+	payloadBytes, err := formatOwnershipPatch(workflow, owner, collaborators)
+	if err != nil {
+		return nil, err
+	}
+
+	updated, err := s.k8sWorkflowsClient.Patch(ctx, workflow.GetName(), types.JSONPatchType, payloadBytes, metav1.PatchOptions{})
+	if err != nil {
+		log.Log(logging.ERROR, "error occurred updating the argo workflow", "workflow-name", workflow.GetName(), "error", err)
+		return nil, err
+	}
+	patched = true
+
+	return clusterFromWorkflow(*updated), nil
+}
+
+// updateCollaborators returns the sorted collaborators after adding and
+// removing the given emails. The owner is never a collaborator.
+func updateCollaborators(current []string, owner string, add []string, remove []string) []string {
+	var collaborators []string
+	for _, email := range slices.Concat(current, add) {
+		if email == owner || slices.Contains(remove, email) || slices.Contains(collaborators, email) {
+			continue
+		}
+		collaborators = append(collaborators, email)
+	}
+	sort.Strings(collaborators)
+
+	return collaborators
+}
+
+// formatOwnershipPatch generates a raw patch for updating the owner and
+// collaborator annotations and labels of the given workflow.
+func formatOwnershipPatch(workflow *v1alpha1.Workflow, owner string, collaborators []string) ([]byte, error) {
+	// JSON Patch path uses ~1 to escape / in annotation and label names.
+	escape := func(key string) string {
+		return strings.ReplaceAll(strings.ReplaceAll(key, "~", "~0"), "/", "~1")
+	}
+
+	var payload []map[string]any
+	setAnnotation := func(key string, value string) {
+		payload = append(payload, map[string]any{
+			"op":    "add",
+			"path":  "/metadata/annotations/" + escape(key),
+			"value": value,
+		})
+	}
+	setLabel := func(key string, value string) {
+		payload = append(payload, map[string]any{
+			"op":    "add",
+			"path":  "/metadata/labels/" + escape(key),
+			"value": value,
+		})
+	}
+
+	if workflow.GetLabels() == nil {
+		payload = append(payload, map[string]any{
+			"op":    "add",
+			"path":  "/metadata/labels",
+			"value": map[string]string{},
+		})
+	}
+
+	setAnnotation(annotationOwnerKey, owner)
+	setAnnotation(annotationCollaboratorsKey, strings.Join(collaborators, ","))
+	setLabel(labelOwner, emailToLabelValue(owner))
+
+	// Drop the labels of collaborators that have been removed.
+	wanted := make(map[string]struct{}, len(collaborators))
+	for _, collaborator := range collaborators {
+		wanted[collaboratorLabel(collaborator)] = struct{}{}
+	}
+	for key := range workflow.GetLabels() {
+		if _, found := wanted[key]; !found && strings.HasPrefix(key, labelCollaboratorPrefix) {
+			payload = append(payload, map[string]any{
+				"op":   "remove",
+				"path": "/metadata/labels/" + escape(key),
+			})
+		}
+	}
+	for _, collaborator := range collaborators {
+		setLabel(collaboratorLabel(collaborator), "true")
+	}
+
+	patchBytes, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal ownership patch: %w", err)
+	}
+
+	return patchBytes, nil
+}
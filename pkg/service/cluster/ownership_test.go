@@ -0,0 +1,117 @@
+package cluster
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/argoproj/argo-workflows/v4/pkg/apis/workflow/v1alpha1"
+	argofake "github.com/argoproj/argo-workflows/v4/pkg/client/clientset/versioned/fake"
+	v1 "github.com/stackrox/infra/generated/api/v1"
+	"github.com/stackrox/infra/pkg/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestUpdateCollaborators(t *testing.T) {
+	tests := []struct {
+		name     string
+		current  []string
+		owner    string
+		add      []string
+		remove   []string
+		expected []string
+	}{
+		{
+			name:     "add to none",
+			owner:    "owner@redhat.com",
+			add:      []string{"b@redhat.com", "a@redhat.com"},
+			expected: []string{"a@redhat.com", "b@redhat.com"},
+		},
+		{
+			name:     "add existing",
+			current:  []string{"a@redhat.com"},
+			owner:    "owner@redhat.com",
+			add:      []string{"a@redhat.com"},
+			expected: []string{"a@redhat.com"},
+		},
+		{
+			name:     "remove",
+			current:  []string{"a@redhat.com", "b@redhat.com"},
+			owner:    "owner@redhat.com",
+			remove:   []string{"a@redhat.com"},
+			expected: []string{"b@redhat.com"},
+		},
+		{
+			name:     "new owner is not a collaborator",
+			current:  []string{"a@redhat.com", "b@redhat.com"},
+			owner:    "a@redhat.com",
+			add:      []string{"previous@redhat.com"},
+			expected: []string{"b@redhat.com", "previous@redhat.com"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, updateCollaborators(test.current, test.owner, test.add, test.remove))
+		})
+	}
+}
+
+func TestFormatOwnershipPatch(t *testing.T) {
+	workflow := &v1alpha1.Workflow{
+		ObjectMeta: metav1.ObjectMeta{
+			Labels: map[string]string{
+				labelOwner:                        "previous.at.redhat.com",
+				collaboratorLabel("a@redhat.com"): "true",
+				collaboratorLabel("b@redhat.com"): "true",
+			},
+		},
+	}
+
+	patchBytes, err := formatOwnershipPatch(workflow, "a@redhat.com", []string{"b@redhat.com", "previous@redhat.com"})
+	require.NoError(t, err)
+
+	var payload []map[string]any
+	require.NoError(t, json.Unmarshal(patchBytes, &payload))
+
+	assert.Equal(t, []map[string]any{
+		{"op": "add", "path": "/metadata/annotations/infra.stackrox.com~1owner", "value": "a@redhat.com"},
+		{"op": "add", "path": "/metadata/annotations/infra.stackrox.com~1collaborators", "value": "b@redhat.com,previous@redhat.com"},
+		{"op": "add", "path": "/metadata/labels/infra.stackrox.com~1owner", "value": "a.at.redhat.com"},
+		{"op": "remove", "path": "/metadata/labels/collaborator.infra.stackrox.com~1a.at.redhat.com"},
+		{"op": "add", "path": "/metadata/labels/collaborator.infra.stackrox.com~1b.at.redhat.com", "value": "true"},
+		{"op": "add", "path": "/metadata/labels/collaborator.infra.stackrox.com~1previous.at.redhat.com", "value": "true"},
+	}, payload)
+}
+
+func TestUpdateOwnershipQuota(t *testing.T) {
+	now := time.Now()
+	running := clusterWorkflow("running", "a@example.com", v1alpha1.WorkflowRunning, now)
+	other := clusterWorkflow("other", "b@example.com", v1alpha1.WorkflowRunning, now)
+	ctx := serviceAccountContext(t, &v1.ServiceAccount{Email: "a@example.com"})
+	req := &v1.UpdateOwnershipRequest{Id: "running", Owner: "b@example.com"}
+
+	// The new owner has no room for another cluster.
+	s := newTestClusterService(t, &config.QuotaConfig{PerOwner: 1}, running, other)
+	s.k8sWorkflowsClient = argofake.NewSimpleClientset(&running).ArgoprojV1alpha1().Workflows(running.Namespace)
+	_, err := s.UpdateOwnership(ctx, req)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	// The reservation is released when the workflow cannot be patched.
+	s = newTestClusterService(t, &config.QuotaConfig{PerOwner: 1}, running)
+	s.k8sWorkflowsClient = argofake.NewSimpleClientset().ArgoprojV1alpha1().Workflows(running.Namespace)
+	_, err = s.UpdateOwnership(ctx, req)
+	assert.Error(t, err)
+	assert.NotContains(t, s.pendingClusters, "running")
+
+	// The transferred cluster counts against the quota of the new owner
+	// until the workflow cache has caught up.
+	s.k8sWorkflowsClient = argofake.NewSimpleClientset(&running).ArgoprojV1alpha1().Workflows(running.Namespace)
+	_, err = s.UpdateOwnership(ctx, req)
+	require.NoError(t, err)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(s.checkQuota("b@example.com", nil, "gke-default")))
+}
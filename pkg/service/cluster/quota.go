@@ -0,0 +1,381 @@
+package cluster
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	v1 "github.com/stackrox/infra/generated/api/v1"
+	"github.com/stackrox/infra/pkg/config"
+	"github.com/stackrox/infra/pkg/service/middleware"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
+)
+
+// quotaPendingTTL is how long a cluster that passed the quota check counts
+// against the quotas on its own, until the workflow cache has caught up with
+// its workflow.
+const quotaPendingTTL = 2 * time.Minute
+
+// pendingCluster is a cluster that passed the quota check, but whose workflow
+// may not be in the workflow cache yet.
+type pendingCluster struct {
+	owner   string
+	teams   []string
+	flavor  string
+	expires time.Time
+}
+
+// quotaLimit is a single limit on the number of concurrent clusters, scoped to
+// an owner, a flavor, or both, or to a team. Limits of a token scope apply to
+// the owner of the token.
+type quotaLimit struct {
+	owner  string
+	team   string
+	flavor string
+	limit  int
+	scoped bool
+}
+
+// description returns a human readable description of the limit.
+func (l quotaLimit) description() string {
+	switch {
+	case l.scoped:
+		return fmt.Sprintf("%d concurrent clusters per token scope", l.limit)
+	case l.team != "":
+		return fmt.Sprintf("%d concurrent clusters for team %s", l.limit, l.team)
+	case l.owner != "" && l.flavor != "":
+		return fmt.Sprintf("%d concurrent %s clusters per owner", l.limit, l.flavor)
+	case l.owner != "":
+		return fmt.Sprintf("%d concurrent clusters per owner", l.limit)
+	default:
+		return fmt.Sprintf("%d concurrent %s clusters", l.limit, l.flavor)
+	}
+}
+
+// quotaLimits returns the limits that apply to the given owner, to the given
+// teams, and when given a flavor ID, to that flavor. When no flavor ID is
+// given, the limits for every configured flavor are returned.
+func quotaLimits(cfg *config.QuotaConfig, owner string, teams []string, flavorID string) []quotaLimit {
+	if cfg == nil || isQuotaExempt(cfg, owner) {
+		return nil
+	}
+
+	var limits []quotaLimit
+	if cfg.PerOwner > 0 {
+		limits = append(limits, quotaLimit{owner: owner, limit: cfg.PerOwner})
+	}
+
+	for _, team := range sortedKeys(cfg.PerTeam) {
+		if limit := cfg.PerTeam[team]; limit > 0 && slices.Contains(teams, team) {
+			limits = append(limits, quotaLimit{team: team, limit: limit})
+		}
+	}
+
+	for _, flavor := range sortedKeys(cfg.PerFlavor) {
+		if limit := cfg.PerFlavor[flavor]; limit > 0 && (flavorID == "" || flavorID == flavor) {
+			limits = append(limits, quotaLimit{flavor: flavor, limit: limit})
+		}
+	}
+
+	for _, flavor := range sortedKeys(cfg.PerOwnerPerFlavor) {
+		if limit := cfg.PerOwnerPerFlavor[flavor]; limit > 0 && (flavorID == "" || flavorID == flavor) {
+			limits = append(limits, quotaLimit{owner: owner, flavor: flavor, limit: limit})
+		}
+	}
+
+	return limits
+}
+
+// isQuotaExempt determines if the given owner is exempt from all quotas.
+func isQuotaExempt(cfg *config.QuotaConfig, owner string) bool {
+	return cfg != nil && slices.Contains(cfg.Exempt, owner)
+}
+
+func sortedKeys(m map[string]int) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// quotaSelector builds a label selector matching the clusters that count
+// against the given limit.
+func quotaSelector(limit quotaLimit) (labels.Selector, error) {
+	selector := labels.NewSelector()
+
+	requirement, err := labels.NewRequirement(labelDeleted, selection.NotEquals, []string{"true"})
+	if err != nil {
+		return nil, err
+	}
+	selector = selector.Add(*requirement)
+
+	if limit.owner != "" {
+		requirement, err := labels.NewRequirement(labelOwner, selection.Equals, []string{emailToLabelValue(limit.owner)})
+		if err != nil {
+			return nil, err
+		}
+		selector = selector.Add(*requirement)
+	}
+
+	if limit.team != "" {
+		requirement, err := labels.NewRequirement(teamLabel(limit.team), selection.Equals, []string{"true"})
+		if err != nil {
+			return nil, err
+		}
+		selector = selector.Add(*requirement)
+	}
+
+	if limit.flavor != "" {
+		requirement, err := labels.NewRequirement(labelFlavor, selection.Equals, []string{limit.flavor})
+		if err != nil {
+			return nil, err
+		}
+		selector = selector.Add(*requirement)
+	}
+
+	return selector, nil
+}
+
+// activeClusterIDs returns the IDs of the creating or ready clusters that
+// count against the given limit.
+func (s *clusterImpl) activeClusterIDs(limit quotaLimit) ([]string, error) {
+	selector, err := quotaSelector(limit)
+	if err != nil {
+		return nil, err
+	}
+
+	workflows, err := s.listWorkflows(selector)
+	if err != nil {
+		return nil, err
+	}
+
+	var clusterIDs []string
+	for _, workflow := range workflows {
+		if isClusterOneOfAllowedStatuses(&workflow, []v1.Status{v1.Status_CREATING, v1.Status_READY}) {
+			clusterIDs = append(clusterIDs, getClusterIDFromWorkflow(&workflow))
+		}
+	}
+
+	// Clusters that were just created count even before the workflow cache
+	// has caught up with them.
+	for _, clusterID := range s.pendingClusterIDs(limit) {
+		if !slices.Contains(clusterIDs, clusterID) {
+			clusterIDs = append(clusterIDs, clusterID)
+		}
+	}
+	sort.Strings(clusterIDs)
+
+	return clusterIDs, nil
+}
+
+// pendingClusterIDs returns the IDs of the pending clusters that count
+// against the given limit, and forgets the expired ones.
+func (s *clusterImpl) pendingClusterIDs(limit quotaLimit) []string {
+	s.pendingLock.Lock()
+	defer s.pendingLock.Unlock()
+
+	now := time.Now()
+	var clusterIDs []string
+	for clusterID, pending := range s.pendingClusters {
+		if now.After(pending.expires) {
+			delete(s.pendingClusters, clusterID)
+			continue
+		}
+		if (limit.owner == "" || limit.owner == pending.owner) &&
+			(limit.team == "" || slices.Contains(pending.teams, limit.team)) &&
+			(limit.flavor == "" || limit.flavor == pending.flavor) {
+			clusterIDs = append(clusterIDs, clusterID)
+		}
+	}
+
+	return clusterIDs
+}
+
+// reserveQuota checks the quotas like checkQuota, as well as the number of
+// clusters that the given token scope allows, if any. The cluster with the
+// given ID does not count against them itself, and is counted right away
+// afterwards, so that concurrent creations cannot exceed them. The returned
+// function releases the reservation, and must be called if the cluster is not
+// created after all.
+func (s *clusterImpl) reserveQuota(owner string, teams []string, scope *v1.TokenScope, flavorID string, clusterID string) (func(), error) {
+	s.quotaLock.Lock()
+	defer s.quotaLock.Unlock()
+
+	return s.reserveQuotaLocked(owner, teams, scope, flavorID, clusterID)
+}
+
+// reserveNewCluster is reserveQuota for a cluster that is about to be
+// created. A codes.AlreadyExists error is returned if a cluster with the same
+// ID was just reserved, as its workflow may not be in the workflow cache yet.
+func (s *clusterImpl) reserveNewCluster(owner string, teams []string, scope *v1.TokenScope, flavorID string, clusterID string) (func(), error) {
+	s.quotaLock.Lock()
+	defer s.quotaLock.Unlock()
+
+	if s.isPending(clusterID) {
+		return nil, status.Errorf(codes.AlreadyExists, "An infra cluster ID %q is already being created.", clusterID)
+	}
+
+	return s.reserveQuotaLocked(owner, teams, scope, flavorID, clusterID)
+}
+
+// isPending determines if the cluster with the given ID is reserved, and the
+// reservation has not expired yet.
+func (s *clusterImpl) isPending(clusterID string) bool {
+	s.pendingLock.Lock()
+	defer s.pendingLock.Unlock()
+
+	pending, found := s.pendingClusters[clusterID]
+	return found && time.Now().Before(pending.expires)
+}
+
+// reserveQuotaLocked is reserveQuota for callers that hold the quota lock.
+func (s *clusterImpl) reserveQuotaLocked(owner string, teams []string, scope *v1.TokenScope, flavorID string, clusterID string) (func(), error) {
+	if err := s.checkQuotaLocked(owner, teams, scope, flavorID, clusterID); err != nil {
+		return nil, err
+	}
+
+	s.pendingLock.Lock()
+	defer s.pendingLock.Unlock()
+	if s.pendingClusters == nil {
+		s.pendingClusters = make(map[string]pendingCluster)
+	}
+	previous, reserved := s.pendingClusters[clusterID]
+	s.pendingClusters[clusterID] = pendingCluster{
+		owner:   owner,
+		teams:   teams,
+		flavor:  flavorID,
+		expires: time.Now().Add(quotaPendingTTL),
+	}
+
+	return func() {
+		s.pendingLock.Lock()
+		defer s.pendingLock.Unlock()
+		// An earlier reservation of the same cluster is kept.
+		if reserved {
+			s.pendingClusters[clusterID] = previous
+			return
+		}
+		delete(s.pendingClusters, clusterID)
+	}, nil
+}
+
+// checkQuota returns a codes.ResourceExhausted error if creating another
+// cluster of the given flavor for the given owner, which belongs to the given
+// teams, would exceed any quota.
+func (s *clusterImpl) checkQuota(owner string, teams []string, flavorID string) error {
+	s.quotaLock.Lock()
+	defer s.quotaLock.Unlock()
+
+	return s.checkQuotaLocked(owner, teams, nil, flavorID, "")
+}
+
+// checkQuotaLocked is checkQuota for callers that hold the quota lock, which
+// also checks the number of clusters that the given token scope allows. The
+// cluster with the given ID, if any, is not counted.
+func (s *clusterImpl) checkQuotaLocked(owner string, teams []string, scope *v1.TokenScope, flavorID string, clusterID string) error {
+	limits := quotaLimits(s.quota, owner, teams, flavorID)
+	// Scoped tokens are limited even when their owner is exempt from quotas.
+	if maxClusters := int(scope.GetMaxClusters()); maxClusters > 0 {
+		limits = append(limits, quotaLimit{owner: owner, limit: maxClusters, scoped: true})
+	}
+
+	for _, limit := range limits {
+		clusterIDs, err := s.activeClusterIDs(limit)
+		if err != nil {
+			return err
+		}
+		clusterIDs = slices.DeleteFunc(clusterIDs, func(id string) bool {
+			return clusterID != "" && id == clusterID
+		})
+
+		if len(clusterIDs) >= limit.limit {
+			return status.Errorf(codes.ResourceExhausted,
+				"quota of %s exceeded, clusters counting against it: %s",
+				limit.description(), strings.Join(clusterIDs, ", "),
+			)
+		}
+	}
+
+	return nil
+}
+
+type quotaImpl struct {
+	v1.UnimplementedQuotaServiceServer
+	cluster *clusterImpl
+}
+
+var (
+	_ middleware.APIService = (*quotaImpl)(nil)
+	_ v1.QuotaServiceServer = (*quotaImpl)(nil)
+)
+
+// NewQuotaService creates a new QuotaService, which reports on the quotas
+// enforced by the given ClusterService.
+func NewQuotaService(clusterService middleware.APIService) (middleware.APIService, error) {
+	cluster, ok := clusterService.(*clusterImpl)
+	if !ok {
+		return nil, errors.New("quota service requires a cluster service")
+	}
+
+	return &quotaImpl{cluster: cluster}, nil
+}
+
+// Get implements QuotaService.Get.
+func (s *quotaImpl) Get(ctx context.Context, _ *empty.Empty) (*v1.QuotaResponse, error) {
+	owner, err := middleware.GetOwnerFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &v1.QuotaResponse{
+		Exempt: isQuotaExempt(s.cluster.quota, owner),
+	}
+
+	for _, limit := range quotaLimits(s.cluster.quota, owner, middleware.TeamsFromContext(ctx), "") {
+		clusterIDs, err := s.cluster.activeClusterIDs(limit)
+		if err != nil {
+			return nil, err
+		}
+
+		resp.Usage = append(resp.Usage, &v1.QuotaUsage{
+			Description: limit.description(),
+			Owner:       limit.owner,
+			Team:        limit.team,
+			Flavor:      limit.flavor,
+			Limit:       int32(limit.limit),
+			Used:        int32(len(clusterIDs)),
+			Clusters:    clusterIDs,
+		})
+	}
+
+	return resp, nil
+}
+
+// Access configures access for this service.
+func (s *quotaImpl) Access() map[string]middleware.Access {
+	return map[string]middleware.Access{
+		"/v1.QuotaService/Get": middleware.Viewer,
+	}
+}
+
+// RegisterServiceServer registers this service with the given gRPC Server.
+func (s *quotaImpl) RegisterServiceServer(server *grpc.Server) {
+	v1.RegisterQuotaServiceServer(server, s)
+}
+
+// RegisterServiceHandler registers this service with the given gRPC Gateway endpoint.
+func (s *quotaImpl) RegisterServiceHandler(ctx context.Context, mux *runtime.ServeMux, conn *grpc.ClientConn) error {
+	return v1.RegisterQuotaServiceHandler(ctx, mux, conn)
+}
@@ -0,0 +1,227 @@
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/argoproj/argo-workflows/v4/pkg/apis/workflow/v1alpha1"
+	v1 "github.com/stackrox/infra/generated/api/v1"
+	"github.com/stackrox/infra/pkg/config"
+	"github.com/stackrox/infra/pkg/flavor"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// newTestClusterService creates a cluster service backed by a synced
+// workflow cache of the given workflows.
+func newTestClusterService(t *testing.T, quota *config.QuotaConfig, workflows ...v1alpha1.Workflow) *clusterImpl {
+	c, _ := newTestWorkflowCache(workflows...)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	c.run(ctx)
+	require.True(t, c.synced())
+
+	return &clusterImpl{workflows: c, quota: quota, registry: &flavor.Registry{}}
+}
+
+// newTestRegistry creates a flavor registry with the default flavor
+// "gke-default", which has the alias "gke".
+func newTestRegistry(t *testing.T) *flavor.Registry {
+	dir := t.TempDir()
+	workflowFile := filepath.Join(dir, "workflow.yaml")
+	require.NoError(t, os.WriteFile(workflowFile, []byte(`apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+spec:
+  arguments:
+    parameters:
+      - name: name
+`), 0o600))
+
+	flavorsFile := filepath.Join(dir, "flavors.yaml")
+	require.NoError(t, os.WriteFile(flavorsFile, []byte(fmt.Sprintf(`- id: gke-default
+  name: GKE
+  description: GKE flavor
+  availability: default
+  workflow: %s
+  aliases:
+    - gke
+  parameters:
+    - name: name
+      description: cluster name
+      kind: required
+`, workflowFile)), 0o600))
+
+	registry, err := flavor.NewFromConfig(flavorsFile)
+	require.NoError(t, err)
+	return registry
+}
+
+func TestQuotaLimits(t *testing.T) {
+	cfg := &config.QuotaConfig{
+		PerOwner: 5,
+		PerFlavor: map[string]int{
+			"openshift-4": 20,
+			"gke-default": 0,
+		},
+		PerOwnerPerFlavor: map[string]int{
+			"openshift-4": 2,
+		},
+		PerTeam: map[string]int{
+			"sre": 10,
+			"qa":  0,
+		},
+		Exempt: []string{"ci@example.com"},
+	}
+
+	tests := []struct {
+		name     string
+		cfg      *config.QuotaConfig
+		owner    string
+		teams    []string
+		flavorID string
+		expected []quotaLimit
+	}{
+		{
+			name:     "no quota configured",
+			owner:    "user@example.com",
+			flavorID: "openshift-4",
+		},
+		{
+			name:     "exempt owner",
+			cfg:      cfg,
+			owner:    "ci@example.com",
+			flavorID: "openshift-4",
+		},
+		{
+			name:     "flavor with all limits",
+			cfg:      cfg,
+			owner:    "user@example.com",
+			flavorID: "openshift-4",
+			expected: []quotaLimit{
+				{owner: "user@example.com", limit: 5},
+				{flavor: "openshift-4", limit: 20},
+				{owner: "user@example.com", flavor: "openshift-4", limit: 2},
+			},
+		},
+		{
+			name:     "flavor without limits",
+			cfg:      cfg,
+			owner:    "user@example.com",
+			flavorID: "gke-default",
+			expected: []quotaLimit{
+				{owner: "user@example.com", limit: 5},
+			},
+		},
+		{
+			name:     "team members",
+			cfg:      cfg,
+			owner:    "user@example.com",
+			teams:    []string{"qa", "sre"},
+			flavorID: "gke-default",
+			expected: []quotaLimit{
+				{owner: "user@example.com", limit: 5},
+				{team: "sre", limit: 10},
+			},
+		},
+		{
+			name:  "all flavors",
+			cfg:   cfg,
+			owner: "user@example.com",
+			expected: []quotaLimit{
+				{owner: "user@example.com", limit: 5},
+				{flavor: "openshift-4", limit: 20},
+				{owner: "user@example.com", flavor: "openshift-4", limit: 2},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, quotaLimits(test.cfg, test.owner, test.teams, test.flavorID))
+		})
+	}
+}
+
+func TestReserveQuota(t *testing.T) {
+	s := newTestClusterService(t, &config.QuotaConfig{PerOwner: 2},
+		cachedWorkflow("running-abcde", time.Now(), map[string]string{labelClusterID: "running", labelOwner: emailToLabelValue("a@example.com"), labelFlavor: "gke-default"}),
+	)
+
+	// The pending cluster counts before its workflow is cached.
+	release, err := s.reserveQuota("a@example.com", nil, nil, "gke-default", "pending")
+	require.NoError(t, err)
+
+	_, err = s.reserveQuota("a@example.com", nil, nil, "gke-default", "exceeding")
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	assert.Equal(t, codes.ResourceExhausted, status.Code(s.checkQuota("a@example.com", nil, "qa-demo")))
+	assert.NoError(t, s.checkQuota("b@example.com", nil, "gke-default"))
+
+	clusterIDs, err := s.activeClusterIDs(quotaLimit{owner: "a@example.com", limit: 2})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"pending", "running"}, clusterIDs)
+
+	// A released cluster, which was not created after all, no longer counts.
+	release()
+	assert.NoError(t, s.checkQuota("a@example.com", nil, "gke-default"))
+
+	// Expired pending clusters are forgotten.
+	_, err = s.reserveQuota("a@example.com", nil, nil, "gke-default", "expired")
+	require.NoError(t, err)
+	s.pendingClusters["expired"] = pendingCluster{owner: "a@example.com", flavor: "gke-default", expires: time.Now().Add(-time.Second)}
+	assert.NoError(t, s.checkQuota("a@example.com", nil, "gke-default"))
+	assert.NotContains(t, s.pendingClusters, "expired")
+}
+
+func TestTeamQuota(t *testing.T) {
+	teamCluster := cachedWorkflow("team-abcde", time.Now(), map[string]string{labelClusterID: "team", labelOwner: emailToLabelValue("a@example.com"), labelFlavor: "gke-default", teamLabel("sre"): "true"})
+	s := newTestClusterService(t, &config.QuotaConfig{PerTeam: map[string]int{"sre": 2}}, teamCluster)
+
+	// Clusters of teammates count against the quota of the team.
+	_, err := s.reserveQuota("b@example.com", []string{"sre"}, nil, "gke-default", "pending")
+	require.NoError(t, err)
+	err = s.checkQuota("c@example.com", []string{"qa", "sre"}, "gke-default")
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	assert.Contains(t, status.Convert(err).Message(), "2 concurrent clusters for team sre")
+	assert.Contains(t, status.Convert(err).Message(), "pending, team")
+
+	// Other teams are not affected.
+	assert.NoError(t, s.checkQuota("c@example.com", []string{"qa"}, "gke-default"))
+	assert.NoError(t, s.checkQuota("c@example.com", nil, "gke-default"))
+}
+
+func TestCreateQuotaWithAlias(t *testing.T) {
+	s := newTestClusterService(t, &config.QuotaConfig{PerFlavor: map[string]int{"gke-default": 1}},
+		cachedWorkflow("running-abcde", time.Now(), map[string]string{labelClusterID: "running", labelOwner: emailToLabelValue("a@example.com"), labelFlavor: "gke-default"}),
+	)
+	s.registry = newTestRegistry(t)
+
+	// The alias counts against the limits of the flavor it stands for.
+	_, err := s.create(&v1.CreateClusterRequest{ID: "gke", Parameters: map[string]string{"name": "second"}}, "b@example.com", nil, nil, "")
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+}
+
+func TestCreatePendingClusterID(t *testing.T) {
+	s := newTestClusterService(t, nil)
+	s.registry = newTestRegistry(t)
+
+	// A cluster with the same ID is being created, but its workflow is not
+	// cached yet.
+	release, err := s.reserveNewCluster("a@example.com", nil, nil, "gke-default", "pending")
+	require.NoError(t, err)
+
+	_, err = s.create(&v1.CreateClusterRequest{ID: "gke-default", Parameters: map[string]string{"name": "pending"}}, "b@example.com", nil, nil, "")
+	assert.Equal(t, codes.AlreadyExists, status.Code(err))
+
+	// The ID can be reused once the reservation is released.
+	release()
+	release, err = s.reserveNewCluster("b@example.com", nil, nil, "gke-default", "pending")
+	require.NoError(t, err)
+	release()
+}
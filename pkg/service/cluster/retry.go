@@ -0,0 +1,121 @@
+package cluster
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	workflowpkg "github.com/argoproj/argo-workflows/v4/pkg/apiclient/workflow"
+	"github.com/argoproj/argo-workflows/v4/pkg/apis/workflow/v1alpha1"
+	v1 "github.com/stackrox/infra/generated/api/v1"
+	"github.com/stackrox/infra/pkg/logging"
+	"github.com/stackrox/infra/pkg/notifier"
+	"github.com/stackrox/infra/pkg/service/middleware"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// Retry implements ClusterService.Retry.
+func (s *clusterImpl) Retry(ctx context.Context, req *v1.ResourceByID) (*v1.ResourceByID, error) {
+	owner, err := middleware.GetOwnerFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	log.AuditLog(logging.INFO, "cluster-retry", "received a retry request for infra cluster",
+		"actor", owner,
+		"cluster-id", req.GetId(),
+	)
+
+	workflow, err := s.getMostRecentArgoWorkflowFromClusterID(req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkOwnerOrAdmin(ctx, workflow); err != nil {
+		return nil, err
+	}
+
+	if current := workflowStatus(workflow.Status); current != v1.Status_FAILED {
+		return nil, status.Errorf(codes.FailedPrecondition, "only failed clusters can be retried, cluster %q is %s", req.GetId(), current)
+	}
+
+	// The retried cluster keeps its lifespan, which may have run out while
+	// it was failed.
+	if isWorkflowExpired(*workflow) {
+		return nil, status.Errorf(codes.FailedPrecondition, "cluster %q has expired, create a new one instead", req.GetId())
+	}
+
+	// A retried cluster counts against the quotas again, like a new one.
+	flavorID := GetFlavor(workflow)
+	if err := checkTokenScope(ctx, flavorID, GetLifespan(workflow)); err != nil {
+		return nil, err
+	}
+	releaseQuota, err := s.reserveQuota(GetOwner(workflow), GetTeams(workflow), middleware.ScopeFromContext(ctx), flavorID, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	// Argo resets the failed steps, and the workflow resumes from there.
+	retried, err := s.argoWorkflowsClient.RetryWorkflow(s.argoClientCtx, &workflowpkg.WorkflowRetryRequest{
+		Name:      workflow.GetName(),
+		Namespace: s.workflowNamespace,
+	})
+	if err != nil {
+		releaseQuota()
+		log.Log(logging.ERROR, "failed to retry argo workflow", "workflow-name", workflow.GetName(), "error", err)
+		return nil, err
+	}
+
+	// Reset the notification phases, so that the owner is notified about the
+	// progress of the retried cluster as for a new one.
+	payloadBytes, err := formatNotificationResetPatch(retried, s.notifiers)
+	if err != nil {
+		return nil, err
+	}
+	if payloadBytes != nil {
+		_, err = s.k8sWorkflowsClient.Patch(ctx, retried.GetName(), types.JSONPatchType, payloadBytes, metav1.PatchOptions{})
+		if err != nil {
+			log.Log(logging.ERROR, "failed to reset notification annotations",
+				"cluster-id", req.GetId(),
+				"workflow-name", retried.GetName(),
+				"error", err,
+			)
+		}
+	}
+
+	err = s.bqClient.InsertClusterRetryRecord(context.Background(), req.GetId(), retried.GetName(), owner)
+	if err != nil {
+		log.Log(logging.WARN, "failed to record cluster retry", "cluster-id", req.GetId(), "error", err)
+	}
+
+	return &v1.ResourceByID{Id: req.GetId()}, nil
+}
+
+// formatNotificationResetPatch returns a JSON patch clearing the notification
+// phase of every given notification sink, or nil if there is none to clear.
+// Sinks that are skipped for the cluster remain skipped.
+func formatNotificationResetPatch(workflow *v1alpha1.Workflow, notifiers []notifier.Notifier) ([]byte, error) {
+	var payload []map[string]any
+	for _, sink := range notifiers {
+		current := GetNotificationStatus(workflow, sink.Name())
+		if current == "" || current == notifier.StatusSkip {
+			continue
+		}
+
+		// JSON Patch path uses ~1 to escape / in annotation names.
+		key := strings.ReplaceAll(strings.ReplaceAll(notificationAnnotationKey(sink.Name()), "~", "~0"), "/", "~1")
+		payload = append(payload, map[string]any{
+			"op":    "add",
+			"path":  "/metadata/annotations/" + key,
+			"value": "",
+		})
+	}
+
+	if len(payload) == 0 {
+		return nil, nil
+	}
+
+	return json.Marshal(payload)
+}
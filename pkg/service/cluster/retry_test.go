@@ -0,0 +1,164 @@
+package cluster
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/argoproj/argo-workflows/v4/pkg/apis/workflow/v1alpha1"
+	v1 "github.com/stackrox/infra/generated/api/v1"
+	"github.com/stackrox/infra/pkg/config"
+	"github.com/stackrox/infra/pkg/notifier"
+	"github.com/stackrox/infra/pkg/service/middleware"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type namedNotifier string
+
+func (n namedNotifier) Name() string {
+	return string(n)
+}
+
+func (n namedNotifier) Notify(_ notifier.Event) error {
+	return nil
+}
+
+func TestFormatNotificationResetPatch(t *testing.T) {
+	notifiers := []notifier.Notifier{namedNotifier("slack"), namedNotifier("webhook")}
+
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		expected    string
+	}{
+		{
+			name: "resets every notified sink",
+			annotations: map[string]string{
+				annotationSlackKey:                       "failed",
+				annotationNotificationPrefix + "webhook": "failed",
+			},
+			expected: `[{"op":"add","path":"/metadata/annotations/infra.stackrox.com~1slack","value":""},` +
+				`{"op":"add","path":"/metadata/annotations/infra.stackrox.com~1notification-webhook","value":""}]`,
+		},
+		{
+			name: "keeps skipped sinks",
+			annotations: map[string]string{
+				annotationSlackKey:                       "skip",
+				annotationNotificationPrefix + "webhook": "failed",
+			},
+			expected: `[{"op":"add","path":"/metadata/annotations/infra.stackrox.com~1notification-webhook","value":""}]`,
+		},
+		{
+			name: "nothing to reset",
+			annotations: map[string]string{
+				annotationSlackKey: "skip",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			workflow := &v1alpha1.Workflow{ObjectMeta: metav1.ObjectMeta{Annotations: test.annotations}}
+			payload, err := formatNotificationResetPatch(workflow, notifiers)
+			require.NoError(t, err)
+			if test.expected == "" {
+				assert.Nil(t, payload)
+				return
+			}
+			assert.JSONEq(t, test.expected, string(payload))
+		})
+	}
+}
+
+// serviceAccountContext returns a context authenticated with a token of the
+// given service account.
+func serviceAccountContext(t *testing.T, svcacct *v1.ServiceAccount) context.Context {
+	enrich := middleware.ServiceAccountEnricher(
+		func(string) (*v1.ServiceAccount, error) { return svcacct, nil },
+		func(context.Context, string) (bool, error) { return false, nil },
+	)
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer token"))
+	ctx, err := enrich(ctx, nil)
+	require.NoError(t, err)
+	return ctx
+}
+
+// clusterWorkflow returns a workflow of the given cluster, in the given phase.
+func clusterWorkflow(clusterID string, owner string, phase v1alpha1.WorkflowPhase, started time.Time) v1alpha1.Workflow {
+	workflow := cachedWorkflow(clusterID+"-abcde", started, map[string]string{
+		labelClusterID: clusterID,
+		labelOwner:     emailToLabelValue(owner),
+		labelFlavor:    "gke-default",
+	})
+	workflow.Annotations = map[string]string{
+		annotationOwnerKey:    owner,
+		annotationFlavorKey:   "gke-default",
+		annotationLifespanKey: "3h",
+	}
+	workflow.Status.Phase = phase
+	workflow.Status.StartedAt = metav1.NewTime(started)
+	return workflow
+}
+
+func TestRetryChecks(t *testing.T) {
+	const owner = "ci@example.com"
+	now := time.Now()
+	failed := clusterWorkflow("failed", owner, v1alpha1.WorkflowFailed, now.Add(-time.Hour))
+	running := clusterWorkflow("running", owner, v1alpha1.WorkflowRunning, now.Add(-time.Hour))
+	expired := clusterWorkflow("failed", owner, v1alpha1.WorkflowFailed, now.Add(-4*time.Hour))
+
+	tests := []struct {
+		name      string
+		quota     *config.QuotaConfig
+		scope     *v1.TokenScope
+		workflows []v1alpha1.Workflow
+		expected  codes.Code
+	}{
+		{
+			name:      "quota exceeded",
+			quota:     &config.QuotaConfig{PerOwner: 1},
+			workflows: []v1alpha1.Workflow{failed, running},
+			expected:  codes.ResourceExhausted,
+		},
+		{
+			name:      "flavor outside token scope",
+			scope:     &v1.TokenScope{Flavors: []string{"qa-demo"}},
+			workflows: []v1alpha1.Workflow{failed},
+			expected:  codes.PermissionDenied,
+		},
+		{
+			name:      "lifespan outside token scope",
+			scope:     &v1.TokenScope{MaxLifespan: durationpb.New(time.Hour)},
+			workflows: []v1alpha1.Workflow{failed},
+			expected:  codes.PermissionDenied,
+		},
+		{
+			name:      "token scope clusters exceeded",
+			scope:     &v1.TokenScope{MaxClusters: 1},
+			workflows: []v1alpha1.Workflow{failed, running},
+			expected:  codes.ResourceExhausted,
+		},
+		{
+			name:      "expired",
+			workflows: []v1alpha1.Workflow{expired},
+			expected:  codes.FailedPrecondition,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newTestClusterService(t, tt.quota, tt.workflows...)
+			ctx := serviceAccountContext(t, &v1.ServiceAccount{Email: owner, Scope: tt.scope})
+
+			_, err := s.Retry(ctx, &v1.ResourceByID{Id: "failed"})
+			assert.Equal(t, tt.expected, status.Code(err), err)
+		})
+	}
+}
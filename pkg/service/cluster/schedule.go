@@ -0,0 +1,511 @@
+package cluster
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/robfig/cron/v3"
+	v1 "github.com/stackrox/infra/generated/api/v1"
+	"github.com/stackrox/infra/pkg/config"
+	"github.com/stackrox/infra/pkg/kube"
+	"github.com/stackrox/infra/pkg/logging"
+	"github.com/stackrox/infra/pkg/service/middleware"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/rand"
+	k8sv1 "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/util/retry"
+)
+
+const (
+	// defaultScheduleNamespace is the namespace of the ConfigMap that
+	// persists schedules when the server does not run in a pod.
+	defaultScheduleNamespace = "infra"
+
+	// scheduleConfigMapName is the name of the ConfigMap that persists
+	// schedules. Each schedule is stored as JSON under its own ID.
+	scheduleConfigMapName = "schedules"
+
+	// defaultScheduleCheckInterval is how often to periodically check for
+	// schedules that are due.
+	defaultScheduleCheckInterval = 1 * time.Minute
+
+	// scheduleRunSuffixLength is the length of the random suffix that is
+	// appended to the cluster name of every run of a recurring schedule.
+	scheduleRunSuffixLength = 5
+)
+
+type scheduleImpl struct {
+	v1.UnimplementedScheduleServiceServer
+	cluster            *clusterImpl
+	k8sConfigMapClient k8sv1.ConfigMapInterface
+	namespace          string
+	checkInterval      time.Duration
+
+	// lock serializes updates to the schedules ConfigMap from this server.
+	lock sync.Mutex
+}
+
+var (
+	_ middleware.APIService    = (*scheduleImpl)(nil)
+	_ v1.ScheduleServiceServer = (*scheduleImpl)(nil)
+)
+
+// NewScheduleService creates a new ScheduleService, which creates clusters
+// using the given ClusterService. Defaults are used for a missing
+// configuration.
+func NewScheduleService(clusterService middleware.APIService, cfg *config.ScheduleConfig) (middleware.APIService, error) {
+	cluster, ok := clusterService.(*clusterImpl)
+	if !ok {
+		return nil, errors.New("schedule service requires a cluster service")
+	}
+
+	if cfg == nil {
+		cfg = &config.ScheduleConfig{}
+	}
+
+	namespace := cfg.Namespace
+	if namespace == "" {
+		namespace = kube.PodNamespace(defaultScheduleNamespace)
+	}
+
+	checkInterval := cfg.CheckInterval.Duration()
+	if checkInterval <= 0 {
+		checkInterval = defaultScheduleCheckInterval
+		if os.Getenv("TEST_MODE") == "true" {
+			checkInterval = 5 * time.Second
+		}
+	}
+
+	k8sConfigMapClient, err := kube.GetK8sConfigMapClient(namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	impl := newScheduleService(cluster, k8sConfigMapClient, namespace, checkInterval)
+	go impl.startScheduleCheck()
+
+	return impl, nil
+}
+
+func newScheduleService(cluster *clusterImpl, client k8sv1.ConfigMapInterface, namespace string, checkInterval time.Duration) *scheduleImpl {
+	return &scheduleImpl{
+		cluster:            cluster,
+		k8sConfigMapClient: client,
+		namespace:          namespace,
+		checkInterval:      checkInterval,
+	}
+}
+
+// Create implements ScheduleService.Create.
+func (s *scheduleImpl) Create(ctx context.Context, req *v1.Schedule) (*v1.Schedule, error) {
+	owner, err := middleware.GetOwnerFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	log.AuditLog(logging.INFO, "schedule-create", "received a create request for schedule",
+		"actor", owner,
+		"schedule-id", req.GetID(),
+		"flavor-id", req.GetRequest().GetID(),
+	)
+
+	schedule := proto.Clone(req).(*v1.Schedule)
+	schedule.Owner = owner
+	schedule.CreatedOn = timestamppb.Now()
+	schedule.LastRun = nil
+	schedule.LastClusterID = ""
+	schedule.LastError = ""
+
+	if schedule.GetID() == "" {
+		schedule.ID = fmt.Sprintf("%s-%s", schedule.GetRequest().GetID(), rand.String(5))
+	}
+	if err := s.validate(schedule); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// A scoped token cannot escape its scope through a schedule. The scope is
+	// kept with the schedule, and checked again whenever it fires.
+	if err := checkTokenScope(ctx, schedule.GetRequest().GetID(), schedule.GetRequest().GetLifespan()); err != nil {
+		return nil, err
+	}
+	schedule.Scope = nil
+	if scope := middleware.ScopeFromContext(ctx); scope != nil {
+		schedule.Scope = proto.Clone(scope).(*v1.TokenScope)
+	}
+
+	// The clusters created by the schedule belong to the current teams of
+	// the owner.
+	if err := s.cluster.checkFlavorAvailable(ctx, schedule.GetRequest().GetID()); err != nil {
+		return nil, err
+	}
+	schedule.Teams = middleware.TeamsFromContext(ctx)
+
+	next, err := nextScheduleRun(schedule, time.Now())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	schedule.NextRun = next
+
+	err = s.update(ctx, func(schedules map[string]*v1.Schedule) error {
+		if _, found := schedules[schedule.GetID()]; found {
+			return status.Errorf(codes.AlreadyExists, "schedule %q already exists", schedule.GetID())
+		}
+		schedules[schedule.GetID()] = schedule
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return schedule, nil
+}
+
+// List implements ScheduleService.List. Schedules of other owners are
+// visible by the same rules as their clusters.
+func (s *scheduleImpl) List(ctx context.Context, req *v1.ScheduleListRequest) (*v1.ScheduleListResponse, error) {
+	owner, err := middleware.GetOwnerFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	schedules, _, err := s.load(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &v1.ScheduleListResponse{}
+	for _, schedule := range schedules {
+		if !req.GetAll() && schedule.GetOwner() != owner {
+			continue
+		}
+		if !isVisibleTo(ctx, schedule.GetOwner(), nil, schedule.GetTeams()) {
+			continue
+		}
+		resp.Schedules = append(resp.Schedules, schedule)
+	}
+
+	sort.Slice(resp.Schedules, func(i, j int) bool {
+		return resp.Schedules[i].GetID() < resp.Schedules[j].GetID()
+	})
+
+	return resp, nil
+}
+
+// Delete implements ScheduleService.Delete.
+func (s *scheduleImpl) Delete(ctx context.Context, req *v1.ResourceByID) (*empty.Empty, error) {
+	owner, err := middleware.GetOwnerFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	log.AuditLog(logging.INFO, "schedule-delete", "received a delete request for schedule",
+		"actor", owner,
+		"schedule-id", req.GetId(),
+	)
+
+	err = s.update(ctx, func(schedules map[string]*v1.Schedule) error {
+		schedule, found := schedules[req.GetId()]
+		if !found {
+			return status.Errorf(codes.NotFound, "schedule %q not found", req.GetId())
+		}
+		if schedule.GetOwner() != owner && !middleware.HasAccess(ctx, middleware.Admin) {
+			return status.Errorf(codes.PermissionDenied, "schedule %q is owned by %s", req.GetId(), schedule.GetOwner())
+		}
+		delete(schedules, req.GetId())
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &empty.Empty{}, nil
+}
+
+// validate checks that the given schedule can be stored, and that it will be
+// able to create a cluster when it fires.
+func (s *scheduleImpl) validate(schedule *v1.Schedule) error {
+	if err := validateClusterID(schedule.GetID()); err != nil {
+		return fmt.Errorf("invalid schedule ID: %v", err)
+	}
+
+	if (schedule.GetAt() == nil) == (schedule.GetCron() == "") {
+		return errors.New("exactly one of a time or a cron expression must be given")
+	}
+
+	if schedule.GetAt() != nil && !schedule.GetAt().AsTime().After(time.Now()) {
+		return fmt.Errorf("scheduled time %s is in the past", schedule.GetAt().AsTime().Format(time.RFC3339))
+	}
+
+	req := schedule.GetRequest()
+	if req == nil {
+		return errors.New("no cluster request given")
+	}
+
+	flav, _, found := s.cluster.registry.Get(req.GetID())
+	if !found {
+		return fmt.Errorf("flavor %q not found", req.GetID())
+	}
+	// The flavor is stored by its ID, which scopes and quotas are checked
+	// against, rather than by an alias.
+	req.ID = flav.GetID()
+
+	if req.Parameters == nil {
+		req.Parameters = make(map[string]string)
+	}
+	if _, found := req.Parameters["name"]; !found {
+		req.Parameters["name"] = schedule.GetID()
+	}
+	if err := validateClusterID(req.Parameters["name"]); err != nil {
+		return fmt.Errorf("invalid cluster ID: %v", err)
+	}
+	if schedule.GetCron() != "" {
+		// Every run of a recurring schedule suffixes the cluster name.
+		if err := validateClusterID(scheduleRunClusterID(req.Parameters["name"])); err != nil {
+			return fmt.Errorf("invalid cluster ID for recurring runs: %v", err)
+		}
+	}
+
+	_, err := checkAndEnrichParameters(flav.Parameters, req.Parameters)
+	return err
+}
+
+// nextScheduleRun determines the next time after the given time at which the
+// schedule fires. Nil is returned for a one-off schedule that has already
+// fired.
+func nextScheduleRun(schedule *v1.Schedule, after time.Time) (*timestamppb.Timestamp, error) {
+	if schedule.GetCron() == "" {
+		if schedule.GetLastRun() != nil {
+			return nil, nil
+		}
+		return schedule.GetAt(), nil
+	}
+
+	spec, err := cron.ParseStandard(schedule.GetCron())
+	if err != nil {
+		return nil, fmt.Errorf("invalid cron expression %q: %v", schedule.GetCron(), err)
+	}
+
+	return timestamppb.New(spec.Next(after)), nil
+}
+
+// scheduleRunClusterID returns a unique cluster ID for a run of a recurring
+// schedule, so that a run does not collide with the cluster of a previous run
+// that is still alive.
+func scheduleRunClusterID(name string) string {
+	return name + "-" + rand.String(scheduleRunSuffixLength)
+}
+
+// scheduleRunRequest returns the request that creates the cluster of a run
+// of the given schedule.
+func scheduleRunRequest(schedule *v1.Schedule) *v1.CreateClusterRequest {
+	req := proto.Clone(schedule.GetRequest()).(*v1.CreateClusterRequest)
+	if schedule.GetCron() != "" {
+		req.Parameters["name"] = scheduleRunClusterID(req.GetParameters()["name"])
+	}
+	return req
+}
+
+func (s *scheduleImpl) startScheduleCheck() {
+	for ; ; time.Sleep(s.checkInterval) {
+		// Only the leader fires schedules.
+		if !s.cluster.leader.IsLeader() {
+			continue
+		}
+
+		start := time.Now()
+
+		schedules, _, err := s.load(context.Background())
+		if err != nil {
+			log.Log(logging.ERROR, "failed to load schedules", "error", err)
+			continue
+		}
+
+		for _, schedule := range schedules {
+			if schedule.GetNextRun() == nil || schedule.GetNextRun().AsTime().After(start) {
+				continue
+			}
+			s.fire(schedule.GetID())
+		}
+
+		// Log the duration of the loop if above the warning threshold to be aware of performance issues.
+		if time.Since(start) > loopDurationWarning {
+			log.Log(logging.WARN, fmt.Sprintf("schedule loop took %s", time.Since(start).String()))
+		}
+	}
+}
+
+// fire creates a cluster for the named schedule, and records the outcome.
+// The run is claimed before the cluster is created, so that a retried update
+// never creates a cluster twice. One-off schedules are removed once they have
+// fired.
+func (s *scheduleImpl) fire(scheduleID string) {
+	var claimed *v1.Schedule
+	err := s.update(context.Background(), func(schedules map[string]*v1.Schedule) error {
+		claimed = nil
+
+		schedule, found := schedules[scheduleID]
+		if !found {
+			// The schedule was deleted in the meantime.
+			return nil
+		}
+
+		now := time.Now()
+		if schedule.GetNextRun() == nil || schedule.GetNextRun().AsTime().After(now) {
+			// The schedule already fired, or was never due.
+			return nil
+		}
+
+		schedule.LastRun = timestamppb.New(now)
+		next, err := nextScheduleRun(schedule, now)
+		if err != nil {
+			return err
+		}
+		schedule.NextRun = next
+
+		claimed = proto.Clone(schedule).(*v1.Schedule)
+		return nil
+	})
+	if err != nil {
+		log.Log(logging.ERROR, "failed to claim schedule run", "schedule-id", scheduleID, "error", err)
+		return
+	}
+	if claimed == nil {
+		return
+	}
+
+	log.Log(logging.INFO, "creating a scheduled infra cluster",
+		"schedule-id", scheduleID,
+		"flavor-id", claimed.GetRequest().GetID(),
+		"cluster-owner", claimed.GetOwner(),
+	)
+
+	var lastClusterID, lastError string
+	req := scheduleRunRequest(claimed)
+	clusterID, err := s.cluster.create(req, claimed.GetOwner(), claimed.GetTeams(), claimed.GetScope(), scheduleID)
+	if err != nil {
+		log.Log(logging.WARN, "failed to create a scheduled infra cluster", "schedule-id", scheduleID, "error", err)
+		lastError = err.Error()
+	} else {
+		lastClusterID = clusterID.GetId()
+	}
+
+	err = s.update(context.Background(), func(schedules map[string]*v1.Schedule) error {
+		schedule, found := schedules[scheduleID]
+		if !found {
+			return nil
+		}
+
+		if schedule.GetNextRun() == nil {
+			delete(schedules, scheduleID)
+			return nil
+		}
+
+		schedule.LastClusterID = lastClusterID
+		schedule.LastError = lastError
+		return nil
+	})
+	if err != nil {
+		log.Log(logging.ERROR, "failed to record schedule run", "schedule-id", scheduleID, "error", err)
+	}
+}
+
+// load reads all schedules from the schedules ConfigMap, which is created if
+// it does not exist yet.
+func (s *scheduleImpl) load(ctx context.Context) (map[string]*v1.Schedule, *corev1.ConfigMap, error) {
+	configMap, err := s.k8sConfigMapClient.Get(ctx, scheduleConfigMapName, metav1.GetOptions{})
+	if k8serrors.IsNotFound(err) {
+		configMap, err = s.k8sConfigMapClient.Create(ctx, &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      scheduleConfigMapName,
+				Namespace: s.namespace,
+			},
+		}, metav1.CreateOptions{})
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	schedules := make(map[string]*v1.Schedule, len(configMap.Data))
+	for id, data := range configMap.Data {
+		schedule := &v1.Schedule{}
+		if err := protojson.Unmarshal([]byte(data), schedule); err != nil {
+			log.Log(logging.ERROR, "failed to parse schedule", "schedule-id", id, "error", err)
+			continue
+		}
+		schedules[id] = schedule
+	}
+
+	return schedules, configMap, nil
+}
+
+// update applies the given mutation to the stored schedules, retrying if the
+// schedules ConfigMap was concurrently modified. Entries that fail to parse
+// are kept as they are, so that they can still be repaired by hand.
+func (s *scheduleImpl) update(ctx context.Context, mutate func(map[string]*v1.Schedule) error) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		schedules, configMap, err := s.load(ctx)
+		if err != nil {
+			return err
+		}
+
+		data := make(map[string]string, len(configMap.Data))
+		for id, raw := range configMap.Data {
+			if _, parsed := schedules[id]; !parsed {
+				data[id] = raw
+			}
+		}
+
+		if err := mutate(schedules); err != nil {
+			return err
+		}
+
+		configMap.Data = data
+		for id, schedule := range schedules {
+			data, err := protojson.Marshal(schedule)
+			if err != nil {
+				return err
+			}
+			configMap.Data[id] = string(data)
+		}
+
+		_, err = s.k8sConfigMapClient.Update(ctx, configMap, metav1.UpdateOptions{})
+		return err
+	})
+}
+
+// Access configures access for this service.
+func (s *scheduleImpl) Access() map[string]middleware.Access {
+	return map[string]middleware.Access{
+		"/v1.ScheduleService/Create": middleware.Authenticated,
+		"/v1.ScheduleService/List":   middleware.Viewer,
+		"/v1.ScheduleService/Delete": middleware.Authenticated,
+	}
+}
+
+// RegisterServiceServer registers this service with the given gRPC Server.
+func (s *scheduleImpl) RegisterServiceServer(server *grpc.Server) {
+	v1.RegisterScheduleServiceServer(server, s)
+}
+
+// RegisterServiceHandler registers this service with the given gRPC Gateway endpoint.
+func (s *scheduleImpl) RegisterServiceHandler(ctx context.Context, mux *runtime.ServeMux, conn *grpc.ClientConn) error {
+	return v1.RegisterScheduleServiceHandler(ctx, mux, conn)
+}
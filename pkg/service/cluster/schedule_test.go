@@ -0,0 +1,172 @@
+package cluster
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	v1 "github.com/stackrox/infra/generated/api/v1"
+	"github.com/stackrox/infra/pkg/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestNextScheduleRun(t *testing.T) {
+	// A Friday.
+	now := time.Date(2024, time.May, 31, 9, 0, 0, 0, time.UTC)
+	at := timestamppb.New(now.Add(time.Hour))
+
+	tests := []struct {
+		name      string
+		schedule  *v1.Schedule
+		expected  *timestamppb.Timestamp
+		expectErr bool
+	}{
+		{
+			name:     "one-off not yet fired",
+			schedule: &v1.Schedule{At: at},
+			expected: at,
+		},
+		{
+			name:     "one-off already fired",
+			schedule: &v1.Schedule{At: at, LastRun: at},
+			expected: nil,
+		},
+		{
+			name:     "weekdays skips the weekend",
+			schedule: &v1.Schedule{Cron: "0 8 * * 1-5"},
+			expected: timestamppb.New(time.Date(2024, time.June, 3, 8, 0, 0, 0, time.UTC)),
+		},
+		{
+			name:     "cron with time zone",
+			schedule: &v1.Schedule{Cron: "CRON_TZ=Europe/Berlin 0 12 * * *"},
+			expected: timestamppb.New(time.Date(2024, time.May, 31, 10, 0, 0, 0, time.UTC)),
+		},
+		{
+			name:      "invalid cron",
+			schedule:  &v1.Schedule{Cron: "every day"},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			next, err := nextScheduleRun(tt.schedule, now)
+			if tt.expectErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected.AsTime(), next.AsTime())
+			assert.Equal(t, tt.expected == nil, next == nil)
+		})
+	}
+}
+
+func TestScheduleRunRequest(t *testing.T) {
+	request := &v1.CreateClusterRequest{ID: "gke-default", Parameters: map[string]string{"name": "nightly"}}
+
+	t.Run("one-off keeps the name", func(t *testing.T) {
+		req := scheduleRunRequest(&v1.Schedule{Request: request})
+		assert.Equal(t, "nightly", req.GetParameters()["name"])
+	})
+
+	t.Run("recurring runs get a unique name", func(t *testing.T) {
+		schedule := &v1.Schedule{Request: request, Cron: "0 8 * * *"}
+		first := scheduleRunRequest(schedule).GetParameters()["name"]
+		second := scheduleRunRequest(schedule).GetParameters()["name"]
+
+		assert.Regexp(t, `^nightly-[a-z0-9]{5}$`, first)
+		assert.NotEqual(t, first, second)
+		assert.NoError(t, validateClusterID(first))
+		// The stored request is not modified.
+		assert.Equal(t, "nightly", request.GetParameters()["name"])
+	})
+}
+
+func TestUpdateKeepsUnparseableSchedules(t *testing.T) {
+	ctx := context.Background()
+	client := fake.NewClientset().CoreV1().ConfigMaps("infra")
+	s := newScheduleService(newTestClusterService(t, nil), client, "infra", time.Minute)
+
+	_, err := client.Create(ctx, &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: scheduleConfigMapName},
+		Data: map[string]string{
+			"broken":  "{not json",
+			"nightly": `{"ID":"nightly","Owner":"ci@example.com","Cron":"0 8 * * *"}`,
+		},
+	}, metav1.CreateOptions{})
+	require.NoError(t, err)
+
+	require.NoError(t, s.update(ctx, func(schedules map[string]*v1.Schedule) error {
+		assert.NotContains(t, schedules, "broken")
+		delete(schedules, "nightly")
+		return nil
+	}))
+
+	configMap, err := client.Get(ctx, scheduleConfigMapName, metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"broken": "{not json"}, configMap.Data)
+}
+
+func TestListScheduleVisibility(t *testing.T) {
+	rbac := &config.RBACConfig{
+		Teams: []config.TeamBinding{
+			{Name: "sre", Emails: []string{"owner@example.com", "teammate@example.com"}},
+			{Name: "qa", Emails: []string{"other@example.com"}},
+		},
+	}
+	s := newScheduleService(newTestClusterService(t, nil), fake.NewClientset().CoreV1().ConfigMaps("infra"), "infra", time.Minute)
+
+	require.NoError(t, s.update(context.Background(), func(schedules map[string]*v1.Schedule) error {
+		schedules["team"] = &v1.Schedule{ID: "team", Owner: "owner@example.com", Teams: []string{"sre"}}
+		schedules["public"] = &v1.Schedule{ID: "public", Owner: "owner@example.com"}
+		return nil
+	}))
+
+	listed := func(email string) []string {
+		resp, err := s.List(teamContext(t, email, rbac), &v1.ScheduleListRequest{All: true})
+		require.NoError(t, err)
+		var ids []string
+		for _, schedule := range resp.GetSchedules() {
+			ids = append(ids, schedule.GetID())
+		}
+		return ids
+	}
+
+	assert.Equal(t, []string{"public", "team"}, listed("owner@example.com"))
+	assert.Equal(t, []string{"public", "team"}, listed("teammate@example.com"))
+	assert.Equal(t, []string{"public"}, listed("other@example.com"))
+}
+
+func TestFireChecksScope(t *testing.T) {
+	ctx := context.Background()
+	cluster := newTestClusterService(t, nil)
+	cluster.registry = newTestRegistry(t)
+	s := newScheduleService(cluster, fake.NewClientset().CoreV1().ConfigMaps("infra"), "infra", time.Minute)
+
+	// A schedule that is outside the scope it was created with does not run.
+	require.NoError(t, s.update(ctx, func(schedules map[string]*v1.Schedule) error {
+		schedules["nightly"] = &v1.Schedule{
+			ID:      "nightly",
+			Owner:   "ci@example.com",
+			Cron:    "0 8 * * *",
+			NextRun: timestamppb.New(time.Now().Add(-time.Minute)),
+			Request: &v1.CreateClusterRequest{ID: "gke-default", Parameters: map[string]string{"name": "nightly"}},
+			Scope:   &v1.TokenScope{Flavors: []string{"qa-demo"}},
+		}
+		return nil
+	}))
+
+	s.fire("nightly")
+
+	schedules, _, err := s.load(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, schedules["nightly"].GetLastClusterID())
+	assert.Contains(t, schedules["nightly"].GetLastError(), "token scope does not allow flavor")
+	assert.True(t, schedules["nightly"].GetNextRun().AsTime().After(time.Now()))
+}
@@ -0,0 +1,33 @@
+package cluster
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/duration"
+	v1 "github.com/stackrox/infra/generated/api/v1"
+	"github.com/stackrox/infra/pkg/service/middleware"
+)
+
+// checkTokenScope returns an error if the scope of the token in the given
+// context does not allow creating a cluster of the given flavor and lifespan.
+func checkTokenScope(ctx context.Context, flavorID string, lifespan *duration.Duration) error {
+	return checkScope(middleware.ScopeFromContext(ctx), flavorID, lifespan)
+}
+
+// checkScope returns an error if the given token scope does not allow
+// creating a cluster of the given flavor and lifespan. The flavor ID must not
+// be an alias. The number of clusters that the scope allows is enforced by
+// reserveQuota.
+func checkScope(scope *v1.TokenScope, flavorID string, lifespan *duration.Duration) error {
+	if err := middleware.CheckFlavorInScope(scope, flavorID); err != nil {
+		return err
+	}
+
+	// Clusters without a lifespan are given the default one by create.
+	requested := lifespan.AsDuration()
+	if requested <= 0 {
+		requested = 3 * time.Hour
+	}
+	return middleware.CheckLifespanInScope(scope, requested)
+}
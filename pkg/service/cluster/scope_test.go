@@ -0,0 +1,49 @@
+package cluster
+
+import (
+	"testing"
+	"time"
+
+	v1 "github.com/stackrox/infra/generated/api/v1"
+	"github.com/stackrox/infra/pkg/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestCreateChecksScope(t *testing.T) {
+	running := cachedWorkflow("running-abcde", time.Now(), map[string]string{labelClusterID: "running", labelOwner: emailToLabelValue("ci@example.com"), labelFlavor: "gke-default"})
+	s := newTestClusterService(t, &config.QuotaConfig{Exempt: []string{"ci@example.com"}}, running)
+	s.registry = newTestRegistry(t)
+
+	create := func(flavorID string, scope *v1.TokenScope) error {
+		req := &v1.CreateClusterRequest{ID: flavorID, Parameters: map[string]string{"name": "scoped"}}
+		_, err := s.create(req, "ci@example.com", nil, scope, "")
+		return err
+	}
+
+	// Scopes are checked against the flavor that an alias stands for.
+	assert.Equal(t, codes.PermissionDenied, status.Code(create("gke-default", &v1.TokenScope{Flavors: []string{"gke"}})))
+	assert.Equal(t, codes.PermissionDenied, status.Code(create("gke", &v1.TokenScope{Flavors: []string{"gke"}})))
+
+	// The clusters that a scope allows are limited even for exempt owners.
+	assert.Equal(t, codes.ResourceExhausted, status.Code(create("gke", &v1.TokenScope{Flavors: []string{"gke-default"}, MaxClusters: 1})))
+}
+
+func TestReserveQuotaWithScope(t *testing.T) {
+	s := newTestClusterService(t, nil)
+	scope := &v1.TokenScope{MaxClusters: 1}
+
+	// A pending cluster counts against the scope, so that concurrent creations
+	// cannot exceed it.
+	release, err := s.reserveQuota("ci@example.com", nil, scope, "gke-default", "first")
+	require.NoError(t, err)
+	_, err = s.reserveQuota("ci@example.com", nil, scope, "gke-default", "second")
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	assert.Contains(t, status.Convert(err).Message(), "1 concurrent clusters per token scope")
+
+	release()
+	_, err = s.reserveQuota("ci@example.com", nil, scope, "gke-default", "second")
+	assert.NoError(t, err)
+}
@@ -0,0 +1,143 @@
+package cluster
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/argoproj/argo-workflows/v4/pkg/apis/workflow/v1alpha1"
+	v1 "github.com/stackrox/infra/generated/api/v1"
+	"github.com/stackrox/infra/pkg/logging"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/watch"
+)
+
+// workflowHandler is called with the current state of a watched workflow, and
+// returns true once it is no longer interested in further updates.
+type workflowHandler func(workflow v1alpha1.Workflow) (bool, error)
+
+// Watch implements ClusterService.Watch.
+func (s *clusterImpl) Watch(clusterID *v1.ResourceByID, stream v1.ClusterService_WatchServer) error {
+	var last *v1.Cluster
+
+	return s.watchClusterWorkflow(stream.Context(), clusterID.GetId(), func(workflow v1alpha1.Workflow) (bool, error) {
+		metacluster, err := s.metaClusterFromWorkflow(workflow)
+		if err != nil {
+			log.Log(logging.ERROR, "failed to convert argo workflow to infra meta-cluster", "workflow-name", workflow.GetName(), "error", err)
+			return false, err
+		}
+
+		if clusterChanged(last, metacluster.Cluster) {
+			if err := stream.Send(metacluster.Cluster); err != nil {
+				return false, err
+			}
+			last = metacluster.Cluster
+		}
+
+		// A completed workflow will not change any further.
+		return workflow.Status.Phase.Completed(), nil
+	})
+}
+
+// watchClusterWorkflow calls handle with the most recent workflow for the
+// given cluster ID, and then again every time that workflow changes. Watching
+// stops when handle returns true or an error, or when the context is done.
+func (s *clusterImpl) watchClusterWorkflow(ctx context.Context, clusterID string, handle workflowHandler) error {
+	workflow, err := s.getMostRecentArgoWorkflowFromClusterID(clusterID)
+	if err != nil {
+		return err
+	}
+	if err := checkVisible(ctx, workflow); err != nil {
+		return err
+	}
+
+	if done, err := handle(*workflow); done || err != nil {
+		return err
+	}
+
+	listOpts := metav1.ListOptions{
+		LabelSelector: fmt.Sprintf("%s=%s", labelClusterID, clusterID),
+	}
+	workflowName := workflow.GetName()
+	resourceVersion := workflow.GetResourceVersion()
+
+	// The API server closes watches periodically, so keep re-establishing the
+	// watch from the last seen resource version until the client goes away.
+	for ctx.Err() == nil {
+		listOpts.ResourceVersion = resourceVersion
+		watcher, err := s.k8sWorkflowsClient.Watch(ctx, listOpts)
+		if err != nil {
+			log.Log(logging.ERROR, "failed to watch argo workflows", "cluster-id", clusterID, "error", err)
+			return err
+		}
+
+		for event := range watcher.ResultChan() {
+			switch event.Type {
+			case watch.Added, watch.Modified:
+				updated, ok := event.Object.(*v1alpha1.Workflow)
+				if !ok {
+					continue
+				}
+				resourceVersion = updated.GetResourceVersion()
+
+				// Only follow the workflow that we started with, or a newer
+				// one that reused the same cluster ID.
+				if updated.GetName() != workflowName {
+					if updated.CreationTimestamp.Before(&workflow.CreationTimestamp) {
+						continue
+					}
+					workflow, workflowName = updated, updated.GetName()
+				}
+
+				if done, err := handle(*updated); done || err != nil {
+					watcher.Stop()
+					return err
+				}
+
+			case watch.Deleted:
+				deleted, ok := event.Object.(*v1alpha1.Workflow)
+				if ok && deleted.GetName() == workflowName {
+					watcher.Stop()
+					return status.Errorf(codes.NotFound, "argo workflow %q for cluster %q was deleted", workflowName, clusterID)
+				}
+
+			case watch.Error:
+				// Most likely the resource version has expired. Resynchronise
+				// from the current state of the cluster and watch from there.
+				log.Log(logging.WARN, "argo workflow watch failed, restarting", "cluster-id", clusterID, "error", event.Object)
+				resourceVersion = ""
+			}
+		}
+		watcher.Stop()
+
+		if resourceVersion == "" && ctx.Err() == nil {
+			current, err := s.getMostRecentArgoWorkflowFromClusterID(clusterID)
+			if err != nil {
+				return err
+			}
+			workflow, workflowName, resourceVersion = current, current.GetName(), current.GetResourceVersion()
+
+			if done, err := handle(*workflow); done || err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// clusterChanged determines if a cluster differs from the last one sent to a
+// watcher in any of the fields that watchers care about.
+func clusterChanged(last *v1.Cluster, current *v1.Cluster) bool {
+	if last == nil {
+		return true
+	}
+
+	return last.GetID() != current.GetID() ||
+		last.GetStatus() != current.GetStatus() ||
+		!proto.Equal(last.GetLifespan(), current.GetLifespan()) ||
+		last.GetURL() != current.GetURL() ||
+		last.GetConnect() != current.GetConnect()
+}
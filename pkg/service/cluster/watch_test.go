@@ -0,0 +1,79 @@
+package cluster
+
+import (
+	"testing"
+	"time"
+
+	v1 "github.com/stackrox/infra/generated/api/v1"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func TestClusterChanged(t *testing.T) {
+	base := func() *v1.Cluster {
+		return &v1.Cluster{
+			ID:          "example-1",
+			Status:      v1.Status_CREATING,
+			Lifespan:    durationpb.New(3 * time.Hour),
+			Description: "example",
+		}
+	}
+
+	tests := []struct {
+		name     string
+		last     *v1.Cluster
+		mutate   func(*v1.Cluster)
+		expected bool
+	}{
+		{
+			name:     "nothing sent yet",
+			last:     nil,
+			mutate:   func(_ *v1.Cluster) {},
+			expected: true,
+		},
+		{
+			name:     "identical",
+			last:     base(),
+			mutate:   func(_ *v1.Cluster) {},
+			expected: false,
+		},
+		{
+			name:     "status changed",
+			last:     base(),
+			mutate:   func(c *v1.Cluster) { c.Status = v1.Status_READY },
+			expected: true,
+		},
+		{
+			name:     "lifespan changed",
+			last:     base(),
+			mutate:   func(c *v1.Cluster) { c.Lifespan = durationpb.New(4 * time.Hour) },
+			expected: true,
+		},
+		{
+			name:     "URL changed",
+			last:     base(),
+			mutate:   func(c *v1.Cluster) { c.URL = "https://example.com" },
+			expected: true,
+		},
+		{
+			name:     "connect changed",
+			last:     base(),
+			mutate:   func(c *v1.Cluster) { c.Connect = "gcloud container clusters get-credentials" },
+			expected: true,
+		},
+		{
+			name:     "irrelevant field changed",
+			last:     base(),
+			mutate:   func(c *v1.Cluster) { c.Description = "changed" },
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			current := base()
+			tt.mutate(current)
+			assert.Equal(t, tt.expected, clusterChanged(tt.last, current))
+		})
+	}
+}
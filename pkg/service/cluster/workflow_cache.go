@@ -0,0 +1,287 @@
+package cluster
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/argoproj/argo-workflows/v4/pkg/apis/workflow/v1alpha1"
+	workflowv1 "github.com/argoproj/argo-workflows/v4/pkg/client/clientset/versioned/typed/workflow/v1alpha1"
+	"github.com/stackrox/infra/pkg/logging"
+	"github.com/stackrox/infra/pkg/service/metrics"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/selection"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+)
+
+const (
+	indexClusterID = "cluster-id"
+	indexOwner     = "owner"
+	indexFlavor    = "flavor"
+)
+
+// labelIndexes maps the workflow labels that the cache is indexed by to the
+// name of their index.
+var labelIndexes = map[string]string{ //nolint:gochecknoglobals
+	labelClusterID: indexClusterID,
+	labelOwner:     indexOwner,
+	labelFlavor:    indexFlavor,
+}
+
+// workflowCache is a watch-backed cache of the argo workflows, indexed by the
+// cluster ID, owner, and flavor labels. It also drives the reconcilers that
+// act on workflow changes.
+type workflowCache struct {
+	informer cache.SharedIndexInformer
+
+	lock   sync.Mutex
+	queues []workqueue.TypedInterface[string]
+}
+
+// newWorkflowCache creates a workflow cache for the workflows accessed with
+// the given client. The cache is filled once run is called. Reconcilers are
+// resynced at most as often as the given period, which must not be longer
+// than any of their resync periods, as no resync happens when it is zero.
+func newWorkflowCache(client workflowv1.WorkflowInterface, resyncPeriod time.Duration) *workflowCache {
+	return newWorkflowCacheFromListerWatcher(&cache.ListWatch{
+		ListWithContextFunc: func(ctx context.Context, options metav1.ListOptions) (runtime.Object, error) {
+			return client.List(ctx, options)
+		},
+		WatchFuncWithContext: func(ctx context.Context, options metav1.ListOptions) (watch.Interface, error) {
+			return client.Watch(ctx, options)
+		},
+	}, resyncPeriod)
+}
+
+func newWorkflowCacheFromListerWatcher(lw cache.ListerWatcher, resyncPeriod time.Duration) *workflowCache {
+	indexers := make(cache.Indexers, len(labelIndexes))
+	for label, index := range labelIndexes {
+		indexers[index] = labelIndexFunc(label)
+	}
+
+	c := &workflowCache{
+		informer: cache.NewSharedIndexInformer(lw, &v1alpha1.Workflow{}, resyncPeriod, indexers),
+	}
+
+	_, err := c.informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj any) {
+			c.observe(obj)
+		},
+		UpdateFunc: func(oldObj, newObj any) {
+			oldWorkflow, oldOK := oldObj.(*v1alpha1.Workflow)
+			newWorkflow, newOK := newObj.(*v1alpha1.Workflow)
+			// Resyncs redeliver unchanged workflows, which are not events.
+			if oldOK && newOK && oldWorkflow.GetResourceVersion() == newWorkflow.GetResourceVersion() {
+				return
+			}
+			c.observe(newObj)
+		},
+		DeleteFunc: func(_ any) {
+			metrics.WorkflowCacheLastEventGauge.SetToCurrentTime()
+			metrics.WorkflowCacheSizeGauge.Set(float64(len(c.informer.GetStore().ListKeys())))
+		},
+	})
+	if err != nil {
+		log.Log(logging.ERROR, "failed to add workflow cache metrics handler", "error", err)
+	}
+
+	return c
+}
+
+// labelIndexFunc indexes workflows by the value of the given label.
+func labelIndexFunc(label string) cache.IndexFunc {
+	return func(obj any) ([]string, error) {
+		workflow, ok := obj.(*v1alpha1.Workflow)
+		if !ok {
+			return nil, nil
+		}
+		if value, found := workflow.GetLabels()[label]; found {
+			return []string{value}, nil
+		}
+		return nil, nil
+	}
+}
+
+// observe records the freshness metrics for a workflow change event.
+func (c *workflowCache) observe(obj any) {
+	metrics.WorkflowCacheLastEventGauge.SetToCurrentTime()
+	metrics.WorkflowCacheSizeGauge.Set(float64(len(c.informer.GetStore().ListKeys())))
+
+	if !c.informer.HasSynced() {
+		// The initial list is not a change event.
+		return
+	}
+
+	if workflow, ok := obj.(*v1alpha1.Workflow); ok {
+		if changed := lastChanged(workflow); !changed.IsZero() {
+			metrics.WorkflowCacheEventLagHistogram.Observe(time.Since(changed).Seconds())
+		}
+	}
+}
+
+// lastChanged returns the most recent time that the given workflow was
+// changed, as recorded by the API server.
+func lastChanged(workflow *v1alpha1.Workflow) time.Time {
+	changed := workflow.GetCreationTimestamp().Time
+	for _, entry := range workflow.GetManagedFields() {
+		if entry.Time != nil && entry.Time.After(changed) {
+			changed = entry.Time.Time
+		}
+	}
+	return changed
+}
+
+// run fills the cache and keeps it up to date until the context is done.
+func (c *workflowCache) run(ctx context.Context) {
+	go c.informer.RunWithContext(ctx)
+
+	if cache.WaitForCacheSync(ctx.Done(), c.informer.HasSynced) {
+		metrics.WorkflowCacheSyncedGauge.Set(1)
+		log.Log(logging.INFO, "workflow cache synced", "workflows", len(c.informer.GetStore().ListKeys()))
+	}
+}
+
+// synced reports whether the cache has been filled.
+func (c *workflowCache) synced() bool {
+	return c.informer.HasSynced()
+}
+
+// list returns copies of the cached workflows that match the given selector,
+// most recently created first. An index is used when the selector requires
+// an indexed label to have a specific value.
+func (c *workflowCache) list(selector labels.Selector) []v1alpha1.Workflow {
+	var workflows []v1alpha1.Workflow
+	for _, obj := range c.candidates(selector) {
+		workflow, ok := obj.(*v1alpha1.Workflow)
+		if !ok || !selector.Matches(labels.Set(workflow.GetLabels())) {
+			continue
+		}
+		workflows = append(workflows, *workflow.DeepCopy())
+	}
+
+	sort.SliceStable(workflows, func(i, j int) bool {
+		return workflows[j].CreationTimestamp.Before(&workflows[i].CreationTimestamp)
+	})
+
+	return workflows
+}
+
+// candidates returns the cached workflows that may match the given selector.
+func (c *workflowCache) candidates(selector labels.Selector) []any {
+	requirements, _ := selector.Requirements()
+	for _, requirement := range requirements {
+		index, found := labelIndexes[requirement.Key()]
+		if !found || (requirement.Operator() != selection.Equals && requirement.Operator() != selection.DoubleEquals) {
+			continue
+		}
+
+		value, _ := requirement.Values().PopAny()
+		objs, err := c.informer.GetIndexer().ByIndex(index, value)
+		if err == nil {
+			return objs
+		}
+	}
+
+	return c.informer.GetStore().List()
+}
+
+// enqueueWorkflow adds the key of the given workflow to the queue, unless it
+// has been deleted.
+func enqueueWorkflow(queue workqueue.TypedInterface[string], obj any) {
+	workflow, ok := obj.(*v1alpha1.Workflow)
+	if !ok || workflow.GetLabels()[labelDeleted] == "true" {
+		return
+	}
+	if key, err := cache.MetaNamespaceKeyFunc(workflow); err == nil {
+		queue.Add(key)
+	}
+}
+
+// resync queues every cached workflow for every reconciler.
+func (c *workflowCache) resync() {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	for _, obj := range c.informer.GetStore().List() {
+		for _, queue := range c.queues {
+			enqueueWorkflow(queue, obj)
+		}
+	}
+}
+
+// addReconciler calls reconcile with a copy of every workflow that is added
+// or changed, and with every cached workflow once per resync period.
+// Workflows are reconciled one at a time, and workflows that change while
+// queued are only reconciled once. Deleted workflows are not reconciled.
+//
+// reconcile reports whether it changed the workflow. The cached version of a
+// changed workflow is then stale, and is not reconciled again until the
+// change reaches the cache, so that the same change is not made twice.
+func (c *workflowCache) addReconciler(name string, resyncPeriod time.Duration, reconcile func(workflow v1alpha1.Workflow) bool) error {
+	queue := workqueue.NewTyped[string]()
+	c.lock.Lock()
+	c.queues = append(c.queues, queue)
+	c.lock.Unlock()
+
+	enqueue := func(obj any) {
+		enqueueWorkflow(queue, obj)
+	}
+
+	_, err := c.informer.AddEventHandlerWithResyncPeriod(cache.ResourceEventHandlerFuncs{
+		AddFunc: enqueue,
+		UpdateFunc: func(_, newObj any) {
+			enqueue(newObj)
+		},
+	}, resyncPeriod)
+	if err != nil {
+		return err
+	}
+
+	go func() {
+		// stale maps the keys of changed workflows to the resource version
+		// that they were changed from.
+		stale := make(map[string]string)
+
+		for {
+			key, shutdown := queue.Get()
+			if shutdown {
+				return
+			}
+
+			obj, exists, err := c.informer.GetStore().GetByKey(key)
+			workflow, ok := obj.(*v1alpha1.Workflow)
+			if err != nil || !exists || !ok {
+				delete(stale, key)
+			} else if version, found := stale[key]; found && version == workflow.GetResourceVersion() {
+				log.Log(logging.DEBUG, "skipping reconcile of a stale workflow",
+					"reconciler", name,
+					"workflow-name", workflow.GetName(),
+				)
+			} else {
+				delete(stale, key)
+
+				start := time.Now()
+				if reconcile(*workflow.DeepCopy()) {
+					stale[key] = workflow.GetResourceVersion()
+				}
+
+				// Log slow reconciles to be aware of performance issues.
+				if time.Since(start) > loopDurationWarning {
+					log.Log(logging.WARN, "workflow reconcile was slow",
+						"reconciler", name,
+						"workflow-name", workflow.GetName(),
+						"duration", time.Since(start).String(),
+					)
+				}
+			}
+			queue.Done(key)
+		}
+	}()
+
+	return nil
+}
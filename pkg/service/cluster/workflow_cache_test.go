@@ -0,0 +1,201 @@
+package cluster
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/argoproj/argo-workflows/v4/pkg/apis/workflow/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/tools/cache"
+)
+
+func cachedWorkflow(name string, created time.Time, workflowLabels map[string]string) v1alpha1.Workflow {
+	return v1alpha1.Workflow{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              name,
+			Namespace:         "default",
+			ResourceVersion:   "1",
+			CreationTimestamp: metav1.NewTime(created),
+			Labels:            workflowLabels,
+		},
+	}
+}
+
+func newTestWorkflowCache(workflows ...v1alpha1.Workflow) (*workflowCache, *watch.FakeWatcher) {
+	return newTestWorkflowCacheWithResync(0, workflows...)
+}
+
+func newTestWorkflowCacheWithResync(resyncPeriod time.Duration, workflows ...v1alpha1.Workflow) (*workflowCache, *watch.FakeWatcher) {
+	watcher := watch.NewFake()
+	c := newWorkflowCacheFromListerWatcher(&cache.ListWatch{
+		ListWithContextFunc: func(_ context.Context, _ metav1.ListOptions) (runtime.Object, error) {
+			return &v1alpha1.WorkflowList{
+				ListMeta: metav1.ListMeta{ResourceVersion: "1"},
+				Items:    workflows,
+			}, nil
+		},
+		WatchFuncWithContext: func(_ context.Context, _ metav1.ListOptions) (watch.Interface, error) {
+			return watcher, nil
+		},
+	}, resyncPeriod)
+	return c, watcher
+}
+
+func TestWorkflowCacheList(t *testing.T) {
+	now := time.Now()
+	c, _ := newTestWorkflowCache(
+		cachedWorkflow("old-abcde", now.Add(-2*time.Hour), map[string]string{labelClusterID: "old", labelOwner: "a.at.example.com", labelFlavor: "gke-default"}),
+		cachedWorkflow("new-abcde", now.Add(-1*time.Hour), map[string]string{labelClusterID: "new", labelOwner: "a.at.example.com", labelFlavor: "qa-demo"}),
+		cachedWorkflow("new-fghij", now, map[string]string{labelClusterID: "new", labelOwner: "b.at.example.com", labelFlavor: "gke-default", labelDeleted: "true"}),
+	)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	c.run(ctx)
+	require.True(t, c.synced())
+
+	tests := []struct {
+		name     string
+		selector string
+		expected []string
+	}{
+		{
+			name:     "everything",
+			selector: "",
+			expected: []string{"new-fghij", "new-abcde", "old-abcde"},
+		},
+		{
+			name:     "by cluster ID",
+			selector: labelClusterID + "=new",
+			expected: []string{"new-fghij", "new-abcde"},
+		},
+		{
+			name:     "by owner and flavor",
+			selector: labelOwner + "=a.at.example.com," + labelFlavor + "=gke-default",
+			expected: []string{"old-abcde"},
+		},
+		{
+			name:     "not deleted",
+			selector: labelDeleted + "!=true",
+			expected: []string{"new-abcde", "old-abcde"},
+		},
+		{
+			name:     "no match",
+			selector: labelClusterID + "=missing",
+			expected: []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			selector, err := labels.Parse(tt.selector)
+			require.NoError(t, err)
+
+			names := []string{}
+			for _, workflow := range c.list(selector) {
+				names = append(names, workflow.GetName())
+			}
+			assert.Equal(t, tt.expected, names)
+		})
+	}
+}
+
+func TestWorkflowCacheReconciler(t *testing.T) {
+	c, watcher := newTestWorkflowCache(
+		cachedWorkflow("existing-abcde", time.Now(), map[string]string{labelClusterID: "existing"}),
+		cachedWorkflow("deleted-abcde", time.Now(), map[string]string{labelClusterID: "deleted", labelDeleted: "true"}),
+	)
+
+	reconciled := make(chan string, 10)
+	require.NoError(t, c.addReconciler("test", 0, func(workflow v1alpha1.Workflow) bool {
+		reconciled <- workflow.GetName()
+		return false
+	}))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	c.run(ctx)
+
+	assert.Equal(t, "existing-abcde", <-reconciled)
+
+	added := cachedWorkflow("added-abcde", time.Now(), map[string]string{labelClusterID: "added"})
+	added.ResourceVersion = "2"
+	watcher.Add(&added)
+	assert.Equal(t, "added-abcde", <-reconciled)
+
+	modified := added.DeepCopy()
+	modified.ResourceVersion = "3"
+	modified.Labels[labelDeleted] = "true"
+	watcher.Modify(modified)
+
+	select {
+	case name := <-reconciled:
+		t.Fatalf("unexpected reconcile of %q", name)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+func TestWorkflowCacheReconcilerSkipsStale(t *testing.T) {
+	c, watcher := newTestWorkflowCache(
+		cachedWorkflow("example-abcde", time.Now(), map[string]string{labelClusterID: "example"}),
+	)
+
+	// The reconciler changes every workflow, like a notification patch.
+	reconciled := make(chan string, 10)
+	require.NoError(t, c.addReconciler("test", 0, func(workflow v1alpha1.Workflow) bool {
+		reconciled <- workflow.GetResourceVersion()
+		return true
+	}))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	c.run(ctx)
+
+	assert.Equal(t, "1", <-reconciled)
+
+	// The cached version is stale until the change reaches the cache.
+	c.resync()
+	select {
+	case version := <-reconciled:
+		t.Fatalf("unexpected reconcile of stale version %q", version)
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	changed := cachedWorkflow("example-abcde", time.Now(), map[string]string{labelClusterID: "example"})
+	changed.ResourceVersion = "2"
+	watcher.Modify(&changed)
+	assert.Equal(t, "2", <-reconciled)
+}
+
+func TestWorkflowCacheReconcilerResyncs(t *testing.T) {
+	c, _ := newTestWorkflowCacheWithResync(time.Second,
+		cachedWorkflow("unchanged-abcde", time.Now(), map[string]string{labelClusterID: "unchanged"}),
+	)
+
+	reconciled := make(chan string, 10)
+	require.NoError(t, c.addReconciler("test", time.Second, func(workflow v1alpha1.Workflow) bool {
+		reconciled <- workflow.GetName()
+		return false
+	}))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	c.run(ctx)
+
+	// The unchanged workflow is reconciled again after the period, which is
+	// at least the informer minimum of a second.
+	for range 2 {
+		select {
+		case name := <-reconciled:
+			assert.Equal(t, "unchanged-abcde", name)
+		case <-time.After(3 * time.Second):
+			t.Fatal("unchanged workflow was not reconciled again")
+		}
+	}
+}
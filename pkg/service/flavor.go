@@ -3,13 +3,16 @@ package service
 import (
 	"context"
 
+	"github.com/golang/protobuf/ptypes/empty"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	v1 "github.com/stackrox/infra/generated/api/v1"
 	"github.com/stackrox/infra/pkg/flavor"
+	"github.com/stackrox/infra/pkg/logging"
 	"github.com/stackrox/infra/pkg/service/middleware"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type flavorImpl struct {
@@ -32,7 +35,7 @@ func NewFlavorService(registry *flavor.Registry) (middleware.APIService, error)
 }
 
 // List implements FlavorService.List.
-func (s *flavorImpl) List(_ context.Context, request *v1.FlavorListRequest) (*v1.FlavorListResponse, error) {
+func (s *flavorImpl) List(ctx context.Context, request *v1.FlavorListRequest) (*v1.FlavorListResponse, error) {
 	var resp v1.FlavorListResponse
 	for _, flavor := range s.registry.Flavors() {
 		if flavor.GetAvailability() == v1.Flavor_janitorDelete {
@@ -41,7 +44,10 @@ func (s *flavorImpl) List(_ context.Context, request *v1.FlavorListRequest) (*v1
 		if !request.GetAll() && (flavor.GetAvailability() == v1.Flavor_alpha || flavor.GetAvailability() == v1.Flavor_deprecated) {
 			continue
 		}
-		scrubInternalParameters(flavor)
+		if !isAvailable(ctx, flavor) {
+			continue
+		}
+		scrubInternalParameters(ctx, flavor)
 		resp.Flavors = append(resp.GetFlavors(), flavor)
 	}
 
@@ -49,19 +55,57 @@ func (s *flavorImpl) List(_ context.Context, request *v1.FlavorListRequest) (*v1
 }
 
 // Info implements FlavorService.Info.
-func (s *flavorImpl) Info(_ context.Context, flavorID *v1.ResourceByID) (*v1.Flavor, error) {
+func (s *flavorImpl) Info(ctx context.Context, flavorID *v1.ResourceByID) (*v1.Flavor, error) {
 	flavor, _, found := s.registry.Get(flavorID.Id)
-	if !found || flavor.GetAvailability() == v1.Flavor_janitorDelete {
+	if !found || flavor.GetAvailability() == v1.Flavor_janitorDelete || !isAvailable(ctx, flavor) {
 		return nil, status.Errorf(codes.NotFound, "flavor %q not found", flavorID.Id)
 	}
-	scrubInternalParameters(flavor)
+	scrubInternalParameters(ctx, flavor)
 
 	return flavor, nil
 }
 
+// Reload implements FlavorService.Reload.
+func (s *flavorImpl) Reload(ctx context.Context, _ *empty.Empty) (*v1.FlavorRegistryStatus, error) {
+	actor, _ := middleware.GetOwnerFromContext(ctx)
+	log.AuditLog(logging.INFO, "flavor-reload", "received a flavor config reload request", "actor", actor)
+
+	// A failed reload is reported as part of the status.
+	_ = s.registry.Reload()
+
+	return s.RegistryStatus(ctx, &empty.Empty{})
+}
+
+// RegistryStatus implements FlavorService.RegistryStatus.
+func (s *flavorImpl) RegistryStatus(_ context.Context, _ *empty.Empty) (*v1.FlavorRegistryStatus, error) {
+	reloadStatus := s.registry.Status()
+
+	resp := &v1.FlavorRegistryStatus{
+		ConfigHash: reloadStatus.ConfigHash,
+		LoadedOn:   timestamppb.New(reloadStatus.LoadedOn),
+		LastReload: timestamppb.New(reloadStatus.LastAttempt),
+	}
+	if reloadStatus.LastError != nil {
+		resp.LastReloadError = reloadStatus.LastError.Error()
+	}
+
+	return resp, nil
+}
+
+// isAvailable determines if the principal in the given context may use the
+// given flavor. Infra admins may use every flavor.
+func isAvailable(ctx context.Context, flav *v1.Flavor) bool {
+	return middleware.HasAccess(ctx, middleware.Admin) || flavor.IsAvailableTo(flav, middleware.TeamsFromContext(ctx))
+}
+
 // scrubInternalParameters drops any internal parameters from the given flavor,
-// as the end user is not allowed to provide values for them.
-func scrubInternalParameters(flavor *v1.Flavor) {
+// as the end user is not allowed to provide values for them. Flavor admins
+// still get to see them.
+func scrubInternalParameters(ctx context.Context, flavor *v1.Flavor) {
+	if middleware.HasAccess(ctx, middleware.FlavorAdmin) {
+		return
+	}
+
 	newParams := make(map[string]*v1.Parameter)
 	for paramName, paramValue := range flavor.Parameters {
 		if paramValue.Internal {
@@ -76,8 +120,10 @@ func scrubInternalParameters(flavor *v1.Flavor) {
 // Access configures access for this service.
 func (s *flavorImpl) Access() map[string]middleware.Access {
 	return map[string]middleware.Access{
-		"/v1.FlavorService/Info": middleware.Authenticated,
-		"/v1.FlavorService/List": middleware.Authenticated,
+		"/v1.FlavorService/Info":           middleware.Viewer,
+		"/v1.FlavorService/List":           middleware.Viewer,
+		"/v1.FlavorService/Reload":         middleware.FlavorAdmin,
+		"/v1.FlavorService/RegistryStatus": middleware.Viewer,
 	}
 }
 
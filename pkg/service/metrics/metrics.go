@@ -40,6 +40,52 @@ var (
 			Help:      "Current number of entries in the artifact cache",
 		},
 	)
+
+	// LeaderGauge reports whether this replica is the leader that runs the background reconcilers
+	LeaderGauge = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: "infra",
+			Name:      "leader",
+			Help:      "Whether this replica is the leader that runs the background reconcilers (1) or not (0)",
+		},
+	)
+
+	// WorkflowCacheSizeGauge reports current number of workflows in the workflow cache
+	WorkflowCacheSizeGauge = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: "infra",
+			Name:      "workflow_cache_size",
+			Help:      "Current number of workflows in the workflow cache",
+		},
+	)
+
+	// WorkflowCacheSyncedGauge reports whether the workflow cache has completed its initial sync
+	WorkflowCacheSyncedGauge = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: "infra",
+			Name:      "workflow_cache_synced",
+			Help:      "Whether the workflow cache has completed its initial sync (1) or not (0)",
+		},
+	)
+
+	// WorkflowCacheLastEventGauge reports when the workflow cache last received a change event
+	WorkflowCacheLastEventGauge = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: "infra",
+			Name:      "workflow_cache_last_event_timestamp_seconds",
+			Help:      "Unix time at which the workflow cache last received a change event",
+		},
+	)
+
+	// WorkflowCacheEventLagHistogram tracks the delay between a workflow change and the workflow cache observing it
+	WorkflowCacheEventLagHistogram = prometheus.NewHistogram(
+		prometheus.HistogramOpts{
+			Namespace: "infra",
+			Name:      "workflow_cache_event_lag_seconds",
+			Help:      "Delay between a workflow change and the workflow cache receiving its event",
+			Buckets:   []float64{0.1, 0.5, 1, 2, 5, 10, 30, 60},
+		},
+	)
 )
 
 func init() {
@@ -48,4 +94,9 @@ func init() {
 	prometheus.MustRegister(ArtifactCacheHitsCounter)
 	prometheus.MustRegister(ArtifactCacheMissesCounter)
 	prometheus.MustRegister(ArtifactCacheSizeGauge)
+	prometheus.MustRegister(LeaderGauge)
+	prometheus.MustRegister(WorkflowCacheSizeGauge)
+	prometheus.MustRegister(WorkflowCacheSyncedGauge)
+	prometheus.MustRegister(WorkflowCacheLastEventGauge)
+	prometheus.MustRegister(WorkflowCacheEventLagHistogram)
 }
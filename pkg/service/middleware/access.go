@@ -1,16 +1,25 @@
 package middleware
 
 // Access represents a single access level, that is used when permissioning API
-// endpoints.
+// endpoints. Access levels are ordered from most to least privileged, and a
+// principal with a given access level may access any endpoint that requires
+// that level or a less privileged one.
 type Access int
 
 const (
-	// Admin represents admin level access
+	// Admin represents infra-admin level access.
 	Admin Access = iota + 1
 
-	// Authenticated represents user or service account level access.
+	// FlavorAdmin represents flavor-admin level access.
+	FlavorAdmin
+
+	// Authenticated represents user level access, which is granted to users
+	// and service accounts unless configured otherwise.
 	Authenticated
 
+	// Viewer represents read-only access.
+	Viewer
+
 	// Anonymous represents unauthenticated access.
 	Anonymous
 )
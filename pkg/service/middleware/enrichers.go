@@ -5,6 +5,7 @@ package middleware
 import (
 	"context"
 
+	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	"github.com/pkg/errors"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
@@ -12,7 +13,9 @@ import (
 )
 
 // contextFunc represents a function that can be used for processing and
-// transforming a context as part of a gRPC interceptor.
+// transforming a context as part of a gRPC interceptor. It is used for both
+// unary and stream calls, and only depends on the called service and method
+// of the given info.
 type contextFunc func(ctx context.Context, info *grpc.UnaryServerInfo) (context.Context, error)
 
 // ContextInterceptor enables the interception and transformation of a gRPC context.
@@ -27,10 +30,30 @@ func ContextInterceptor(ctxFunc contextFunc) grpc.UnaryServerInterceptor {
 	}
 }
 
+// ContextStreamInterceptor enables the interception and transformation of the
+// context of a gRPC stream.
+func ContextStreamInterceptor(ctxFunc contextFunc) grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		newCtx, err := ctxFunc(stream.Context(), &grpc.UnaryServerInfo{
+			Server:     srv,
+			FullMethod: info.FullMethod,
+		})
+		if err != nil {
+			return err
+		}
+
+		wrapped := grpc_middleware.WrapServerStream(stream)
+		wrapped.WrappedContext = newCtx
+		return handler(srv, wrapped)
+	}
+}
+
 // EnforceAccess enforces authorization to API services. Specifically,
 // if a service declares that it is allowed to be accessed anonymously, access
-// is allowed always. If the service does not permit anonymous access, a
-// v1.User must exist in the given context for access to be allowed.
+// is allowed always. Otherwise, the principal in the given context must have
+// been granted a role with at least the access level that the service declares
+// for the called method. Service accounts with a scoped token may only call
+// the methods in their scope.
 func EnforceAccess(ctx context.Context, info *grpc.UnaryServerInfo) (context.Context, error) {
 	// Convert to a service.
 	svc, ok := info.Server.(APIService)
@@ -41,31 +64,27 @@ func EnforceAccess(ctx context.Context, info *grpc.UnaryServerInfo) (context.Con
 	access := getAccess(ctx)
 
 	if isAccessAllowed(info.FullMethod, svc.Access(), access) {
+		if svc.Access()[info.FullMethod] != Anonymous && !isMethodInScope(ScopeFromContext(ctx), info.FullMethod) {
+			return nil, status.Errorf(codes.PermissionDenied, "token scope does not allow %s", info.FullMethod)
+		}
 		return ctx, nil
 	}
 
-	// There is no authenticated principal, deny access!
+	// The principal does not have a sufficient role, deny access!
 	return nil, status.Error(codes.PermissionDenied, "access denied")
 }
 
+// getAccess determines the highest access level granted by the roles of the
+// principal in the given context.
 func getAccess(ctx context.Context) Access {
-	// Check if an authenticated user is accessing the service.
-	if _, found := UserFromContext(ctx); found {
-		return Authenticated
-	}
-
-	// Check if an authenticated service account is accessing the service.
-	if _, found := ServiceAccountFromContext(ctx); found {
-		return Authenticated
-	}
-
-	// Check if an authenticated service account is accessing the service.
-	if AdminInContext(ctx) {
-		return Admin
+	access := Anonymous
+	for _, role := range RolesFromContext(ctx) {
+		if roleAccess[role] < access {
+			access = roleAccess[role]
+		}
 	}
 
-	// Fall back to anonymous access.
-	return Anonymous
+	return access
 }
 
 func isAccessAllowed(method string, policy map[string]Access, access Access) bool {
@@ -74,20 +93,8 @@ func isAccessAllowed(method string, policy map[string]Access, access Access) boo
 		return false
 	}
 
-	// have                  | Admin | Authenticated | Anonymous |
-	// require Admin         | allow | deny          | deny      |
-	// require Authenticated | deny  | allow         | deny      |
-	// require Anonymous     | deny  | allow         | allow     |
-	switch required {
-	case Admin:
-		return access == Admin
-	case Authenticated:
-		return access == Authenticated
-	case Anonymous:
-		return access == Anonymous || access == Authenticated
-	default:
-		panic("unknown required access level")
-	}
+	// Access levels are ordered from most to least privileged.
+	return access <= required
 }
 
 // GetOwnerFromContext finds the email of the authenticated user or service account from the request context.
@@ -0,0 +1,115 @@
+package middleware
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	v1 "github.com/stackrox/infra/generated/api/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+const testAdminPassword = "password"
+
+// streamService is a CliService whose stream requires an authenticated
+// caller, and which reports whether the caller is an admin.
+type streamService struct {
+	v1.UnimplementedCliServiceServer
+}
+
+func (s *streamService) Upgrade(_ *v1.CliUpgradeRequest, stream v1.CliService_UpgradeServer) error {
+	chunk := "anonymous"
+	if AdminInContext(stream.Context()) {
+		chunk = "admin"
+	}
+	return stream.Send(&v1.CliUpgradeResponse{FileChunk: []byte(chunk)})
+}
+
+func (s *streamService) Access() map[string]Access {
+	return map[string]Access{
+		"/v1.CliService/Upgrade": Authenticated,
+	}
+}
+
+func (s *streamService) RegisterServiceServer(server *grpc.Server) {
+	v1.RegisterCliServiceServer(server, s)
+}
+
+func (s *streamService) RegisterServiceHandler(_ context.Context, _ *runtime.ServeMux, _ *grpc.ClientConn) error {
+	return nil
+}
+
+func TestStreamAccess(t *testing.T) {
+	listener := bufconn.Listen(1024 * 1024)
+	server := grpc.NewServer(
+		grpc.StreamInterceptor(grpc_middleware.ChainStreamServer(
+			ContextStreamInterceptor(AdminEnricher(testAdminPassword)),
+			ContextStreamInterceptor(RoleEnricher(nil)),
+			ContextStreamInterceptor(EnforceAccess),
+		)),
+	)
+	(&streamService{}).RegisterServiceServer(server)
+	go server.Serve(listener) //nolint:errcheck
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+	client := v1.NewCliServiceClient(conn)
+
+	tests := []struct {
+		name     string
+		token    string
+		code     codes.Code
+		expected string
+	}{
+		{
+			name: "anonymous caller is rejected",
+			code: codes.PermissionDenied,
+		},
+		{
+			name:  "invalid token is rejected",
+			token: "wrong",
+			code:  codes.PermissionDenied,
+		},
+		{
+			name:     "admin is allowed and enriched",
+			token:    testAdminPassword,
+			expected: "admin",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctx := context.Background()
+			if test.token != "" {
+				ctx = metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+test.token)
+			}
+
+			stream, err := client.Upgrade(ctx, &v1.CliUpgradeRequest{})
+			require.NoError(t, err)
+
+			resp, err := stream.Recv()
+			if test.code != codes.OK {
+				assert.Equal(t, test.code, status.Code(err))
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, string(resp.GetFileChunk()))
+		})
+	}
+}
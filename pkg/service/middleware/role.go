@@ -0,0 +1,198 @@
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/stackrox/infra/pkg/config"
+	"google.golang.org/grpc"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+// Role is a named access level that can be bound to principals.
+type Role string
+
+const (
+	// RoleViewer grants read-only access.
+	RoleViewer Role = "viewer"
+
+	// RoleUser grants access to create and manage your own clusters.
+	RoleUser Role = "user"
+
+	// RoleFlavorAdmin additionally grants access to flavor internals.
+	RoleFlavorAdmin Role = "flavor-admin"
+
+	// RoleInfraAdmin grants access to everything, including the clusters of
+	// other owners.
+	RoleInfraAdmin Role = "infra-admin"
+)
+
+// roleAccess maps every known role to the access level that it grants.
+var roleAccess = map[Role]Access{
+	RoleViewer:      Viewer,
+	RoleUser:        Authenticated,
+	RoleFlavorAdmin: FlavorAdmin,
+	RoleInfraAdmin:  Admin,
+}
+
+type roleContextKey struct{}
+
+type teamContextKey struct{}
+
+// ValidateRBACConfig checks that the given configuration only references
+// known roles.
+func ValidateRBACConfig(cfg *config.RBACConfig) error {
+	if cfg == nil {
+		return nil
+	}
+
+	if _, found := roleAccess[Role(cfg.DefaultRole)]; cfg.DefaultRole != "" && !found {
+		return fmt.Errorf("unknown default role %q", cfg.DefaultRole)
+	}
+
+	for index, binding := range cfg.Bindings {
+		if _, found := roleAccess[Role(binding.Role)]; !found {
+			return fmt.Errorf("unknown role %q in binding %d", binding.Role, index)
+		}
+	}
+
+	teams := make(map[string]struct{}, len(cfg.Teams))
+	for index, team := range cfg.Teams {
+		if team.Name == "" {
+			return fmt.Errorf("no name given for team %d", index)
+		}
+		if errs := validation.IsValidLabelValue(team.Name); len(errs) > 0 {
+			return fmt.Errorf("invalid name %q for team %d: %s", team.Name, index, strings.Join(errs, ", "))
+		}
+		if _, found := teams[team.Name]; found {
+			return fmt.Errorf("team %q is defined more than once", team.Name)
+		}
+		teams[team.Name] = struct{}{}
+	}
+
+	return nil
+}
+
+// RoleEnricher enriches the given gRPC context with the roles granted to the
+// authenticated principal, and the teams it is a member of. It must run after
+// the user, service account and admin enrichers.
+func RoleEnricher(cfg *config.RBACConfig) contextFunc {
+	return func(ctx context.Context, _ *grpc.UnaryServerInfo) (context.Context, error) {
+		ctx = contextWithTeams(ctx, resolveTeams(ctx, cfg))
+		return contextWithRoles(ctx, resolveRoles(ctx, cfg)), nil
+	}
+}
+
+// principalIdentity returns the email and OIDC groups of the authenticated
+// principal in the given context.
+func principalIdentity(ctx context.Context) (string, []string, bool) {
+	if user, found := UserFromContext(ctx); found {
+		return user.GetEmail(), user.GetGroups(), true
+	}
+	if svcacct, found := ServiceAccountFromContext(ctx); found {
+		return svcacct.GetEmail(), svcacct.GetGroups(), true
+	}
+	return "", nil, false
+}
+
+// isBound determines if the given email, or any of the given groups, is
+// listed by a binding.
+func isBound(email string, groups []string, boundEmails []string, boundGroups []string) bool {
+	return slices.Contains(boundEmails, email) || slices.ContainsFunc(boundGroups, func(group string) bool {
+		return slices.Contains(groups, group)
+	})
+}
+
+// resolveTeams determines the teams that the principal in the given context
+// is a member of, sorted by name.
+func resolveTeams(ctx context.Context, cfg *config.RBACConfig) []string {
+	email, groups, found := principalIdentity(ctx)
+	if !found || cfg == nil {
+		return nil
+	}
+
+	var teams []string
+	for _, team := range cfg.Teams {
+		if isBound(email, groups, team.Emails, team.Groups) && !slices.Contains(teams, team.Name) {
+			teams = append(teams, team.Name)
+		}
+	}
+	sort.Strings(teams)
+
+	return teams
+}
+
+// resolveRoles determines the roles granted to the principal in the given
+// context. Anonymous callers are not granted any roles.
+func resolveRoles(ctx context.Context, cfg *config.RBACConfig) []Role {
+	if AdminInContext(ctx) {
+		return []Role{RoleInfraAdmin}
+	}
+
+	email, groups, found := principalIdentity(ctx)
+	if !found {
+		return nil
+	}
+
+	defaultRole := RoleUser
+	if cfg != nil && cfg.DefaultRole != "" {
+		defaultRole = Role(cfg.DefaultRole)
+	}
+	roles := []Role{defaultRole}
+
+	if cfg != nil {
+		for _, binding := range cfg.Bindings {
+			role := Role(binding.Role)
+			if slices.Contains(roles, role) {
+				continue
+			}
+			if isBound(email, groups, binding.Emails, binding.Groups) {
+				roles = append(roles, role)
+			}
+		}
+	}
+
+	// Order roles from most to least privileged.
+	sort.SliceStable(roles, func(i, j int) bool {
+		return roleAccess[roles[i]] < roleAccess[roles[j]]
+	})
+
+	return roles
+}
+
+// RolesFromContext extracts the roles granted to the authenticated principal
+// from the given context. If the context was not enriched with roles, the
+// default roles for the principal are returned.
+func RolesFromContext(ctx context.Context) []Role {
+	roles, found := ctx.Value(roleContextKey{}).([]Role)
+	if !found {
+		return resolveRoles(ctx, nil)
+	}
+	return roles
+}
+
+// TeamsFromContext extracts the teams that the authenticated principal is a
+// member of from the given context.
+func TeamsFromContext(ctx context.Context) []string {
+	teams, _ := ctx.Value(teamContextKey{}).([]string)
+	return teams
+}
+
+// HasAccess determines if the principal in the given context has at least the
+// given access level.
+func HasAccess(ctx context.Context, access Access) bool {
+	return getAccess(ctx) <= access
+}
+
+// contextWithRoles returns the given context enriched with roles.
+func contextWithRoles(ctx context.Context, roles []Role) context.Context {
+	return context.WithValue(ctx, roleContextKey{}, roles)
+}
+
+// contextWithTeams returns the given context enriched with teams.
+func contextWithTeams(ctx context.Context, teams []string) context.Context {
+	return context.WithValue(ctx, teamContextKey{}, teams)
+}
@@ -0,0 +1,183 @@
+package middleware
+
+import (
+	"context"
+	"testing"
+
+	v1 "github.com/stackrox/infra/generated/api/v1"
+	"github.com/stackrox/infra/pkg/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResolveRoles(t *testing.T) {
+	cfg := &config.RBACConfig{
+		Bindings: []config.RoleBinding{
+			{Role: "infra-admin", Emails: []string{"admin@example.com"}},
+			{Role: "flavor-admin", Groups: []string{"flavor-maintainers"}},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		cfg      *config.RBACConfig
+		expected []Role
+	}{
+		{
+			name: "anonymous",
+			ctx:  context.Background(),
+			cfg:  cfg,
+		},
+		{
+			name:     "admin password",
+			ctx:      contextWithAdmin(context.Background()),
+			cfg:      cfg,
+			expected: []Role{RoleInfraAdmin},
+		},
+		{
+			name:     "no config",
+			ctx:      contextWithUser(context.Background(), &v1.User{Email: "user@example.com"}),
+			expected: []Role{RoleUser},
+		},
+		{
+			name:     "default role",
+			ctx:      contextWithUser(context.Background(), &v1.User{Email: "user@example.com"}),
+			cfg:      &config.RBACConfig{DefaultRole: "viewer"},
+			expected: []Role{RoleViewer},
+		},
+		{
+			name:     "bound by email",
+			ctx:      contextWithServiceAccount(context.Background(), &v1.ServiceAccount{Email: "admin@example.com"}),
+			cfg:      cfg,
+			expected: []Role{RoleInfraAdmin, RoleUser},
+		},
+		{
+			name:     "bound by group",
+			ctx:      contextWithUser(context.Background(), &v1.User{Email: "user@example.com", Groups: []string{"flavor-maintainers"}}),
+			cfg:      cfg,
+			expected: []Role{RoleFlavorAdmin, RoleUser},
+		},
+		{
+			name:     "personal token bound by group",
+			ctx:      contextWithServiceAccount(context.Background(), &v1.ServiceAccount{Email: "user@example.com", Groups: []string{"flavor-maintainers"}}),
+			cfg:      cfg,
+			expected: []Role{RoleFlavorAdmin, RoleUser},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, resolveRoles(test.ctx, test.cfg))
+		})
+	}
+}
+
+func TestResolveTeams(t *testing.T) {
+	cfg := &config.RBACConfig{
+		Teams: []config.TeamBinding{
+			{Name: "sre", Groups: []string{"sre", "oncall"}},
+			{Name: "ci", Emails: []string{"roxbot@example.com"}},
+			{Name: "platform", Groups: []string{"oncall"}},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		cfg      *config.RBACConfig
+		expected []string
+	}{
+		{
+			name: "anonymous",
+			ctx:  context.Background(),
+			cfg:  cfg,
+		},
+		{
+			name: "no config",
+			ctx:  contextWithUser(context.Background(), &v1.User{Email: "user@example.com", Groups: []string{"sre"}}),
+		},
+		{
+			name:     "member by groups",
+			ctx:      contextWithUser(context.Background(), &v1.User{Email: "user@example.com", Groups: []string{"oncall"}}),
+			cfg:      cfg,
+			expected: []string{"platform", "sre"},
+		},
+		{
+			name:     "member by email",
+			ctx:      contextWithServiceAccount(context.Background(), &v1.ServiceAccount{Email: "roxbot@example.com"}),
+			cfg:      cfg,
+			expected: []string{"ci"},
+		},
+		{
+			name:     "personal token member by groups",
+			ctx:      contextWithServiceAccount(context.Background(), &v1.ServiceAccount{Email: "user@example.com", Groups: []string{"sre"}}),
+			cfg:      cfg,
+			expected: []string{"sre"},
+		},
+		{
+			name: "no member",
+			ctx:  contextWithUser(context.Background(), &v1.User{Email: "user@example.com", Groups: []string{"qa"}}),
+			cfg:  cfg,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, resolveTeams(test.ctx, test.cfg))
+		})
+	}
+}
+
+func TestIsAccessAllowed(t *testing.T) {
+	policy := map[string]Access{
+		"/admin":     Admin,
+		"/user":      Authenticated,
+		"/viewer":    Viewer,
+		"/anonymous": Anonymous,
+	}
+
+	tests := []struct {
+		method  string
+		access  Access
+		allowed bool
+	}{
+		{method: "/admin", access: Admin, allowed: true},
+		{method: "/admin", access: FlavorAdmin, allowed: false},
+		{method: "/admin", access: Authenticated, allowed: false},
+		{method: "/user", access: Admin, allowed: true},
+		{method: "/user", access: Authenticated, allowed: true},
+		{method: "/user", access: Viewer, allowed: false},
+		{method: "/viewer", access: Viewer, allowed: true},
+		{method: "/viewer", access: Anonymous, allowed: false},
+		{method: "/anonymous", access: Anonymous, allowed: true},
+		{method: "/unknown", access: Admin, allowed: false},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.allowed, isAccessAllowed(test.method, policy, test.access), "%s with %d", test.method, test.access)
+	}
+}
+
+func TestValidateRBACConfig(t *testing.T) {
+	assert.NoError(t, ValidateRBACConfig(nil))
+	assert.NoError(t, ValidateRBACConfig(&config.RBACConfig{
+		DefaultRole: "viewer",
+		Bindings:    []config.RoleBinding{{Role: "infra-admin"}},
+	}))
+	assert.Error(t, ValidateRBACConfig(&config.RBACConfig{DefaultRole: "owner"}))
+	assert.Error(t, ValidateRBACConfig(&config.RBACConfig{
+		Bindings: []config.RoleBinding{{Role: "admin"}},
+	}))
+	assert.NoError(t, ValidateRBACConfig(&config.RBACConfig{
+		Teams: []config.TeamBinding{{Name: "sre"}, {Name: "ci"}},
+	}))
+	assert.Error(t, ValidateRBACConfig(&config.RBACConfig{
+		Teams: []config.TeamBinding{{Groups: []string{"sre"}}},
+	}))
+	assert.Error(t, ValidateRBACConfig(&config.RBACConfig{
+		Teams: []config.TeamBinding{{Name: "sre"}, {Name: "sre"}},
+	}))
+	assert.Error(t, ValidateRBACConfig(&config.RBACConfig{
+		Teams: []config.TeamBinding{{Name: "sre/on-call"}},
+	}))
+}
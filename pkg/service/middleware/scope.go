@@ -0,0 +1,103 @@
+package middleware
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	v1 "github.com/stackrox/infra/generated/api/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ValidateScope returns an error if the given token scope is malformed.
+func ValidateScope(scope *v1.TokenScope) error {
+	for _, method := range scope.GetMethods() {
+		if !strings.HasPrefix(method, "/") || strings.Count(method, "/") != 2 || strings.HasSuffix(method, "/") {
+			return errors.Errorf("method %q is not a full method name like /v1.ClusterService/Create", method)
+		}
+	}
+	for _, flavor := range scope.GetFlavors() {
+		if flavor == "" {
+			return errors.New("empty flavor given")
+		}
+	}
+	if scope.GetMaxLifespan().AsDuration() < 0 {
+		return errors.New("max lifespan is negative")
+	}
+	if scope.GetMaxClusters() < 0 {
+		return errors.New("max clusters is negative")
+	}
+
+	return nil
+}
+
+// ScopeFromContext returns the scope of the service account token that the
+// given context was authenticated with, or nil if it is not restricted.
+func ScopeFromContext(ctx context.Context) *v1.TokenScope {
+	// Users authenticated with a session cookie are never restricted.
+	if _, found := UserFromContext(ctx); found {
+		return nil
+	}
+
+	svcacct, found := ServiceAccountFromContext(ctx)
+	if !found {
+		return nil
+	}
+	return svcacct.GetScope()
+}
+
+// isMethodInScope determines if the given scope allows calling the given full
+// method name.
+func isMethodInScope(scope *v1.TokenScope, method string) bool {
+	if len(scope.GetMethods()) == 0 {
+		return true
+	}
+
+	service := method[:strings.LastIndex(method, "/")+1]
+	return slices.Contains(scope.GetMethods(), method) || slices.Contains(scope.GetMethods(), service+"*")
+}
+
+// CheckFlavorScope returns a codes.PermissionDenied error if the scope of the
+// token in the given context does not allow creating clusters of the given
+// flavor.
+func CheckFlavorScope(ctx context.Context, flavorID string) error {
+	return CheckFlavorInScope(ScopeFromContext(ctx), flavorID)
+}
+
+// CheckFlavorInScope returns a codes.PermissionDenied error if the given
+// scope does not allow creating clusters of the given flavor.
+func CheckFlavorInScope(scope *v1.TokenScope, flavorID string) error {
+	if len(scope.GetFlavors()) == 0 || slices.Contains(scope.GetFlavors(), flavorID) {
+		return nil
+	}
+
+	return status.Errorf(codes.PermissionDenied, "token scope does not allow flavor %q, only %s",
+		flavorID, strings.Join(scope.GetFlavors(), ", "),
+	)
+}
+
+// CheckLifespanScope returns a codes.PermissionDenied error if the scope of
+// the token in the given context does not allow giving a cluster the given
+// lifespan.
+func CheckLifespanScope(ctx context.Context, lifespan time.Duration) error {
+	return CheckLifespanInScope(ScopeFromContext(ctx), lifespan)
+}
+
+// CheckLifespanInScope returns a codes.PermissionDenied error if the given
+// scope does not allow giving a cluster the given lifespan.
+func CheckLifespanInScope(scope *v1.TokenScope, lifespan time.Duration) error {
+	if scope.GetMaxLifespan() == nil {
+		return nil
+	}
+
+	if maxLifespan := scope.GetMaxLifespan().AsDuration(); lifespan > maxLifespan {
+		return status.Errorf(codes.PermissionDenied, "token scope does not allow a lifespan of %s, at most %s",
+			lifespan, maxLifespan,
+		)
+	}
+
+	return nil
+}
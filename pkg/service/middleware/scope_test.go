@@ -0,0 +1,164 @@
+package middleware
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	v1 "github.com/stackrox/infra/generated/api/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func TestValidateScope(t *testing.T) {
+	tests := []struct {
+		name  string
+		scope *v1.TokenScope
+		error string
+	}{
+		{
+			name: "unscoped",
+		},
+		{
+			name: "valid",
+			scope: &v1.TokenScope{
+				Methods:     []string{"/v1.ClusterService/Create", "/v1.FlavorService/*"},
+				Flavors:     []string{"gke-default"},
+				MaxLifespan: durationpb.New(8 * time.Hour),
+				MaxClusters: 2,
+			},
+		},
+		{
+			name:  "short method name",
+			scope: &v1.TokenScope{Methods: []string{"Create"}},
+			error: `method "Create" is not a full method name`,
+		},
+		{
+			name:  "method without name",
+			scope: &v1.TokenScope{Methods: []string{"/v1.ClusterService/"}},
+			error: `method "/v1.ClusterService/" is not a full method name`,
+		},
+		{
+			name:  "empty flavor",
+			scope: &v1.TokenScope{Flavors: []string{""}},
+			error: "empty flavor given",
+		},
+		{
+			name:  "negative lifespan",
+			scope: &v1.TokenScope{MaxLifespan: durationpb.New(-time.Hour)},
+			error: "max lifespan is negative",
+		},
+		{
+			name:  "negative clusters",
+			scope: &v1.TokenScope{MaxClusters: -1},
+			error: "max clusters is negative",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := ValidateScope(test.scope)
+			if test.error != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), test.error)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestIsMethodInScope(t *testing.T) {
+	scope := &v1.TokenScope{Methods: []string{"/v1.ClusterService/Create", "/v1.FlavorService/*"}}
+
+	tests := []struct {
+		name     string
+		scope    *v1.TokenScope
+		method   string
+		expected bool
+	}{
+		{name: "unscoped", method: "/v1.ClusterService/Delete", expected: true},
+		{name: "no methods", scope: &v1.TokenScope{Flavors: []string{"gke-default"}}, method: "/v1.ClusterService/Delete", expected: true},
+		{name: "listed method", scope: scope, method: "/v1.ClusterService/Create", expected: true},
+		{name: "unlisted method", scope: scope, method: "/v1.ClusterService/Delete"},
+		{name: "method of listed service", scope: scope, method: "/v1.FlavorService/List", expected: true},
+		{name: "method of similar service", scope: scope, method: "/v1.FlavorServiceV2/List"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, isMethodInScope(test.scope, test.method))
+		})
+	}
+}
+
+// accessService is an APIService with the given access policy.
+type accessService map[string]Access
+
+func (s accessService) Access() map[string]Access {
+	return s
+}
+
+func (s accessService) RegisterServiceServer(_ *grpc.Server) {}
+
+func (s accessService) RegisterServiceHandler(_ context.Context, _ *runtime.ServeMux, _ *grpc.ClientConn) error {
+	return nil
+}
+
+func TestEnforceAccessScope(t *testing.T) {
+	scoped := contextWithServiceAccount(context.Background(), &v1.ServiceAccount{
+		Email: "roxbot@redhat.com",
+		Scope: &v1.TokenScope{Methods: []string{"/v1.CliService/Upgrade"}},
+	})
+	scoped = contextWithRoles(scoped, []Role{RoleUser})
+
+	tests := []struct {
+		name   string
+		ctx    context.Context
+		method string
+		access Access
+		code   codes.Code
+	}{
+		{name: "method in scope", ctx: scoped, method: "/v1.CliService/Upgrade", access: Authenticated},
+		{name: "method out of scope", ctx: scoped, method: "/v1.CliService/Other", access: Authenticated, code: codes.PermissionDenied},
+		{name: "anonymous method out of scope", ctx: scoped, method: "/v1.CliService/Other", access: Anonymous},
+		{
+			name:   "user is not scoped",
+			ctx:    contextWithUser(scoped, &v1.User{Email: "user@redhat.com"}),
+			method: "/v1.CliService/Other",
+			access: Authenticated,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			svc := accessService{test.method: test.access}
+			_, err := EnforceAccess(test.ctx, &grpc.UnaryServerInfo{Server: svc, FullMethod: test.method})
+			assert.Equal(t, test.code, status.Code(err))
+		})
+	}
+}
+
+func TestCheckScope(t *testing.T) {
+	ctx := contextWithServiceAccount(context.Background(), &v1.ServiceAccount{
+		Email: "roxbot@redhat.com",
+		Scope: &v1.TokenScope{
+			Flavors:     []string{"gke-default", "openshift-4"},
+			MaxLifespan: durationpb.New(8 * time.Hour),
+		},
+	})
+	unscoped := contextWithServiceAccount(context.Background(), &v1.ServiceAccount{Email: "roxbot@redhat.com"})
+
+	assert.NoError(t, CheckFlavorScope(ctx, "gke-default"))
+	assert.Equal(t, codes.PermissionDenied, status.Code(CheckFlavorScope(ctx, "eks")))
+	assert.NoError(t, CheckFlavorScope(unscoped, "eks"))
+
+	assert.NoError(t, CheckLifespanScope(ctx, 8*time.Hour))
+	assert.Equal(t, codes.PermissionDenied, status.Code(CheckLifespanScope(ctx, 9*time.Hour)))
+	assert.NoError(t, CheckLifespanScope(unscoped, 48*time.Hour))
+}
@@ -6,17 +6,24 @@ import (
 
 	"github.com/pkg/errors"
 	v1 "github.com/stackrox/infra/generated/api/v1"
+	"github.com/stackrox/infra/pkg/logging"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
 )
 
+var log = logging.CreateProductionLogger()
+
 type serviceAccountContextKey struct{}
 
 // ServiceAccountEnricher enriches the given gRPC context with a
 // v1.ServiceAccount struct, if possible. If there is no service account, this
 // function does not return an error, as anonymous API calls are a possibility.
 // Authorization must be independently enforced.
-func ServiceAccountEnricher(validator func(string) (*v1.ServiceAccount, error)) contextFunc {
+//
+// Revoked tokens and tokens with a malformed scope are treated like invalid
+// ones. Tokens issued without an ID cannot be revoked, and stay valid until
+// they expire.
+func ServiceAccountEnricher(validator func(string) (*v1.ServiceAccount, error), isRevoked func(context.Context, string) (bool, error)) contextFunc {
 	return func(ctx context.Context, _ *grpc.UnaryServerInfo) (context.Context, error) {
 		// Extract request metadata (proxied http headers) from given context.
 		meta, ok := metadata.FromIncomingContext(ctx)
@@ -35,6 +42,24 @@ func ServiceAccountEnricher(validator func(string) (*v1.ServiceAccount, error))
 		if err != nil {
 			return ctx, nil
 		}
+		if err := ValidateScope(svcacct.GetScope()); err != nil {
+			return ctx, nil
+		}
+
+		// Reject revoked tokens, and fail closed if that cannot be checked.
+		if svcacct.GetJti() != "" {
+			revoked, err := isRevoked(ctx, svcacct.GetJti())
+			if err != nil {
+				log.Log(logging.ERROR, "failed to check service account token revocation",
+					"token-id", svcacct.GetJti(),
+					"error", err,
+				)
+				return ctx, nil
+			}
+			if revoked {
+				return ctx, nil
+			}
+		}
 
 		return contextWithServiceAccount(ctx, svcacct), nil
 	}
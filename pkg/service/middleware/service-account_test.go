@@ -0,0 +1,62 @@
+package middleware
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	v1 "github.com/stackrox/infra/generated/api/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestServiceAccountEnricher(t *testing.T) {
+	validator := func(token string) (*v1.ServiceAccount, error) {
+		switch token {
+		case "legacy":
+			return &v1.ServiceAccount{Email: "roxbot@redhat.com"}, nil
+		case "invalid":
+			return nil, errors.New("invalid token")
+		default:
+			return &v1.ServiceAccount{Email: "roxbot@redhat.com", Jti: token}, nil
+		}
+	}
+	isRevoked := func(_ context.Context, id string) (bool, error) {
+		switch id {
+		case "revoked":
+			return true, nil
+		case "unavailable":
+			return false, errors.New("store unavailable")
+		default:
+			return false, nil
+		}
+	}
+
+	tests := []struct {
+		name     string
+		token    string
+		expected bool
+	}{
+		{name: "no token"},
+		{name: "invalid token", token: "invalid"},
+		{name: "valid token", token: "valid", expected: true},
+		{name: "revoked token", token: "revoked"},
+		{name: "revocation check failed", token: "unavailable"},
+		{name: "token without ID", token: "legacy", expected: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctx := metadata.NewIncomingContext(context.Background(), metadata.MD{})
+			if test.token != "" {
+				ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+test.token))
+			}
+
+			ctx, err := ServiceAccountEnricher(validator, isRevoked)(ctx, nil)
+			require.NoError(t, err)
+			_, found := ServiceAccountFromContext(ctx)
+			assert.Equal(t, test.expected, found)
+		})
+	}
+}
@@ -7,13 +7,26 @@ import (
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"github.com/pkg/errors"
 	v1 "github.com/stackrox/infra/generated/api/v1"
+	"github.com/stackrox/infra/pkg/logging"
 	"github.com/stackrox/infra/pkg/service/middleware"
+	"github.com/stackrox/infra/pkg/tokenstore"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type userImpl struct {
 	v1.UnimplementedUserServiceServer
 	generate func(*v1.ServiceAccount) (string, error)
+	tokens   tokenStore
+}
+
+// tokenStore records the issued service account tokens.
+type tokenStore interface {
+	Add(ctx context.Context, token *v1.IssuedToken) error
+	Get(ctx context.Context, id string) (*v1.IssuedToken, error)
+	List(ctx context.Context) ([]*v1.IssuedToken, error)
+	Revoke(ctx context.Context, id string, actor string) (*v1.IssuedToken, error)
 }
 
 var (
@@ -22,20 +35,39 @@ var (
 )
 
 // NewUserService creates a new UserService.
-func NewUserService(generator func(*v1.ServiceAccount) (string, error)) (middleware.APIService, error) {
+func NewUserService(generator func(*v1.ServiceAccount) (string, error), tokens tokenStore) (middleware.APIService, error) {
 	return &userImpl{
 		generate: generator,
+		tokens:   tokens,
 	}, nil
 }
 
 // CreateToken implements UserService.CreateToken.
-func (s *userImpl) CreateToken(_ context.Context, req *v1.ServiceAccount) (*v1.TokenResponse, error) {
+func (s *userImpl) CreateToken(ctx context.Context, req *v1.ServiceAccount) (*v1.TokenResponse, error) {
+	// Callers authenticated with the admin password have no identity.
+	actor, _ := middleware.GetOwnerFromContext(ctx)
+	log.AuditLog(logging.INFO, "token-create", "received a token create request for service account",
+		"actor", actor,
+		"service-account", req.GetEmail(),
+		"scope", req.GetScope().String(),
+	)
+
+	if err := middleware.ValidateScope(req.GetScope()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid token scope: %v", err)
+	}
+
 	// Generate the service account token.
 	token, err := s.generate(req)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to generate token")
 	}
 
+	// Tokens that are not recorded could not be revoked, so none are handed
+	// out.
+	if err := s.tokens.Add(ctx, &v1.IssuedToken{Account: req, CreatedBy: actor}); err != nil {
+		return nil, errors.Wrap(err, "failed to record token")
+	}
+
 	return &v1.TokenResponse{
 		Account: req,
 		Token:   token,
@@ -50,23 +82,89 @@ func (s *userImpl) Token(ctx context.Context, _ *empty.Empty) (*v1.TokenResponse
 		return nil, errors.New("not called by a user")
 	}
 
-	// Synthesize a service account from the current user.
+	// Synthesize a service account from the current user, which keeps the
+	// groups that its role and team bindings are resolved from.
 	svcacct := v1.ServiceAccount{
 		Name:        user.Name,
 		Description: "Personal service account for " + user.Email,
 		Email:       user.Email,
+		Groups:      user.Groups,
 	}
 
 	return s.CreateToken(ctx, &svcacct)
 }
 
+// ListTokens implements UserService.ListTokens.
+func (s *userImpl) ListTokens(ctx context.Context, _ *empty.Empty) (*v1.TokenListResponse, error) {
+	tokens, err := s.tokens.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if middleware.HasAccess(ctx, middleware.Admin) {
+		return &v1.TokenListResponse{Tokens: tokens}, nil
+	}
+
+	owner, err := middleware.GetOwnerFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &v1.TokenListResponse{}
+	for _, token := range tokens {
+		if token.GetAccount().GetEmail() == owner {
+			resp.Tokens = append(resp.Tokens, token)
+		}
+	}
+
+	return resp, nil
+}
+
+// RevokeToken implements UserService.RevokeToken.
+func (s *userImpl) RevokeToken(ctx context.Context, req *v1.ResourceByID) (*v1.IssuedToken, error) {
+	// Callers authenticated with the admin password have no identity.
+	actor, _ := middleware.GetOwnerFromContext(ctx)
+	log.AuditLog(logging.INFO, "token-revoke", "received a token revoke request for service account",
+		"actor", actor,
+		"token-id", req.GetId(),
+	)
+
+	token, err := s.tokens.Get(ctx, req.GetId())
+	if errors.Is(err, tokenstore.ErrNotFound) {
+		return nil, status.Errorf(codes.NotFound, "token %q not found", req.GetId())
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Users may only revoke the tokens of their own service accounts.
+	if !middleware.HasAccess(ctx, middleware.Admin) && (actor == "" || token.GetAccount().GetEmail() != actor) {
+		return nil, status.Errorf(codes.PermissionDenied, "token %q is issued for %s", req.GetId(), token.GetAccount().GetEmail())
+	}
+
+	revoked, err := s.tokens.Revoke(ctx, req.GetId(), actor)
+	if errors.Is(err, tokenstore.ErrNotFound) {
+		return nil, status.Errorf(codes.NotFound, "token %q not found", req.GetId())
+	}
+	return revoked, err
+}
+
 // Whoami implements UserService.Whoami.
 func (s *userImpl) Whoami(ctx context.Context, _ *empty.Empty) (*v1.WhoamiResponse, error) {
+	var roles []string
+	for _, role := range middleware.RolesFromContext(ctx) {
+		roles = append(roles, string(role))
+	}
+
+	teams := middleware.TeamsFromContext(ctx)
+
 	if user, found := middleware.UserFromContext(ctx); found {
 		return &v1.WhoamiResponse{
 			Principal: &v1.WhoamiResponse_User{
 				User: user,
 			},
+			Roles: roles,
+			Teams: teams,
 		}, nil
 	}
 
@@ -75,10 +173,12 @@ func (s *userImpl) Whoami(ctx context.Context, _ *empty.Empty) (*v1.WhoamiRespon
 			Principal: &v1.WhoamiResponse_ServiceAccount{
 				ServiceAccount: svcacct,
 			},
+			Roles: roles,
+			Teams: teams,
 		}, nil
 	}
 
-	return &v1.WhoamiResponse{}, nil
+	return &v1.WhoamiResponse{Roles: roles}, nil
 }
 
 // Access configures access for this service.
@@ -86,6 +186,8 @@ func (s *userImpl) Access() map[string]middleware.Access {
 	return map[string]middleware.Access{
 		"/v1.UserService/Token":       middleware.Authenticated,
 		"/v1.UserService/CreateToken": middleware.Admin,
+		"/v1.UserService/ListTokens":  middleware.Authenticated,
+		"/v1.UserService/RevokeToken": middleware.Authenticated,
 		"/v1.UserService/Whoami":      middleware.Anonymous,
 	}
 }
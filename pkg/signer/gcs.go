@@ -1,9 +1,6 @@
-// Package signer facilitates the generation of signed GCS URLS.
 package signer
 
 import (
-	"archive/tar"
-	"compress/gzip"
 	"context"
 	"fmt"
 	"io"
@@ -17,25 +14,21 @@ import (
 	"golang.org/x/oauth2/jwt"
 )
 
-const (
-	// googleCredentialsEnvVar is the name of the environment variable, that
-	// itself contains the name of a GCP IAM credentials JSON file.
-	googleCredentialsEnvVar = "GOOGLE_APPLICATION_CREDENTIALS"
+// googleCredentialsEnvVar is the name of the environment variable, that
+// itself contains the name of a GCP IAM credentials JSON file.
+const googleCredentialsEnvVar = "GOOGLE_APPLICATION_CREDENTIALS"
 
-	// gcsSignedURLLifespan is the length of time a generated GCS signed URL
-	// will be valid for.
-	gcsSignedURLLifespan = 10 * time.Minute
-)
-
-// Signer facilitates the generation of signed GCS URLS.
-type Signer struct {
+// GCSStore is an ArtifactStore backed by Google Cloud Storage.
+type GCSStore struct {
 	cfg    jwt.Config
 	client *storage.Client
 }
 
-// NewFromEnv constructs a Signer from the GOOGLE_APPLICATION_CREDENTIALS set
-// in the working environment.
-func NewFromEnv() (*Signer, error) {
+var _ ArtifactStore = (*GCSStore)(nil)
+
+// NewFromEnv constructs a GCSStore from the GOOGLE_APPLICATION_CREDENTIALS
+// set in the working environment.
+func NewFromEnv() (*GCSStore, error) {
 	credentialsFilename, found := os.LookupEnv(googleCredentialsEnvVar)
 	if !found {
 		return nil, fmt.Errorf("environment variable %q was not set", googleCredentialsEnvVar)
@@ -56,34 +49,35 @@ func NewFromEnv() (*Signer, error) {
 		return nil, err
 	}
 
-	return &Signer{
+	return &GCSStore{
 		cfg:    *jwtCfg,
 		client: client,
 	}, nil
 }
 
-// Generate generates a url that can be used to download the given GCS object
-// for some amount of time.
+// SignedURL generates a url that can be used to download the given GCS
+// object for some amount of time.
 //
 // This is accomplished by creating a GCS signed URL. For more information see:
 // https://cloud.google.com/storage/docs/access-control/signed-urls
-func (s Signer) Generate(gcsBucketName, gcsBucketKey string) (string, error) {
+func (s GCSStore) SignedURL(gcsBucketName, gcsBucketKey string) (string, error) {
 	return storage.SignedURL(gcsBucketName, gcsBucketKey, &storage.SignedURLOptions{
 		GoogleAccessID: s.cfg.Email,
 		PrivateKey:     s.cfg.PrivateKey,
 		Method:         http.MethodGet,
-		Expires:        time.Now().Add(gcsSignedURLLifespan),
+		Expires:        time.Now().Add(signedURLLifespan),
 	})
 }
 
 // Contents returns the raw contents of the named GCS object. It is expected
 // that these are argo workflow artifacts either single files tar gzip'd or
 // plain files.
-func (s Signer) Contents(gcsBucketName, gcsBucketKey string) ([]byte, error) {
+func (s GCSStore) Contents(gcsBucketName, gcsBucketKey string) ([]byte, error) {
 	br, err := s.client.Bucket(gcsBucketName).Object(gcsBucketKey).NewReader(context.Background())
 	if err != nil {
 		return nil, err
 	}
+	defer br.Close() //nolint:errcheck
 
 	attrs, err := s.client.Bucket(gcsBucketName).Object(gcsBucketKey).Attrs(context.Background())
 	if err != nil {
@@ -94,23 +88,5 @@ func (s Signer) Contents(gcsBucketName, gcsBucketKey string) ([]byte, error) {
 		return io.ReadAll(br)
 	}
 
-	gr, err := gzip.NewReader(br)
-	if err != nil {
-		return nil, err
-	}
-	defer gr.Close() //nolint:errcheck
-
-	// Archive is a normal tar archive.
-	tr := tar.NewReader(gr)
-
-	// We're expecting 1 and only 1 file in the archive, so read just the
-	// first entry.
-	if _, err := tr.Next(); err != nil {
-		if err == io.EOF {
-			return nil, fmt.Errorf("unexpected EOF reading artifact")
-		}
-		return nil, err
-	}
-
-	return io.ReadAll(tr)
+	return readArchive(br)
 }
@@ -0,0 +1,83 @@
+package signer
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/stackrox/infra/pkg/config"
+)
+
+// LocalStore is an ArtifactStore backed by a directory on the local
+// filesystem. It is intended for development and testing.
+type LocalStore struct {
+	directory string
+	baseURL   string
+}
+
+var _ ArtifactStore = (*LocalStore)(nil)
+
+// NewLocal constructs a LocalStore from the given configuration.
+func NewLocal(cfg *config.LocalStoreConfig) (*LocalStore, error) {
+	if cfg == nil || cfg.Directory == "" {
+		return nil, errors.New("local artifact store directory was not set")
+	}
+
+	directory, err := filepath.Abs(cfg.Directory)
+	if err != nil {
+		return nil, err
+	}
+
+	return &LocalStore{
+		directory: directory,
+		baseURL:   strings.TrimSuffix(cfg.URL, "/"),
+	}, nil
+}
+
+// SignedURL generates a url that can be used to download the given object.
+// Local objects are not signed, and the url does not expire.
+func (s LocalStore) SignedURL(bucket, key string) (string, error) {
+	filename, err := s.filename(bucket, key)
+	if err != nil {
+		return "", err
+	}
+
+	if s.baseURL == "" {
+		return (&url.URL{Scheme: "file", Path: filename}).String(), nil
+	}
+
+	return s.baseURL + "/" + path.Join(bucket, key), nil
+}
+
+// Contents returns the raw contents of the named object. It is expected that
+// these are argo workflow artifacts either single files tar gzip'd or plain
+// files.
+func (s LocalStore) Contents(bucket, key string) ([]byte, error) {
+	filename, err := s.filename(bucket, key)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close() //nolint:errcheck
+
+	return readContents(file)
+}
+
+// filename returns the name of the file holding the given object, making sure
+// that it is inside the store directory.
+func (s LocalStore) filename(bucket, key string) (string, error) {
+	filename := filepath.Join(s.directory, bucket, filepath.FromSlash(key))
+	if !strings.HasPrefix(filename, s.directory+string(filepath.Separator)) {
+		return "", fmt.Errorf("invalid artifact location %q/%q", bucket, key)
+	}
+
+	return filename, nil
+}
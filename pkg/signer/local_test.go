@@ -0,0 +1,108 @@
+package signer
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stackrox/infra/pkg/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func tarGzip(t *testing.T, contents string) []byte {
+	var buf bytes.Buffer
+	gw := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gw)
+	require.NoError(t, tw.WriteHeader(&tar.Header{Name: "url", Mode: 0644, Size: int64(len(contents))}))
+	_, err := tw.Write([]byte(contents))
+	require.NoError(t, err)
+	require.NoError(t, tw.Close())
+	require.NoError(t, gw.Close())
+	return buf.Bytes()
+}
+
+func TestLocalStoreContents(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "bucket", "workflow"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "bucket", "workflow", "plain"), []byte("https://example.com"), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "bucket", "workflow", "archive.tgz"), tarGzip(t, "https://example.org"), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "bucket", "workflow", "empty"), nil, 0644))
+
+	store, err := NewLocal(&config.LocalStoreConfig{Directory: dir})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		bucket   string
+		key      string
+		expected string
+		wantErr  bool
+	}{
+		{
+			name:     "plain file",
+			bucket:   "bucket",
+			key:      "workflow/plain",
+			expected: "https://example.com",
+		},
+		{
+			name:     "tar gzip'd file",
+			bucket:   "bucket",
+			key:      "workflow/archive.tgz",
+			expected: "https://example.org",
+		},
+		{
+			name:   "empty file",
+			bucket: "bucket",
+			key:    "workflow/empty",
+		},
+		{
+			name:    "missing file",
+			bucket:  "bucket",
+			key:     "workflow/missing",
+			wantErr: true,
+		},
+		{
+			name:    "key outside of the store",
+			bucket:  "bucket",
+			key:     "../../etc/passwd",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			contents, err := store.Contents(tt.bucket, tt.key)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, string(contents))
+		})
+	}
+}
+
+func TestLocalStoreSignedURL(t *testing.T) {
+	dir := t.TempDir()
+
+	store, err := NewLocal(&config.LocalStoreConfig{Directory: dir})
+	require.NoError(t, err)
+	url, err := store.SignedURL("bucket", "workflow/kubeconfig")
+	require.NoError(t, err)
+	assert.Equal(t, "file://"+filepath.Join(dir, "bucket", "workflow", "kubeconfig"), url)
+
+	store, err = NewLocal(&config.LocalStoreConfig{Directory: dir, URL: "https://artifacts.example.com/"})
+	require.NoError(t, err)
+	url, err = store.SignedURL("bucket", "workflow/kubeconfig")
+	require.NoError(t, err)
+	assert.Equal(t, "https://artifacts.example.com/bucket/workflow/kubeconfig", url)
+}
+
+func TestNewUnknownStoreType(t *testing.T) {
+	_, err := New(&config.ArtifactStoreConfig{Type: "ftp"})
+	assert.ErrorContains(t, err, `unknown artifact store type "ftp"`)
+}
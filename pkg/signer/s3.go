@@ -0,0 +1,75 @@
+package signer
+
+import (
+	"context"
+	"errors"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+	"github.com/stackrox/infra/pkg/config"
+)
+
+// defaultS3Endpoint is the endpoint used when none is configured.
+const defaultS3Endpoint = "s3.amazonaws.com"
+
+// S3Store is an ArtifactStore backed by an S3-compatible object store.
+type S3Store struct {
+	client *minio.Client
+}
+
+var _ ArtifactStore = (*S3Store)(nil)
+
+// NewS3 constructs an S3Store from the given configuration.
+func NewS3(cfg *config.S3StoreConfig) (*S3Store, error) {
+	if cfg == nil {
+		return nil, errors.New("s3 artifact store configuration was not set")
+	}
+
+	endpoint := cfg.Endpoint
+	if endpoint == "" {
+		endpoint = defaultS3Endpoint
+	}
+
+	creds := credentials.NewChainCredentials([]credentials.Provider{
+		&credentials.EnvAWS{},
+		&credentials.IAM{},
+	})
+	if cfg.AccessKey != "" || cfg.SecretKey != "" {
+		creds = credentials.NewStaticV4(cfg.AccessKey, cfg.SecretKey, "")
+	}
+
+	client, err := minio.New(endpoint, &minio.Options{
+		Creds:  creds,
+		Secure: !cfg.Insecure,
+		Region: cfg.Region,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &S3Store{client: client}, nil
+}
+
+// SignedURL generates a presigned url that can be used to download the given
+// S3 object for some amount of time.
+func (s S3Store) SignedURL(bucket, key string) (string, error) {
+	u, err := s.client.PresignedGetObject(context.Background(), bucket, key, signedURLLifespan, nil)
+	if err != nil {
+		return "", err
+	}
+
+	return u.String(), nil
+}
+
+// Contents returns the raw contents of the named S3 object. It is expected
+// that these are argo workflow artifacts either single files tar gzip'd or
+// plain files.
+func (s S3Store) Contents(bucket, key string) ([]byte, error) {
+	object, err := s.client.GetObject(context.Background(), bucket, key, minio.GetObjectOptions{})
+	if err != nil {
+		return nil, err
+	}
+	defer object.Close() //nolint:errcheck
+
+	return readContents(object)
+}
@@ -0,0 +1,100 @@
+// Package signer provides access to the object stores holding workflow
+// artifacts, including the generation of signed URLs for downloading them.
+package signer
+
+import (
+	"archive/tar"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/stackrox/infra/pkg/config"
+)
+
+const (
+	// signedURLLifespan is the length of time a generated signed URL will be
+	// valid for.
+	signedURLLifespan = 10 * time.Minute
+
+	storeTypeGCS   = "gcs"
+	storeTypeS3    = "s3"
+	storeTypeLocal = "local"
+)
+
+// ArtifactStore represents an object store that holds workflow artifacts.
+type ArtifactStore interface {
+	// SignedURL generates a url that can be used to download the given
+	// object for some amount of time.
+	SignedURL(bucket, key string) (string, error)
+
+	// Contents returns the raw contents of the given object. It is expected
+	// that these are argo workflow artifacts either single files tar gzip'd
+	// or plain files.
+	Contents(bucket, key string) ([]byte, error)
+}
+
+// New constructs the ArtifactStore selected by the given configuration. A GCS
+// store is constructed from the working environment when no configuration is
+// given.
+func New(cfg *config.ArtifactStoreConfig) (ArtifactStore, error) {
+	if cfg == nil {
+		return NewFromEnv()
+	}
+
+	switch cfg.Type {
+	case "", storeTypeGCS:
+		return NewFromEnv()
+	case storeTypeS3:
+		return NewS3(cfg.S3)
+	case storeTypeLocal:
+		return NewLocal(cfg.Local)
+	default:
+		return nil, fmt.Errorf("unknown artifact store type %q", cfg.Type)
+	}
+}
+
+// gzipMagic is the header that starts every gzip'd file.
+var gzipMagic = []byte{0x1f, 0x8b}
+
+// readContents returns the contents of the given artifact, which is either a
+// single file tar gzip'd or a plain file.
+func readContents(r io.Reader) ([]byte, error) {
+	br := bufio.NewReader(r)
+	header, err := br.Peek(len(gzipMagic))
+	if err != nil && err != io.EOF {
+		return nil, err
+	}
+
+	if !bytes.Equal(header, gzipMagic) {
+		return io.ReadAll(br)
+	}
+
+	return readArchive(br)
+}
+
+// readArchive returns the contents of the single file held by the given tar
+// gzip'd archive.
+func readArchive(r io.Reader) ([]byte, error) {
+	gr, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, err
+	}
+	defer gr.Close() //nolint:errcheck
+
+	// Archive is a normal tar archive.
+	tr := tar.NewReader(gr)
+
+	// We're expecting 1 and only 1 file in the archive, so read just the
+	// first entry.
+	if _, err := tr.Next(); err != nil {
+		if err == io.EOF {
+			return nil, fmt.Errorf("unexpected EOF reading artifact")
+		}
+		return nil, err
+	}
+
+	return io.ReadAll(tr)
+}
@@ -0,0 +1,88 @@
+package slack
+
+import (
+	"time"
+
+	"github.com/stackrox/infra/cmd/infractl/common"
+	"github.com/stackrox/infra/pkg/logging"
+	"github.com/stackrox/infra/pkg/notifier"
+)
+
+// NotifierName is the name of the Slack notification sink.
+const NotifierName = "slack"
+
+type slackNotifier struct {
+	client Slacker
+}
+
+var _ notifier.Notifier = (*slackNotifier)(nil)
+
+// NewNotifier creates a notifier that delivers cluster lifecycle
+// notifications as Slack messages using the given client.
+func NewNotifier(client Slacker) notifier.Notifier {
+	return &slackNotifier{client: client}
+}
+
+// Name implements notifier.Notifier.Name.
+func (n *slackNotifier) Name() string {
+	return NotifierName
+}
+
+// Notify implements notifier.Notifier.Notify. Messages are sent directly to
+// the owner and collaborators if requested, and to the channel otherwise.
+func (n *slackNotifier) Notify(event notifier.Event) error {
+	message := formatMessage(event.Status, templateContext(n.client, event))
+	if message == nil {
+		return nil
+	}
+
+	cluster := event.Cluster
+	user, found := n.client.LookupUser(cluster.GetOwner())
+	if found && event.SlackDM {
+		if err := n.client.PostMessageToUser(user, message...); err != nil {
+			log.Log(logging.ERROR, "failed to send Slack message directly to user", "user-email", user.Profile.Email, "error", err)
+		} else {
+			// Collaborators would not see a direct message to the owner.
+			for _, collaborator := range cluster.GetCollaborators() {
+				if user, found := n.client.LookupUser(collaborator); found {
+					if err := n.client.PostMessageToUser(user, message...); err != nil {
+						log.Log(logging.ERROR, "failed to send Slack message directly to collaborator", "user-email", collaborator, "error", err)
+					}
+				}
+			}
+			return nil
+		}
+	}
+
+	return n.client.PostMessage(message...)
+}
+
+func templateContext(client Slacker, event notifier.Event) TemplateData {
+	cluster := event.Cluster
+	createdOn := cluster.GetCreatedOn().AsTime()
+	lifespan := cluster.GetLifespan().AsDuration()
+	remaining := time.Until(createdOn.Add(lifespan))
+
+	data := TemplateData{
+		Description:    cluster.GetDescription(),
+		Flavor:         cluster.GetFlavor(),
+		ID:             cluster.GetID(),
+		OwnerEmail:     cluster.GetOwner(),
+		Remaining:      common.FormatExpiration(remaining),
+		Scheduled:      event.Scheduled,
+		URL:            cluster.GetURL(),
+		FailureDetails: event.FailureDetails,
+	}
+
+	if user, found := client.LookupUser(cluster.GetOwner()); found {
+		data.OwnerID = user.ID
+	}
+
+	for _, collaborator := range cluster.GetCollaborators() {
+		if user, found := client.LookupUser(collaborator); found {
+			data.CollaboratorIDs = append(data.CollaboratorIDs, user.ID)
+		}
+	}
+
+	return data
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/slack-go/slack"
 	v1 "github.com/stackrox/infra/generated/api/v1"
+	"github.com/stackrox/infra/pkg/notifier"
 )
 
 // TemplateData represents the available context that is passed when executing
@@ -21,32 +22,43 @@ type TemplateData struct {
 	OwnerEmail     string
 	OwnerID        string
 	FailureDetails string
+
+	// CollaboratorIDs are the Slack user IDs of the cluster collaborators.
+	CollaboratorIDs []string
+
+	// Actor, Operation, and Reason describe an operation that was forced on
+	// the cluster by someone other than the owner.
+	Actor     string
+	Operation string
+	Reason    string
 }
 
 // Status represents which lifecycle stage a cluster has most recently sent a
 // slack message for.
-type Status string
+type Status = notifier.Status
 
 const (
 	// StatusSkip is for when cluster should not result in Slack messages.
-	StatusSkip Status = "skip"
+	StatusSkip = notifier.StatusSkip
 
 	// StatusFailed is for when a cluster has failed.
-	StatusFailed Status = "failed"
+	StatusFailed = notifier.StatusFailed
 
 	// StatusDestroyed is for when a cluster is being deleted.
-	StatusDestroyed Status = "destroyed"
+	StatusDestroyed = notifier.StatusDestroyed
 
 	// StatusReady is for when a cluster is ready.
-	StatusReady Status = "ready"
+	StatusReady = notifier.StatusReady
 
 	// StatusNearingExpiry is for when a cluster is close to expiry.
-	StatusNearingExpiry Status = "nearing_expiry"
+	StatusNearingExpiry = notifier.StatusNearingExpiry
 
 	// StatusCreating is for when a cluster is being created.
-	StatusCreating Status = "creating"
+	StatusCreating = notifier.StatusCreating
 )
 
+const templateCollaborators = "{{if .CollaboratorIDs}}:busts_in_silhouette: Shared with{{range .CollaboratorIDs}} <@{{.}}>{{end}}.{{end}}"
+
 var (
 	templatesFailed = []string{ //nolint:gochecknoglobals
 		"<@{{.OwnerID}}> - Your {{if .Scheduled}}scheduled {{end}}{{if .Description}}*{{.Description}}* {{else}}*{{.ID}}* {{end}}cluster has failed!{{if .FailureDetails}} {{.FailureDetails}}{{end}} :fire:",
@@ -58,6 +70,7 @@ var (
 
 	templatesReady = []string{ //nolint:gochecknoglobals
 		"<@{{.OwnerID}}> - Your {{if .Scheduled}}scheduled {{end}}{{if .Description}}*{{.Description}}* {{else}}*{{.ID}}* {{end}}cluster is now ready! :party-parrot:",
+		templateCollaborators,
 		"{{if .URL}}:earth_americas: Browse to *{{.URL}}* to login.{{end}}",
 		":clock2: This cluster has about *{{.Remaining}}* before it is destroyed.",
 		":thinking_face: To view cluster *info*, you can run:\n```$ infractl get {{.ID}}```",
@@ -74,13 +87,21 @@ var (
 
 	templatesNearingExpiry = []string{ //nolint:gochecknoglobals
 		"<@{{.OwnerID}}> - Your {{if .Scheduled}}scheduled {{end}}{{if .Description}}*{{.Description}}* {{else}}*{{.ID}}* {{end}}cluster has about *{{.Remaining}}*. :skull_and_crossbones:",
+		templateCollaborators,
 		":clock2: To buy more time, you can run:\n```$ infractl lifespan {{.ID}} '+1h'```",
 		":link: Or go to: https://infra.rox.systems/cluster/{{.ID}}",
 	}
+
+	templatesForced = []string{ //nolint:gochecknoglobals
+		"{{if .OwnerID}}<@{{.OwnerID}}>{{else}}{{.OwnerEmail}}{{end}} - Your {{if .Description}}*{{.Description}}* {{else}}*{{.ID}}* {{end}}cluster was {{.Operation}} by {{.Actor}}, who is not its owner. :warning:",
+		":memo: The reason given was: {{.Reason}}",
+	}
 )
 
-func templateBlocks(context TemplateData, templates []string) []slack.MsgOption {
-	blocks := make([]slack.Block, 0, len(templates))
+// renderTemplates executes the given templates, omitting the ones that render
+// as blank.
+func renderTemplates(context TemplateData, templates []string) []string {
+	texts := make([]string, 0, len(templates))
 	for _, raw := range templates {
 		tpl := template.Must(template.New("template").Parse(raw))
 		var buf bytes.Buffer
@@ -91,12 +112,21 @@ func templateBlocks(context TemplateData, templates []string) []slack.MsgOption
 		if strings.TrimSpace(buf.String()) == "" {
 			continue
 		}
+		texts = append(texts, buf.String())
+	}
 
+	return texts
+}
+
+func templateBlocks(context TemplateData, templates []string) []slack.MsgOption {
+	texts := renderTemplates(context, templates)
+	blocks := make([]slack.Block, 0, len(texts))
+	for _, text := range texts {
 		blocks = append(blocks,
 			slack.NewSectionBlock(
 				slack.NewTextBlockObject(
 					slack.MarkdownType,
-					buf.String(),
+					text,
 					false,
 					false,
 				),
@@ -115,35 +145,40 @@ func templateBlocks(context TemplateData, templates []string) []slack.MsgOption
 
 // FormatSlackMessage formats the correct Slack message given the current cluster state.
 func FormatSlackMessage(wfStatus v1.Status, clusterIsNearingExpiry bool, slackStatus Status, contextData TemplateData) (Status, []slack.MsgOption) {
-	switch {
-	case slackStatus == StatusSkip:
-		return StatusSkip, nil
-
-	case wfStatus == v1.Status_FAILED && slackStatus != StatusFailed:
-		return StatusFailed, templateBlocks(contextData, templatesFailed)
-
-	case (wfStatus == v1.Status_DESTROYING || wfStatus == v1.Status_FINISHED) && slackStatus != StatusDestroyed:
-		return StatusDestroyed, templateBlocks(contextData, templatesDestroyed)
-
-	case wfStatus == v1.Status_READY && slackStatus != StatusReady && slackStatus != StatusNearingExpiry:
-		return StatusReady, templateBlocks(contextData, templatesReady)
-
-	case wfStatus == v1.Status_READY && slackStatus == StatusReady && clusterIsNearingExpiry:
-		return StatusNearingExpiry, templateBlocks(contextData, templatesNearingExpiry)
-
-	case wfStatus == v1.Status_READY && slackStatus == StatusNearingExpiry && !clusterIsNearingExpiry:
-		return StatusReady, nil
+	newSlackStatus, notify := notifier.Transition(wfStatus, clusterIsNearingExpiry, slackStatus)
+	if !notify {
+		return newSlackStatus, nil
+	}
 
-	case wfStatus == v1.Status_CREATING && slackStatus != StatusCreating:
-		return StatusCreating, templateBlocks(contextData, templatesCreating)
+	return newSlackStatus, formatMessage(newSlackStatus, contextData)
+}
 
+// formatMessage formats the Slack message for the given lifecycle stage.
+func formatMessage(slackStatus Status, contextData TemplateData) []slack.MsgOption {
+	switch slackStatus {
+	case StatusFailed:
+		return templateBlocks(contextData, templatesFailed)
+	case StatusDestroyed:
+		return templateBlocks(contextData, templatesDestroyed)
+	case StatusReady:
+		return templateBlocks(contextData, templatesReady)
+	case StatusNearingExpiry:
+		return templateBlocks(contextData, templatesNearingExpiry)
+	case StatusCreating:
+		return templateBlocks(contextData, templatesCreating)
 	default:
-		return slackStatus, nil
+		return nil
 	}
 }
 
+// FormatForcedMessage formats the Slack message telling the owner that an
+// operation was forced on their cluster.
+func FormatForcedMessage(contextData TemplateData) []slack.MsgOption {
+	return templateBlocks(contextData, templatesForced)
+}
+
 // IsSlackComplete once a slack status has reached any of these states it will
 // require no more updates.
 func IsSlackComplete(slackStatus Status) bool {
-	return slackStatus == StatusSkip || slackStatus == StatusFailed || slackStatus == StatusDestroyed
+	return notifier.IsComplete(slackStatus)
 }
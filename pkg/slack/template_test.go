@@ -194,3 +194,39 @@ func Test(t *testing.T) {
 		})
 	}
 }
+
+func TestFormatForcedMessage(t *testing.T) {
+	tests := []struct {
+		title    string
+		ownerID  string
+		expected string
+	}{
+		{
+			title:    "owner found in Slack",
+			ownerID:  "U123",
+			expected: "<@U123> - Your *example* cluster was deleted by actor@example.com",
+		},
+		{
+			title:    "owner not found in Slack",
+			expected: "owner@example.com - Your *example* cluster was deleted by actor@example.com",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.title, func(t *testing.T) {
+			texts := renderTemplates(TemplateData{
+				ID:         "example",
+				OwnerEmail: "owner@example.com",
+				OwnerID:    test.ownerID,
+				Actor:      "actor@example.com",
+				Operation:  "deleted",
+				Reason:     "blocking the release pipeline",
+			}, templatesForced)
+
+			assert.Equal(t, []string{
+				test.expected + ", who is not its owner. :warning:",
+				":memo: The reason given was: blocking the release pipeline",
+			}, texts)
+		})
+	}
+}
@@ -0,0 +1,319 @@
+// Package tokenstore records issued service account tokens, so that they can
+// be listed and revoked before they expire.
+package tokenstore
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	v1 "github.com/stackrox/infra/generated/api/v1"
+	"github.com/stackrox/infra/pkg/config"
+	"github.com/stackrox/infra/pkg/kube"
+	"github.com/stackrox/infra/pkg/logging"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+	k8sv1 "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/util/retry"
+)
+
+const (
+	defaultNamespace = "infra"
+	defaultName      = "service-account-tokens"
+
+	// storeLabel is the label key that marks the ConfigMaps of a store, with
+	// the name of the store as the value.
+	storeLabel = "infra.stackrox.com/token-store"
+
+	// tokenKey is the ConfigMap data key of the recorded token.
+	tokenKey = "token"
+
+	// revokedCacheTTL is how long the revoked token IDs are cached for. Tokens
+	// revoked through other replicas are rejected after at most this long.
+	revokedCacheTTL = 10 * time.Second
+)
+
+var log = logging.CreateProductionLogger()
+
+// ErrNotFound is returned for tokens that are not recorded.
+var ErrNotFound = errors.New("token not found")
+
+// Store records every issued service account token in its own ConfigMap,
+// named after the store and the unique ID of the token. The ConfigMaps of
+// expired tokens are deleted whenever a token is added, so that they do not
+// pile up.
+type Store struct {
+	client k8sv1.ConfigMapInterface
+	name   string
+	now    func() time.Time
+
+	// mu guards the cached revoked token IDs. It is never held while the
+	// ConfigMaps are accessed.
+	mu         sync.Mutex
+	revoked    map[string]struct{}
+	refreshed  time.Time
+	refreshing bool
+
+	// revokedDuringRefresh are the IDs of the tokens revoked by this replica
+	// while the cache is refreshed, which the refreshed cache may miss.
+	revokedDuringRefresh []string
+}
+
+// New creates a Store. Defaults are used for a missing configuration.
+func New(cfg *config.TokenStoreConfig) (*Store, error) {
+	if cfg == nil {
+		cfg = &config.TokenStoreConfig{}
+	}
+
+	namespace := cfg.Namespace
+	if namespace == "" {
+		namespace = defaultNamespace
+	}
+
+	client, err := kube.GetK8sConfigMapClient(namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	return newStore(client, cfg.Name), nil
+}
+
+func newStore(client k8sv1.ConfigMapInterface, name string) *Store {
+	if name == "" {
+		name = defaultName
+	}
+
+	return &Store{
+		client: client,
+		name:   name,
+		now:    time.Now,
+	}
+}
+
+// Add records the given issued token, and deletes the recorded tokens that
+// have expired.
+func (s *Store) Add(ctx context.Context, token *v1.IssuedToken) error {
+	id := token.GetAccount().GetJti()
+	if id == "" {
+		return errors.New("token has no ID")
+	}
+	if errs := validation.IsDNS1123Subdomain(s.objectName(id)); len(errs) > 0 {
+		return errors.Errorf("token ID %q cannot be recorded: %v", id, errs)
+	}
+
+	data, err := protojson.Marshal(token)
+	if err != nil {
+		return errors.Wrapf(err, "failed to marshal token %q", id)
+	}
+
+	_, err = s.client.Create(ctx, &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   s.objectName(id),
+			Labels: map[string]string{storeLabel: s.name},
+		},
+		Data: map[string]string{tokenKey: string(data)},
+	}, metav1.CreateOptions{})
+	if err != nil {
+		return errors.Wrapf(err, "failed to record token %q", id)
+	}
+
+	s.prune(ctx)
+	return nil
+}
+
+// Get returns the recorded token with the given ID, or ErrNotFound.
+func (s *Store) Get(ctx context.Context, id string) (*v1.IssuedToken, error) {
+	configMap, err := s.client.Get(ctx, s.objectName(id), metav1.GetOptions{})
+	if k8serrors.IsNotFound(err) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get token %q", id)
+	}
+
+	token, ok := s.decode(configMap)
+	if !ok {
+		return nil, ErrNotFound
+	}
+	return token, nil
+}
+
+// List returns the recorded tokens that have not expired, the most recently
+// issued first.
+func (s *Store) List(ctx context.Context) ([]*v1.IssuedToken, error) {
+	configMaps, err := s.list(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var list []*v1.IssuedToken
+	for i := range configMaps {
+		if token, ok := s.decode(&configMaps[i]); ok {
+			list = append(list, token)
+		}
+	}
+	sort.Slice(list, func(i, j int) bool {
+		if list[i].GetAccount().GetIssuedAt() != list[j].GetAccount().GetIssuedAt() {
+			return list[i].GetAccount().GetIssuedAt() > list[j].GetAccount().GetIssuedAt()
+		}
+		return list[i].GetAccount().GetJti() < list[j].GetAccount().GetJti()
+	})
+
+	return list, nil
+}
+
+// Revoke marks the token with the given ID as revoked by the given actor, and
+// returns it. Revoking a revoked token again is a no-op.
+func (s *Store) Revoke(ctx context.Context, id string, actor string) (*v1.IssuedToken, error) {
+	var revoked *v1.IssuedToken
+	// Other replicas may revoke the token concurrently.
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		configMap, err := s.client.Get(ctx, s.objectName(id), metav1.GetOptions{})
+		if k8serrors.IsNotFound(err) {
+			return ErrNotFound
+		}
+		if err != nil {
+			return errors.Wrapf(err, "failed to get token %q", id)
+		}
+
+		token, ok := s.decode(configMap)
+		if !ok {
+			return ErrNotFound
+		}
+		revoked = token
+		if token.GetRevokedAt() != nil {
+			return nil
+		}
+
+		token.RevokedAt = timestamppb.New(s.now())
+		token.RevokedBy = actor
+		data, err := protojson.Marshal(token)
+		if err != nil {
+			return errors.Wrapf(err, "failed to marshal token %q", id)
+		}
+		configMap.Data = map[string]string{tokenKey: string(data)}
+
+		_, err = s.client.Update(ctx, configMap, metav1.UpdateOptions{})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.revoked != nil {
+		s.revoked[id] = struct{}{}
+	}
+	if s.refreshing {
+		s.revokedDuringRefresh = append(s.revokedDuringRefresh, id)
+	}
+
+	return revoked, nil
+}
+
+// IsRevoked reports whether the token with the given ID was revoked. Tokens
+// that are not recorded are not revoked. While the cached revoked token IDs
+// are refreshed, other callers are answered from the previous cache.
+func (s *Store) IsRevoked(ctx context.Context, id string) (bool, error) {
+	s.mu.Lock()
+	if s.revoked != nil && (s.refreshing || s.now().Sub(s.refreshed) <= revokedCacheTTL) {
+		_, found := s.revoked[id]
+		s.mu.Unlock()
+		return found, nil
+	}
+	s.refreshing = true
+	s.mu.Unlock()
+
+	revoked, err := s.loadRevoked(ctx)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.refreshing = false
+	if err != nil {
+		s.revokedDuringRefresh = nil
+		return false, err
+	}
+
+	for _, revokedID := range s.revokedDuringRefresh {
+		revoked[revokedID] = struct{}{}
+	}
+	s.revokedDuringRefresh = nil
+	s.revoked = revoked
+	s.refreshed = s.now()
+
+	_, found := s.revoked[id]
+	return found, nil
+}
+
+// loadRevoked returns the IDs of the recorded tokens that were revoked and
+// have not expired.
+func (s *Store) loadRevoked(ctx context.Context) (map[string]struct{}, error) {
+	configMaps, err := s.list(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	revoked := make(map[string]struct{})
+	for i := range configMaps {
+		if token, ok := s.decode(&configMaps[i]); ok && token.GetRevokedAt() != nil {
+			revoked[token.GetAccount().GetJti()] = struct{}{}
+		}
+	}
+	return revoked, nil
+}
+
+// prune deletes the ConfigMaps of the tokens that have expired or cannot be
+// decoded. Failures are only logged, as they are retried on the next add.
+func (s *Store) prune(ctx context.Context) {
+	configMaps, err := s.list(ctx)
+	if err != nil {
+		log.Log(logging.WARN, "failed to list tokens for pruning", "error", err)
+		return
+	}
+
+	for i := range configMaps {
+		if _, ok := s.decode(&configMaps[i]); ok {
+			continue
+		}
+		err := s.client.Delete(ctx, configMaps[i].GetName(), metav1.DeleteOptions{})
+		if err != nil && !k8serrors.IsNotFound(err) {
+			log.Log(logging.WARN, "failed to delete expired token", "name", configMaps[i].GetName(), "error", err)
+		}
+	}
+}
+
+// list returns the ConfigMaps of every recorded token.
+func (s *Store) list(ctx context.Context) ([]corev1.ConfigMap, error) {
+	configMaps, err := s.client.List(ctx, metav1.ListOptions{LabelSelector: storeLabel + "=" + s.name})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list issued tokens")
+	}
+	return configMaps.Items, nil
+}
+
+// objectName returns the name of the ConfigMap of the token with the given
+// ID.
+func (s *Store) objectName(id string) string {
+	return s.name + "-" + id
+}
+
+// decode parses the token recorded in the given ConfigMap, and reports
+// whether it is valid and has not expired.
+func (s *Store) decode(configMap *corev1.ConfigMap) (*v1.IssuedToken, bool) {
+	var token v1.IssuedToken
+	if err := protojson.Unmarshal([]byte(configMap.Data[tokenKey]), &token); err != nil {
+		return nil, false
+	}
+	if token.GetAccount().GetExpiresAt() < s.now().Unix() {
+		return nil, false
+	}
+
+	return &token, true
+}
@@ -0,0 +1,125 @@
+package tokenstore
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	v1 "github.com/stackrox/infra/generated/api/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func issuedToken(id string, issuedAt time.Time, lifetime time.Duration) *v1.IssuedToken {
+	return &v1.IssuedToken{
+		Account: &v1.ServiceAccount{
+			Name:        "ci-robot",
+			Description: "CI service account",
+			Email:       "roxbot@redhat.com",
+			IssuedAt:    issuedAt.Unix(),
+			NotBefore:   issuedAt.Unix(),
+			ExpiresAt:   issuedAt.Add(lifetime).Unix(),
+			Jti:         id,
+		},
+		CreatedBy: "admin@redhat.com",
+	}
+}
+
+func TestStore(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	store := newStore(fake.NewClientset().CoreV1().ConfigMaps("infra"), "")
+	store.now = func() time.Time { return now }
+
+	require.NoError(t, store.Add(ctx, issuedToken("older", now.Add(-time.Hour), 2*time.Hour)))
+	require.NoError(t, store.Add(ctx, issuedToken("newer", now, time.Hour)))
+	require.NoError(t, store.Add(ctx, issuedToken("expired", now.Add(-2*time.Hour), time.Hour)))
+	require.Error(t, store.Add(ctx, issuedToken("", now, time.Hour)))
+
+	tokens, err := store.List(ctx)
+	require.NoError(t, err)
+	var ids []string
+	for _, token := range tokens {
+		ids = append(ids, token.GetAccount().GetJti())
+	}
+	assert.Equal(t, []string{"newer", "older"}, ids)
+
+	revoked, err := store.IsRevoked(ctx, "older")
+	require.NoError(t, err)
+	assert.False(t, revoked)
+
+	token, err := store.Revoke(ctx, "older", "roxbot@redhat.com")
+	require.NoError(t, err)
+	assert.Equal(t, "roxbot@redhat.com", token.GetRevokedBy())
+	assert.Equal(t, now.Unix(), token.GetRevokedAt().GetSeconds())
+
+	// The cached revocations are updated without a refresh.
+	revoked, err = store.IsRevoked(ctx, "older")
+	require.NoError(t, err)
+	assert.True(t, revoked)
+
+	token, err = store.Get(ctx, "older")
+	require.NoError(t, err)
+	assert.Equal(t, "roxbot@redhat.com", token.GetRevokedBy())
+
+	_, err = store.Revoke(ctx, "expired", "roxbot@redhat.com")
+	assert.ErrorIs(t, err, ErrNotFound)
+	_, err = store.Get(ctx, "missing")
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestStoreRevokedByOtherReplica(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	client := fake.NewClientset().CoreV1().ConfigMaps("infra")
+	store := newStore(client, "")
+	store.now = func() time.Time { return now }
+	other := newStore(client, "")
+
+	require.NoError(t, store.Add(ctx, issuedToken("token", now, time.Hour)))
+	revoked, err := store.IsRevoked(ctx, "token")
+	require.NoError(t, err)
+	assert.False(t, revoked)
+
+	_, err = other.Revoke(ctx, "token", "admin@redhat.com")
+	require.NoError(t, err)
+
+	// Revocations by other replicas are seen once the cache expired.
+	revoked, err = store.IsRevoked(ctx, "token")
+	require.NoError(t, err)
+	assert.False(t, revoked)
+
+	now = now.Add(revokedCacheTTL + time.Second)
+	revoked, err = store.IsRevoked(ctx, "token")
+	require.NoError(t, err)
+	assert.True(t, revoked)
+}
+
+func TestStorePrunesExpiredTokens(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	client := fake.NewClientset().CoreV1().ConfigMaps("infra")
+	store := newStore(client, "")
+	store.now = func() time.Time { return now }
+
+	require.NoError(t, store.Add(ctx, issuedToken("expiring", now, time.Hour)))
+	require.NoError(t, store.Add(ctx, issuedToken("lasting", now, 3*time.Hour)))
+
+	// Every token is recorded in its own ConfigMap.
+	configMaps, err := client.List(ctx, metav1.ListOptions{})
+	require.NoError(t, err)
+	assert.Len(t, configMaps.Items, 2)
+
+	now = now.Add(2 * time.Hour)
+	require.NoError(t, store.Add(ctx, issuedToken("new", now, time.Hour)))
+
+	configMaps, err = client.List(ctx, metav1.ListOptions{})
+	require.NoError(t, err)
+	var names []string
+	for _, configMap := range configMaps.Items {
+		names = append(names, configMap.GetName())
+	}
+	assert.ElementsMatch(t, []string{"service-account-tokens-lasting", "service-account-tokens-new"}, names)
+}